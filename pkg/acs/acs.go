@@ -0,0 +1,181 @@
+package acs
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+	"github.com/containers/kubernetes-mcp-server/pkg/tlsutil"
+)
+
+// ACS is a client for the Red Hat Advanced Cluster Security (StackRox)
+// Central API.
+type ACS struct {
+	bearerToken          string
+	apiTokenFile         string
+	centralURL           string
+	insecure             bool
+	certificateAuthority string
+	tlsMinVersion        string
+	tlsCipherSuites      []string
+	requireTLS           func() bool
+}
+
+// NewACS creates a new ACS Central client from the toolset configuration.
+func NewACS(configProvider api.BaseConfig, kubernetes *rest.Config) *ACS {
+	acs := &ACS{
+		bearerToken:     kubernetes.BearerToken,
+		tlsMinVersion:   configProvider.GetTLSMinVersionConfig(),
+		tlsCipherSuites: configProvider.GetTLSCipherSuitesConfig(),
+		requireTLS:      configProvider.IsRequireTLS,
+	}
+	if cfg, ok := configProvider.GetToolsetConfig("acs"); ok {
+		if ac, ok := cfg.(*Config); ok && ac != nil {
+			acs.centralURL = ac.Url
+			acs.apiTokenFile = ac.ApiTokenFile
+			acs.insecure = ac.Insecure
+			acs.certificateAuthority = ac.CertificateAuthority
+		}
+	}
+	return acs
+}
+
+// validateAndGetURL validates the client configuration and returns the full
+// URL for endpoint with the provided query parameters attached.
+func (a *ACS) validateAndGetURL(endpoint string, query url.Values) (string, error) {
+	if a == nil || a.centralURL == "" {
+		return "", fmt.Errorf("ACS Central is not configured; set url in the [toolset_configs.acs] section")
+	}
+	resultURL, err := url.JoinPath(strings.TrimSpace(a.centralURL), endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to join ACS Central URL with endpoint path: %w", err)
+	}
+	u, err := url.Parse(resultURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid ACS Central URL: %w", err)
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func (a *ACS) createHTTPClient(ctx context.Context) (*http.Client, error) {
+	logger := klogutil.FromContext(ctx)
+	var tlsOpts []tlsutil.TLSConfigOption
+
+	if a.insecure {
+		tlsOpts = append(tlsOpts, tlsutil.WithInsecureSkipVerify(true))
+	}
+
+	if caValue := strings.TrimSpace(a.certificateAuthority); caValue != "" {
+		caPEM, err := os.ReadFile(caValue)
+		if err != nil {
+			logger.Error(err, "failed to read CA certificate from file, proceeding without custom CA", "ca_file", caValue)
+		} else {
+			// Start with the host system pool when possible so we don't drop system roots
+			var certPool *x509.CertPool
+			if systemPool, err := x509.SystemCertPool(); err == nil && systemPool != nil {
+				certPool = systemPool
+			} else {
+				certPool = x509.NewCertPool()
+			}
+			if ok := certPool.AppendCertsFromPEM(caPEM); ok {
+				tlsOpts = append(tlsOpts, tlsutil.WithRootCAs(certPool))
+			} else {
+				logger.V(0).Info("failed to append provided certificate authority; proceeding without custom CA")
+			}
+		}
+	}
+
+	tlsConfig, err := tlsutil.BuildTLSConfig(a.tlsMinVersion, a.tlsCipherSuites, tlsOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build TLS config: %w", err)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	if a.requireTLS == nil {
+		return client, nil
+	}
+	return config.NewTLSEnforcingClient(client, a.requireTLS), nil
+}
+
+// authorizationHeader returns the Authorization header for Central: the API
+// token from api_token_file when configured, otherwise the Kubernetes bearer
+// token.
+func (a *ACS) authorizationHeader() (string, error) {
+	if tokenFile := strings.TrimSpace(a.apiTokenFile); tokenFile != "" {
+		token, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read ACS API token file: %w", err)
+		}
+		return "Bearer " + strings.TrimSpace(string(token)), nil
+	}
+	if token := strings.TrimSpace(a.bearerToken); token != "" {
+		return "Bearer " + token, nil
+	}
+	return "", nil
+}
+
+// maxResponseBodySize is the maximum number of bytes read from an ACS API
+// response. Responses exceeding this limit are truncated to prevent unbounded
+// memory consumption from a misbehaving or compromised upstream server.
+const maxResponseBodySize = 512 << 10 // 512 KiB
+
+// ExecuteRequest performs a GET request against the Central API and returns
+// the raw JSON response body.
+func (a *ACS) ExecuteRequest(ctx context.Context, endpoint string, query url.Values) (string, error) {
+	apiCallURL, err := a.validateAndGetURL(endpoint, query)
+	if err != nil {
+		return "", err
+	}
+
+	klogutil.FromContext(ctx).V(0).Info("ACS API call", "url.full", apiCallURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiCallURL, nil)
+	if err != nil {
+		return "", err
+	}
+	authHeader, err := a.authorizationHeader()
+	if err != nil {
+		return "", err
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+	req.Header.Set("Accept", "application/json")
+	client, err := a.createHTTPClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+	if int64(len(respBody)) > maxResponseBodySize {
+		return "", fmt.Errorf("ACS API response exceeded maximum allowed size of %d bytes", maxResponseBodySize)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if len(respBody) > 0 {
+			return "", fmt.Errorf("ACS API error: %s", strings.TrimSpace(string(respBody)))
+		}
+		return "", fmt.Errorf("ACS API error: status %d", resp.StatusCode)
+	}
+	return string(respBody), nil
+}
@@ -0,0 +1,89 @@
+package acs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// Config holds the Advanced Cluster Security (ACS) toolset configuration
+type Config struct {
+	// Url is the base URL of the ACS Central API endpoint.
+	Url string `toml:"url"`
+	// ApiTokenFile points at a file containing an ACS API token. Central
+	// uses its own token auth; the Kubernetes bearer token is only used as
+	// a fallback for deployments that front Central with an auth proxy.
+	ApiTokenFile         string `toml:"api_token_file,omitempty"`
+	Insecure             bool   `toml:"insecure,omitempty"`
+	CertificateAuthority string `toml:"certificate_authority,omitempty"`
+}
+
+var _ api.ExtendedConfig = (*Config)(nil)
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return errors.New("acs config is nil")
+	}
+	if c.Url == "" {
+		return errors.New("url is required")
+	}
+	if u, err := url.Parse(c.Url); err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.New("url must be a valid URL")
+	}
+	u, _ := url.Parse(c.Url)
+	if strings.EqualFold(u.Scheme, "https") && !c.Insecure && strings.TrimSpace(c.CertificateAuthority) == "" {
+		return errors.New("certificate_authority is required for https when insecure is false")
+	}
+	// Validate that certificate_authority is a valid file
+	if caValue := strings.TrimSpace(c.CertificateAuthority); caValue != "" {
+		if _, err := os.Stat(caValue); err != nil {
+			return fmt.Errorf("certificate_authority must be a valid file path: %w", err)
+		}
+	}
+	if tokenFile := strings.TrimSpace(c.ApiTokenFile); tokenFile != "" {
+		if _, err := os.Stat(tokenFile); err != nil {
+			return fmt.Errorf("api_token_file must be a valid file path: %w", err)
+		}
+	}
+	return nil
+}
+
+func acsToolsetParser(ctx context.Context, primitive toml.Primitive, md toml.MetaData) (api.ExtendedConfig, error) {
+	var cfg Config
+	if err := md.PrimitiveDecode(primitive, &cfg); err != nil {
+		return nil, err
+	}
+
+	// Resolve file paths relative to the config directory when they are not absolute
+	configDir := config.ConfigDirPathFromContext(ctx)
+	if cfg.CertificateAuthority != "" && configDir != "" && !filepath.IsAbs(cfg.CertificateAuthority) {
+		cfg.CertificateAuthority = filepath.Join(configDir, cfg.CertificateAuthority)
+	}
+	if cfg.ApiTokenFile != "" && configDir != "" && !filepath.IsAbs(cfg.ApiTokenFile) {
+		cfg.ApiTokenFile = filepath.Join(configDir, cfg.ApiTokenFile)
+	}
+
+	// Validate TLS settings when require_tls is enabled
+	if config.RequireTLSFromContext(ctx) {
+		if err := config.ValidateURLRequiresTLS(cfg.Url, "ACS Central URL"); err != nil {
+			return nil, err
+		}
+		if cfg.Insecure {
+			return nil, errors.New("require_tls is enabled but ACS insecure=true disables certificate verification")
+		}
+	}
+
+	return &cfg, nil
+}
+
+func init() {
+	config.RegisterToolsetConfig("acs", acsToolsetParser)
+}
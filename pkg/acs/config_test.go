@@ -0,0 +1,123 @@
+package acs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigSuite struct {
+	suite.Suite
+	tempDir   string
+	caFile    string
+	tokenFile string
+}
+
+func (s *ConfigSuite) SetupTest() {
+	// Create a test CA certificate and API token file
+	s.tempDir = s.T().TempDir()
+	s.caFile = filepath.Join(s.tempDir, "ca.crt")
+	s.Require().NoError(os.WriteFile(s.caFile, []byte("test ca content"), 0644), "Failed to write CA file")
+	s.tokenFile = filepath.Join(s.tempDir, "token")
+	s.Require().NoError(os.WriteFile(s.tokenFile, []byte("test-token"), 0600), "Failed to write token file")
+}
+
+func (s *ConfigSuite) TestConfigParser_ResolvesRelativePaths() {
+	// Read config with configDirPath set to tempDir to resolve relative paths
+	cfg := test.Must(config.ReadToml([]byte(`
+		[toolset_configs.acs]
+		url = "https://central.example/"
+		certificate_authority = "ca.crt"
+		api_token_file = "token"
+	`), config.WithDirPath(s.tempDir)))
+
+	acsCfg, ok := cfg.GetToolsetConfig("acs")
+	s.Require().True(ok, "ACS config should be present")
+	acfg, ok := acsCfg.(*Config)
+	s.Require().True(ok, "ACS config should be of type *Config")
+
+	s.Equal(s.caFile, acfg.CertificateAuthority, "Relative certificate_authority should be resolved to absolute path")
+	s.Equal(s.tokenFile, acfg.ApiTokenFile, "Relative api_token_file should be resolved to absolute path")
+}
+
+func (s *ConfigSuite) TestConfigParser_RejectsInvalidTokenFile() {
+	nonExistentFileForTOML := filepath.ToSlash(filepath.Join(s.tempDir, "non-existent-token"))
+
+	cfg, err := config.ReadToml([]byte(`
+		[toolset_configs.acs]
+		url = "http://central.example/"
+		api_token_file = "` + nonExistentFileForTOML + `"
+	`))
+
+	s.Require().Error(err, "Validate should reject invalid token file path")
+	s.Contains(err.Error(), "api_token_file must be a valid file path", "Error message should indicate token file path is invalid")
+	s.Nil(cfg, "Config should be nil when validation fails")
+}
+
+func (s *ConfigSuite) TestConfigParser_RejectsInsecureWithRequireTLS() {
+	caFileForTOML := filepath.ToSlash(s.caFile)
+
+	_, err := config.ReadToml([]byte(`
+		require_tls = true
+		[toolset_configs.acs]
+		url = "https://central.example/"
+		insecure = true
+		certificate_authority = "` + caFileForTOML + `"
+	`))
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "insecure=true disables certificate verification")
+}
+
+func (s *ConfigSuite) TestValidate() {
+	s.Run("nil config returns error", func() {
+		var cfg *Config
+		err := cfg.Validate()
+		s.Error(err, "Expected error for nil config")
+		s.ErrorContains(err, "acs config is nil")
+	})
+	s.Run("empty URL returns error", func() {
+		cfg := &Config{}
+		err := cfg.Validate()
+		s.Error(err, "Expected error for empty URL")
+		s.ErrorContains(err, "url is required")
+	})
+	s.Run("invalid URL returns error", func() {
+		cfg := &Config{Url: "://bad-url"}
+		err := cfg.Validate()
+		s.Error(err, "Expected error for invalid URL")
+		s.ErrorContains(err, "url must be a valid URL")
+	})
+	s.Run("HTTP URL does not require certificate_authority", func() {
+		cfg := &Config{Url: "http://central.example/"}
+		err := cfg.Validate()
+		s.NoError(err, "HTTP URL should not require certificate_authority")
+	})
+	s.Run("HTTPS with insecure=false requires certificate_authority", func() {
+		cfg := &Config{Url: "https://central.example/", Insecure: false}
+		err := cfg.Validate()
+		s.Error(err, "Expected error for HTTPS without cert when not insecure")
+		s.ErrorContains(err, "certificate_authority is required for https when insecure is false")
+	})
+	s.Run("HTTPS with insecure=false and valid certificate_authority passes", func() {
+		cfg := &Config{
+			Url:                  "https://central.example/",
+			CertificateAuthority: s.caFile,
+		}
+		err := cfg.Validate()
+		s.NoError(err, "HTTPS with valid certificate_authority should pass validation")
+	})
+	s.Run("valid api_token_file passes", func() {
+		cfg := &Config{Url: "http://central.example/", ApiTokenFile: s.tokenFile}
+		err := cfg.Validate()
+		s.NoError(err, "Valid api_token_file should pass validation")
+	})
+}
+
+func TestConfig(t *testing.T) {
+	suite.Run(t, new(ConfigSuite))
+}
@@ -109,6 +109,37 @@ type RequireOAuthProvider interface {
 	IsRequireOAuth() bool
 }
 
+// ListMaxObjectsProvider provides access to the list_max_objects setting,
+// which caps the number of objects a list tool call may decode into memory.
+// Zero means no cap.
+type ListMaxObjectsProvider interface {
+	GetListMaxObjects() int64
+}
+
+// Snapshot modes define whether the server records Kubernetes API responses
+// to a snapshot file or replays a previously recorded snapshot without
+// contacting a cluster.
+const (
+	SnapshotModeRecord = "record"
+	SnapshotModeReplay = "replay"
+)
+
+// SnapshotProvider provides access to the snapshot record/replay settings.
+type SnapshotProvider interface {
+	// GetSnapshotMode returns the snapshot mode ("record" or "replay").
+	// Empty means snapshots are disabled.
+	GetSnapshotMode() string
+	// GetSnapshotPath returns the snapshot file path.
+	GetSnapshotPath() string
+}
+
+// BackupBeforeDeleteProvider provides access to the backup_before_delete
+// setting, which makes delete tools return the full object YAML before
+// deleting it.
+type BackupBeforeDeleteProvider interface {
+	IsBackupBeforeDelete() bool
+}
+
 type BaseConfig interface {
 	ClusterAuthProvider
 	ClusterProvider
@@ -122,4 +153,7 @@ type BaseConfig interface {
 	RequireTLSProvider
 	TLSConfigProvider
 	RequireOAuthProvider
+	ListMaxObjectsProvider
+	BackupBeforeDeleteProvider
+	SnapshotProvider
 }
@@ -20,6 +20,10 @@ import (
 type ListOptions struct {
 	metav1.ListOptions
 	AsTable bool
+	// MaxObjects, when greater than zero, caps the number of objects decoded
+	// into memory: the listing is paginated server-side and aborts with an
+	// error once the cap is exceeded.
+	MaxObjects int64
 }
 
 // PodsTopOptions contains options for getting pod metrics.
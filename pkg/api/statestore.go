@@ -0,0 +1,22 @@
+package api
+
+import "context"
+
+// OperationStateStore is a key-value store for long-running operation state
+// (plan identifiers, watch cursors, collected must-gather chunks). Values are
+// opaque byte slices; callers serialize their own state (typically JSON).
+// Backends live in the statestore package; with a persistent backend the
+// state survives a server restart or an HA replica switch.
+type OperationStateStore interface {
+	// Put stores value under key, overwriting any previous value.
+	Put(ctx context.Context, key string, value []byte) error
+	// Get returns the value stored under key; found is false when the key
+	// is absent.
+	Get(ctx context.Context, key string) (value []byte, found bool, err error)
+	// Delete removes key. Deleting an absent key is not an error.
+	Delete(ctx context.Context, key string) error
+	// Keys returns all stored keys that start with prefix, sorted.
+	Keys(ctx context.Context, prefix string) ([]string, error)
+	// Close releases any resources held by the backend.
+	Close() error
+}
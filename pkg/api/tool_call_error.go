@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ToolCallErrorCode classifies a failing tool call so that agent clients can
+// branch on the error type instead of pattern-matching the message text.
+type ToolCallErrorCode string
+
+const (
+	// ToolCallErrorNotFound indicates the target resource (or resource type)
+	// does not exist in the cluster.
+	ToolCallErrorNotFound ToolCallErrorCode = "NotFound"
+	// ToolCallErrorForbidden indicates the cluster rejected the request for
+	// RBAC reasons; retrying with the same credentials will not help.
+	ToolCallErrorForbidden ToolCallErrorCode = "Forbidden"
+	// ToolCallErrorUnauthorized indicates the credentials were rejected
+	// entirely (expired or invalid token).
+	ToolCallErrorUnauthorized ToolCallErrorCode = "Unauthorized"
+	// ToolCallErrorDenied indicates this server's own access control
+	// (denied_resources, read-only mode) blocked the call before it reached
+	// the cluster.
+	ToolCallErrorDenied ToolCallErrorCode = "Denied"
+	// ToolCallErrorValidation indicates the request or manifest failed
+	// validation (schema, invalid field, malformed parameters).
+	ToolCallErrorValidation ToolCallErrorCode = "Validation"
+	// ToolCallErrorConflict indicates a write conflicted with a concurrent
+	// modification or an existing resource; re-read and retry.
+	ToolCallErrorConflict ToolCallErrorCode = "Conflict"
+	// ToolCallErrorTimeout indicates the request timed out or was rate
+	// limited by the cluster.
+	ToolCallErrorTimeout ToolCallErrorCode = "Timeout"
+	// ToolCallErrorUnavailable indicates the cluster (or one of its
+	// aggregated API services) could not be reached.
+	ToolCallErrorUnavailable ToolCallErrorCode = "Unavailable"
+	// ToolCallErrorInternal is the fallback for errors that do not fit any
+	// other category.
+	ToolCallErrorInternal ToolCallErrorCode = "Internal"
+)
+
+// ToolCallErrorCodes lists every code in the taxonomy. Used to validate
+// operator configuration that references error classes by name.
+var ToolCallErrorCodes = []ToolCallErrorCode{
+	ToolCallErrorNotFound,
+	ToolCallErrorForbidden,
+	ToolCallErrorUnauthorized,
+	ToolCallErrorDenied,
+	ToolCallErrorValidation,
+	ToolCallErrorConflict,
+	ToolCallErrorTimeout,
+	ToolCallErrorUnavailable,
+	ToolCallErrorInternal,
+}
+
+// ToolCallError is the structured envelope attached as structuredContent to
+// failing tool calls. The text content keeps the full error message for
+// humans; this envelope carries the machine-readable classification.
+type ToolCallError struct {
+	Code ToolCallErrorCode `json:"code"`
+	// Message mirrors the text content so the envelope is self-contained.
+	Message string `json:"message"`
+	// Retryable is true when retrying the same call may succeed without
+	// changing it first (timeouts, rate limits, conflicts, unavailability).
+	Retryable bool `json:"retryable"`
+	// Group, Kind, and Name identify the target resource when the
+	// underlying Kubernetes Status error carries that detail.
+	Group string `json:"group,omitempty"`
+	Kind  string `json:"kind,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+// NewToolCallError classifies err into the shared taxonomy. It understands
+// Kubernetes API status errors, this server's ValidationError, the access
+// control denial, and context deadline expiry; everything else maps to
+// ToolCallErrorInternal.
+func NewToolCallError(err error) *ToolCallError {
+	e := &ToolCallError{Code: ToolCallErrorInternal, Message: err.Error()}
+
+	var validationErr *ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		switch validationErr.Code {
+		case ErrorCodeResourceNotFound:
+			e.Code = ToolCallErrorNotFound
+		case ErrorCodePermissionDenied:
+			e.Code = ToolCallErrorForbidden
+		default:
+			e.Code = ToolCallErrorValidation
+		}
+	case apierrors.IsNotFound(err):
+		e.Code = ToolCallErrorNotFound
+	case apierrors.IsForbidden(err):
+		e.Code = ToolCallErrorForbidden
+	case apierrors.IsUnauthorized(err):
+		e.Code = ToolCallErrorUnauthorized
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		e.Code = ToolCallErrorValidation
+	case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+		e.Code = ToolCallErrorConflict
+		e.Retryable = apierrors.IsConflict(err)
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err), apierrors.IsTooManyRequests(err):
+		e.Code = ToolCallErrorTimeout
+		e.Retryable = true
+	case apierrors.IsServiceUnavailable(err):
+		e.Code = ToolCallErrorUnavailable
+		e.Retryable = true
+	case errors.Is(err, context.DeadlineExceeded):
+		e.Code = ToolCallErrorTimeout
+		e.Retryable = true
+	case strings.Contains(err.Error(), "resource not allowed:"):
+		// Emitted by AccessControlRoundTripper for denied_resources matches.
+		e.Code = ToolCallErrorDenied
+	}
+
+	var apiStatus apierrors.APIStatus
+	if errors.As(err, &apiStatus) {
+		if details := apiStatus.Status().Details; details != nil {
+			e.Group = details.Group
+			e.Kind = details.Kind
+			e.Name = details.Name
+		}
+	}
+	return e
+}
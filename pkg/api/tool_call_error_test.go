@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type ToolCallErrorSuite struct {
+	suite.Suite
+}
+
+func TestToolCallError(t *testing.T) {
+	suite.Run(t, new(ToolCallErrorSuite))
+}
+
+func (s *ToolCallErrorSuite) TestNewToolCallError() {
+	s.Run("kubernetes status errors", func() {
+		s.Run("classifies NotFound and extracts the target resource", func() {
+			err := apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "nginx")
+			e := NewToolCallError(err)
+			s.Equal(ToolCallErrorNotFound, e.Code)
+			s.False(e.Retryable, "NotFound is not retryable")
+			s.Equal("apps", e.Group)
+			s.Equal("nginx", e.Name)
+		})
+		s.Run("classifies Forbidden as non-retryable", func() {
+			err := apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "top-secret", errors.New("RBAC denied"))
+			e := NewToolCallError(err)
+			s.Equal(ToolCallErrorForbidden, e.Code)
+			s.False(e.Retryable)
+		})
+		s.Run("classifies Unauthorized", func() {
+			e := NewToolCallError(apierrors.NewUnauthorized("token expired"))
+			s.Equal(ToolCallErrorUnauthorized, e.Code)
+		})
+		s.Run("classifies Conflict as retryable", func() {
+			err := apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "cm", errors.New("modified"))
+			e := NewToolCallError(err)
+			s.Equal(ToolCallErrorConflict, e.Code)
+			s.True(e.Retryable, "optimistic concurrency conflicts are retryable")
+		})
+		s.Run("classifies AlreadyExists as non-retryable Conflict", func() {
+			err := apierrors.NewAlreadyExists(schema.GroupResource{Resource: "configmaps"}, "cm")
+			e := NewToolCallError(err)
+			s.Equal(ToolCallErrorConflict, e.Code)
+			s.False(e.Retryable, "retrying the same create will fail again")
+		})
+		s.Run("classifies TooManyRequests as retryable Timeout", func() {
+			e := NewToolCallError(apierrors.NewTooManyRequestsError("throttled"))
+			s.Equal(ToolCallErrorTimeout, e.Code)
+			s.True(e.Retryable)
+		})
+		s.Run("classifies ServiceUnavailable as retryable Unavailable", func() {
+			e := NewToolCallError(apierrors.NewServiceUnavailable("down"))
+			s.Equal(ToolCallErrorUnavailable, e.Code)
+			s.True(e.Retryable)
+		})
+		s.Run("classifies Invalid as Validation", func() {
+			err := apierrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "bad-pod", nil)
+			s.Equal(ToolCallErrorValidation, NewToolCallError(err).Code)
+		})
+	})
+
+	s.Run("server-side errors", func() {
+		s.Run("maps ValidationError resource-not-found code", func() {
+			err := &ValidationError{Code: ErrorCodeResourceNotFound, Message: "no such resource"}
+			s.Equal(ToolCallErrorNotFound, NewToolCallError(err).Code)
+		})
+		s.Run("maps ValidationError permission-denied code to Forbidden", func() {
+			s.Equal(ToolCallErrorForbidden, NewToolCallError(NewPermissionDeniedError("get", "secrets", "default")).Code)
+		})
+		s.Run("maps other ValidationError codes to Validation", func() {
+			err := &ValidationError{Code: ErrorCodeInvalidField, Message: "bad field"}
+			s.Equal(ToolCallErrorValidation, NewToolCallError(err).Code)
+		})
+		s.Run("classifies access control denials as Denied", func() {
+			err := fmt.Errorf("resource not allowed: /v1, Kind=Secret")
+			s.Equal(ToolCallErrorDenied, NewToolCallError(err).Code)
+		})
+	})
+
+	s.Run("edge cases", func() {
+		s.Run("classifies wrapped deadline expiry as retryable Timeout", func() {
+			e := NewToolCallError(fmt.Errorf("failed to list pods: %w", context.DeadlineExceeded))
+			s.Equal(ToolCallErrorTimeout, e.Code)
+			s.True(e.Retryable)
+		})
+		s.Run("falls back to Internal for unclassified errors", func() {
+			e := NewToolCallError(errors.New("something broke"))
+			s.Equal(ToolCallErrorInternal, e.Code)
+			s.False(e.Retryable)
+		})
+		s.Run("mirrors the message in the envelope", func() {
+			s.Equal("something broke", NewToolCallError(errors.New("something broke")).Message)
+		})
+	})
+}
@@ -69,6 +69,13 @@ type ToolCallResult struct {
 	// When set, it is passed as structuredContent in the MCP CallToolResult alongside Content.
 	// Must be completely omitted (nil) when not used.
 	StructuredContent any
+	// Blob is optional binary content returned alongside Content. When set,
+	// it is delivered as an embedded MCP resource (base64-encoded on the wire)
+	// so binary payloads such as compressed logs reach the client losslessly.
+	Blob []byte
+	// BlobMIMEType describes the Blob content (e.g. "application/gzip").
+	// Only meaningful when Blob is set.
+	BlobMIMEType string
 	// Error (non-protocol) to send back to the LLM.
 	Error error
 }
@@ -91,6 +98,20 @@ func NewToolCallResultFull(text string, structured any, err error) *ToolCallResu
 	}
 }
 
+// NewToolCallResultBlob creates a ToolCallResult carrying binary content.
+// The text content should summarize the blob (size, encoding, how to use it)
+// for clients that cannot consume embedded resources; the blob itself is
+// delivered losslessly as a base64-encoded embedded MCP resource with the
+// given MIME type.
+func NewToolCallResultBlob(content string, blob []byte, mimeType string, err error) *ToolCallResult {
+	return &ToolCallResult{
+		Content:      content,
+		Blob:         blob,
+		BlobMIMEType: mimeType,
+		Error:        err,
+	}
+}
+
 // NewToolCallResultStructured creates a ToolCallResult with structured content.
 // The structured value is automatically JSON-serialized into the Content field
 // for backward compatibility with MCP clients that don't support structuredContent.
@@ -170,6 +191,9 @@ type ToolHandlerParams struct {
 	ToolCallRequest
 	ListOutput output.Output
 	Elicitor
+	// OperationState persists long-running operation state across tool
+	// calls — and, with a persistent backend, across server restarts.
+	OperationState OperationStateStore
 }
 
 type ToolHandlerFunc func(params ToolHandlerParams) (*ToolCallResult, error)
@@ -16,6 +16,7 @@ import (
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+	"github.com/containers/kubernetes-mcp-server/pkg/mcplog"
 	"github.com/containers/kubernetes-mcp-server/pkg/output"
 	"github.com/containers/kubernetes-mcp-server/pkg/tlsutil"
 	"github.com/containers/kubernetes-mcp-server/pkg/tokenexchange"
@@ -35,6 +36,19 @@ type ToolOverride struct {
 	Description string `toml:"description,omitempty"`
 }
 
+// LogNotificationRule narrows the MCP logging notifications emitted for one
+// toolset's failing tool calls.
+type LogNotificationRule struct {
+	// Classes limits notifications to these error classes (tool call error
+	// taxonomy codes such as "NotFound" or "Forbidden"). Empty means every
+	// classified error notifies.
+	Classes []string `toml:"classes,omitempty"`
+	// Level replaces the classifier-chosen notification level for matching
+	// errors. Valid values are the MCP logging levels ("debug" through
+	// "emergency"). Empty keeps the classifier's level.
+	Level string `toml:"level,omitempty"`
+}
+
 // StaticConfig is the configuration for the server.
 // It allows to configure server specific settings and tools to be enabled or disabled.
 type StaticConfig struct {
@@ -54,6 +68,11 @@ type StaticConfig struct {
 	// and prompt updates, requiring clients to manually refresh their tool/prompt lists.
 	// Defaults to false (stateful mode with notifications enabled).
 	Stateless bool `toml:"stateless,omitempty"`
+	// DisableSSE turns off the legacy HTTP+SSE transport endpoints (/sse and
+	// /message), leaving only the streamable HTTP transport at /mcp. The
+	// legacy endpoints are kept enabled by default for older MCP clients that
+	// only speak the deprecated transport.
+	DisableSSE bool `toml:"disable_sse,omitempty"`
 	// When true, expose only tools annotated with readOnlyHint=true
 	ReadOnly bool `toml:"read_only,omitempty"`
 	// When true, disable tools annotated with destructiveHint=true
@@ -167,6 +186,65 @@ type StaticConfig struct {
 	// Defaults to false.
 	ValidationEnabled bool `toml:"validation_enabled,omitempty"`
 
+	// BackupBeforeDelete makes resources_delete and pods_delete return the
+	// full YAML of the object in the tool result before deleting it, so
+	// accidental deletes can be restored with resources_create_or_update.
+	// Callers may override it per call with the backup tool argument.
+	// Defaults to false.
+	BackupBeforeDelete bool `toml:"backup_before_delete,omitempty"`
+
+	// ToolTimeout is the default execution timeout applied to every tool
+	// call; callers may override it per call with the timeoutSeconds tool
+	// argument. Zero (the default) disables the timeout.
+	ToolTimeout Duration `toml:"tool_timeout,omitzero"`
+
+	// ResultAttachmentThreshold is the tool output size in bytes above which
+	// the output is stored as a session-scoped MCP resource and replaced
+	// inline by a preview plus a resource link. Zero (the default) disables
+	// attachments and returns all output inline.
+	ResultAttachmentThreshold int64 `toml:"result_attachment_threshold,omitzero"`
+
+	// ResultDelta, when enabled, memoizes the last successful result of every
+	// read-only *_list tool call per session and argument set, and replaces
+	// the result of a repeated identical call with a compact delta (the
+	// added, changed, and removed objects) instead of the full listing.
+	ResultDelta bool `toml:"result_delta,omitempty"`
+
+	// ProxiedMCPServers lists remote MCP servers whose tools are re-exposed
+	// under a name prefix; see ProxiedMCPServer. The set is fixed for the
+	// process lifetime; changing it requires a restart.
+	ProxiedMCPServers []ProxiedMCPServer `toml:"proxied_mcp_servers,omitempty"`
+
+	// Notifiers lists webhook endpoints that selected server events (tool
+	// call audits, tool errors, scheduled reports) are posted to; see
+	// NotifierConfig. The set is fixed for the process lifetime; changing it
+	// requires a restart.
+	Notifiers []NotifierConfig `toml:"notifiers,omitempty"`
+
+	// Schedules lists periodic background runs of read-only tools whose
+	// latest result is exposed as an MCP resource; see ScheduleConfig. The
+	// set is fixed for the process lifetime; changing it requires a restart.
+	Schedules []ScheduleConfig `toml:"schedules,omitempty"`
+
+	// HA configures the high-availability helpers for multi-replica
+	// deployments; see HAConfig. Disabled by default.
+	HA HAConfig `toml:"ha,omitempty"`
+
+	// StateStore configures the store for long-running operation state; see
+	// StateStoreConfig. The zero value selects the in-process memory backend.
+	StateStore StateStoreConfig `toml:"state_store,omitempty"`
+
+	// Snapshot configures record and replay of Kubernetes API responses for
+	// offline demos and testing; see SnapshotConfig. Disabled by default.
+	Snapshot SnapshotConfig `toml:"snapshot,omitempty"`
+
+	// ListMaxObjects is the maximum number of objects a list tool call may
+	// decode into memory. Listings are paginated server-side and abort with a
+	// clear error once the cap is exceeded, so a cluster-wide list of a large
+	// resource (e.g. Secrets) cannot OOM-kill the server. Zero (the default)
+	// disables the cap.
+	ListMaxObjects int64 `toml:"list_max_objects,omitzero"`
+
 	// EnableTargetCompatibilityToolFilters enables filtering of tools based on
 	// cluster target compatibility (e.g., hiding OpenShift-specific tools when
 	// connected to a non-OpenShift cluster). This feature is experimental, and
@@ -180,6 +258,12 @@ type StaticConfig struct {
 	// ConfirmationRules define rules for prompting the user before dangerous actions.
 	ConfirmationRules []api.ConfirmationRule `toml:"confirmation_rules,omitempty"`
 
+	// LogNotifications configures, per toolset name, which error classes emit
+	// MCP logging notifications for failing tool calls and at what level.
+	// Toolsets without an entry keep the default behavior (every classified
+	// error notifies at the classifier-chosen level).
+	LogNotifications map[string]LogNotificationRule `toml:"log_notifications,omitempty"`
+
 	// Internal: parsed provider configs (not exposed to TOML package)
 	parsedClusterProviderConfigs map[string]api.ExtendedConfig
 	// Internal: parsed toolset configs (not exposed to TOML package)
@@ -257,7 +341,16 @@ func Read(ctx context.Context, configPath, dropInConfigDir string) (*StaticConfi
 		return nil, fmt.Errorf("failed to read and merge config files: %w", err)
 	}
 
-	return ReadToml(configData, WithDirPath(configDir))
+	cfg, err := ReadToml(configData, WithDirPath(configDir))
+	if err != nil {
+		return nil, err
+	}
+	// Environment variables sit between config files and CLI flags in the
+	// precedence order (flags are applied later by the command layer).
+	if err := cfg.ApplyEnvOverrides(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
 }
 
 // loadDropInConfigs loads and merges config files from a drop-in directory.
@@ -466,6 +559,22 @@ func (c *StaticConfig) IsValidationEnabled() bool {
 	return c.ValidationEnabled
 }
 
+func (c *StaticConfig) IsBackupBeforeDelete() bool {
+	return c.BackupBeforeDelete
+}
+
+func (c *StaticConfig) GetListMaxObjects() int64 {
+	return c.ListMaxObjects
+}
+
+func (c *StaticConfig) GetSnapshotMode() string {
+	return c.Snapshot.Mode
+}
+
+func (c *StaticConfig) GetSnapshotPath() string {
+	return c.Snapshot.Path
+}
+
 func (c *StaticConfig) IsTargetCompatibilityToolFiltersEnabled() bool {
 	return c.EnableTargetCompatibilityToolFilters
 }
@@ -605,9 +714,71 @@ func (c *StaticConfig) Validate(ctx context.Context) error {
 	if err := c.validateConfirmation(); err != nil {
 		return err
 	}
+	if err := c.validateLogNotifications(); err != nil {
+		return err
+	}
+	if c.ToolTimeout.Duration() < 0 {
+		return fmt.Errorf("tool_timeout must not be negative")
+	}
+	if c.ResultAttachmentThreshold < 0 {
+		return fmt.Errorf("result_attachment_threshold must not be negative")
+	}
+	if c.ListMaxObjects < 0 {
+		return fmt.Errorf("list_max_objects must not be negative")
+	}
 	if err := c.HTTP.Validate(); err != nil {
 		return err
 	}
+	if err := c.StateStore.Validate(); err != nil {
+		return err
+	}
+	if err := c.Snapshot.Validate(); err != nil {
+		return err
+	}
+	if err := c.HA.Validate(); err != nil {
+		return err
+	}
+	proxiedNames := make(map[string]bool, len(c.ProxiedMCPServers))
+	for i := range c.ProxiedMCPServers {
+		server := &c.ProxiedMCPServers[i]
+		if err := server.Validate(); err != nil {
+			return fmt.Errorf("proxied_mcp_servers[%d]: %w", i, err)
+		}
+		if proxiedNames[server.Name] {
+			return fmt.Errorf("proxied_mcp_servers[%d]: duplicate name %q", i, server.Name)
+		}
+		proxiedNames[server.Name] = true
+	}
+	notifierNames := make(map[string]bool, len(c.Notifiers))
+	for i := range c.Notifiers {
+		notifier := &c.Notifiers[i]
+		if err := notifier.Validate(); err != nil {
+			return fmt.Errorf("notifiers[%d]: %w", i, err)
+		}
+		if notifierNames[notifier.Name] {
+			return fmt.Errorf("notifiers[%d]: duplicate name %q", i, notifier.Name)
+		}
+		notifierNames[notifier.Name] = true
+		if c.RequireTLS {
+			if err := ValidateURLRequiresTLS(notifier.URL, fmt.Sprintf("notifiers[%d].url", i)); err != nil {
+				return err
+			}
+		}
+	}
+	scheduleNames := make(map[string]bool, len(c.Schedules))
+	for i := range c.Schedules {
+		schedule := &c.Schedules[i]
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("schedules[%d]: %w", i, err)
+		}
+		if scheduleNames[schedule.Name] {
+			return fmt.Errorf("schedules[%d]: duplicate name %q", i, schedule.Name)
+		}
+		scheduleNames[schedule.Name] = true
+	}
+	if c.HTTP.SessionPersistence && c.Stateless {
+		return fmt.Errorf("session_persistence is incompatible with stateless mode")
+	}
 	return nil
 }
 
@@ -631,6 +802,24 @@ func (c *StaticConfig) validateConfirmation() error {
 	return nil
 }
 
+// validateLogNotifications checks that every log_notifications rule references
+// known error classes and a known MCP logging level.
+func (c *StaticConfig) validateLogNotifications() error {
+	for toolset, rule := range c.LogNotifications {
+		for _, class := range rule.Classes {
+			if !slices.Contains(api.ToolCallErrorCodes, api.ToolCallErrorCode(class)) {
+				return fmt.Errorf("log_notifications.%s: invalid error class %q", toolset, class)
+			}
+		}
+		if rule.Level != "" {
+			if _, ok := mcplog.ParseLevel(rule.Level); !ok {
+				return fmt.Errorf("log_notifications.%s: invalid level %q", toolset, rule.Level)
+			}
+		}
+	}
+	return nil
+}
+
 // validateSkipJWTVerification checks that the user has explicitly opted in to
 // skipping JWT signature verification when require_oauth is enabled but no
 // authorization_url is configured.
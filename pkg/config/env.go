@@ -0,0 +1,83 @@
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// EnvPrefix is the prefix of environment variables that override StaticConfig
+// options. Every scalar TOML option can be overridden via MCP_SERVER_<OPTION>
+// where <OPTION> is the TOML key upper-cased, e.g. MCP_SERVER_READ_ONLY=true,
+// MCP_SERVER_LIST_OUTPUT=yaml, or MCP_SERVER_TOOLSETS=core,config.
+const EnvPrefix = "MCP_SERVER_"
+
+// ApplyEnvOverrides overrides configuration fields from MCP_SERVER_*
+// environment variables. Precedence, lowest to highest: built-in defaults,
+// TOML files (main config then drop-ins), environment variables, CLI flags.
+//
+// String, bool, integer, duration, and []string (comma-separated) options are
+// supported.
+// Structured sections (tool_overrides, toolset_configs, prompts, ...) can
+// only be set through configuration files.
+func (c *StaticConfig) ApplyEnvOverrides() error {
+	value := reflect.ValueOf(c).Elem()
+	structType := value.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		tag := strings.Split(structType.Field(i).Tag.Get("toml"), ",")[0]
+		if tag == "" || tag == "-" {
+			continue
+		}
+		envName := EnvPrefix + strings.ToUpper(tag)
+		envValue, ok := os.LookupEnv(envName)
+		if !ok {
+			continue
+		}
+		target := value.Field(i)
+		switch target.Kind() {
+		case reflect.String:
+			target.SetString(envValue)
+		case reflect.Bool:
+			parsed, err := strconv.ParseBool(envValue)
+			if err != nil {
+				return fmt.Errorf("invalid boolean value for %s: %q", envName, envValue)
+			}
+			target.SetBool(parsed)
+		case reflect.Int:
+			parsed, err := strconv.Atoi(envValue)
+			if err != nil {
+				return fmt.Errorf("invalid integer value for %s: %q", envName, envValue)
+			}
+			target.SetInt(int64(parsed))
+		case reflect.Int64:
+			// Duration-typed options (tool_timeout, ...) parse the same way
+			// they do in TOML, via their TextUnmarshaler (e.g. "30s").
+			if unmarshaler, ok := target.Addr().Interface().(encoding.TextUnmarshaler); ok {
+				if err := unmarshaler.UnmarshalText([]byte(envValue)); err != nil {
+					return fmt.Errorf("invalid duration value for %s: %q", envName, envValue)
+				}
+				continue
+			}
+			parsed, err := strconv.ParseInt(envValue, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid integer value for %s: %q", envName, envValue)
+			}
+			target.SetInt(parsed)
+		case reflect.Slice:
+			if target.Type().Elem().Kind() != reflect.String {
+				continue
+			}
+			parts := strings.Split(envValue, ",")
+			for j := range parts {
+				parts[j] = strings.TrimSpace(parts[j])
+			}
+			target.Set(reflect.ValueOf(parts))
+		default:
+			// Structured options (maps, nested sections) are file-only.
+		}
+	}
+	return nil
+}
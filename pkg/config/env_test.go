@@ -0,0 +1,132 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EnvOverridesSuite struct {
+	BaseConfigSuite
+}
+
+func (s *EnvOverridesSuite) TestApplyEnvOverrides() {
+	s.Run("string option", func() {
+		s.T().Setenv("MCP_SERVER_LIST_OUTPUT", "yaml")
+		cfg := BaseDefault()
+		s.Require().NoError(cfg.ApplyEnvOverrides())
+		s.Equal("yaml", cfg.ListOutput)
+	})
+	s.Run("boolean option", func() {
+		s.T().Setenv("MCP_SERVER_READ_ONLY", "true")
+		cfg := BaseDefault()
+		s.Require().NoError(cfg.ApplyEnvOverrides())
+		s.True(cfg.ReadOnly)
+	})
+	s.Run("integer option", func() {
+		s.T().Setenv("MCP_SERVER_LOG_LEVEL", "5")
+		cfg := BaseDefault()
+		s.Require().NoError(cfg.ApplyEnvOverrides())
+		s.Equal(5, cfg.LogLevel)
+	})
+	s.Run("int64 option", func() {
+		s.T().Setenv("MCP_SERVER_RESULT_ATTACHMENT_THRESHOLD", "4096")
+		cfg := BaseDefault()
+		s.Require().NoError(cfg.ApplyEnvOverrides())
+		s.Equal(int64(4096), cfg.ResultAttachmentThreshold)
+	})
+	s.Run("int64 option (list_max_objects)", func() {
+		s.T().Setenv("MCP_SERVER_LIST_MAX_OBJECTS", "50")
+		cfg := BaseDefault()
+		s.Require().NoError(cfg.ApplyEnvOverrides())
+		s.Equal(int64(50), cfg.ListMaxObjects)
+	})
+	s.Run("duration option", func() {
+		s.T().Setenv("MCP_SERVER_TOOL_TIMEOUT", "30s")
+		cfg := BaseDefault()
+		s.Require().NoError(cfg.ApplyEnvOverrides())
+		s.Equal(30*time.Second, cfg.ToolTimeout.Duration())
+	})
+	s.Run("comma-separated list option", func() {
+		s.T().Setenv("MCP_SERVER_TOOLSETS", "core, config ,helm")
+		cfg := BaseDefault()
+		s.Require().NoError(cfg.ApplyEnvOverrides())
+		s.Equal([]string{"core", "config", "helm"}, cfg.Toolsets)
+	})
+	s.Run("unset variables leave defaults untouched", func() {
+		cfg := BaseDefault()
+		s.Require().NoError(cfg.ApplyEnvOverrides())
+		s.Equal(BaseDefault().ListOutput, cfg.ListOutput)
+	})
+}
+
+func (s *EnvOverridesSuite) TestApplyEnvOverridesInvalidValues() {
+	s.Run("invalid boolean is rejected", func() {
+		s.T().Setenv("MCP_SERVER_READ_ONLY", "not-a-bool")
+		cfg := BaseDefault()
+		err := cfg.ApplyEnvOverrides()
+		s.Require().Error(err)
+		s.Contains(err.Error(), "MCP_SERVER_READ_ONLY")
+	})
+	s.Run("invalid integer is rejected", func() {
+		s.T().Setenv("MCP_SERVER_LOG_LEVEL", "verbose")
+		cfg := BaseDefault()
+		err := cfg.ApplyEnvOverrides()
+		s.Require().Error(err)
+		s.Contains(err.Error(), "MCP_SERVER_LOG_LEVEL")
+	})
+	s.Run("invalid int64 is rejected", func() {
+		s.T().Setenv("MCP_SERVER_LIST_MAX_OBJECTS", "many")
+		cfg := BaseDefault()
+		err := cfg.ApplyEnvOverrides()
+		s.Require().Error(err)
+		s.Contains(err.Error(), "MCP_SERVER_LIST_MAX_OBJECTS")
+	})
+	s.Run("invalid duration is rejected", func() {
+		s.T().Setenv("MCP_SERVER_TOOL_TIMEOUT", "soon")
+		cfg := BaseDefault()
+		err := cfg.ApplyEnvOverrides()
+		s.Require().Error(err)
+		s.Contains(err.Error(), "MCP_SERVER_TOOL_TIMEOUT")
+	})
+}
+
+func (s *EnvOverridesSuite) TestReadAppliesEnvOverrides() {
+	s.Run("environment variables take precedence over config files", func() {
+		s.T().Setenv("MCP_SERVER_LIST_OUTPUT", "yaml")
+		path := s.writeConfig(`
+			list_output = "table"
+			read_only = true
+		`)
+		cfg, err := Read(s.T().Context(), path, "")
+		s.Require().NoError(err)
+		s.Equal("yaml", cfg.ListOutput, "env var should override the config file value")
+		s.True(cfg.ReadOnly, "options without env overrides keep their file values")
+	})
+}
+
+func (s *EnvOverridesSuite) TestRedacted() {
+	s.Run("masks sts_client_secret", func() {
+		cfg := BaseDefault()
+		cfg.StsClientSecret = "super-secret"
+		s.Equal("(redacted)", cfg.Redacted().StsClientSecret)
+	})
+	s.Run("leaves the original configuration untouched", func() {
+		cfg := BaseDefault()
+		cfg.StsClientSecret = "super-secret"
+		_ = cfg.Redacted()
+		s.Equal("super-secret", cfg.StsClientSecret)
+	})
+	s.Run("renders as TOML", func() {
+		cfg := BaseDefault()
+		cfg.ListOutput = "yaml"
+		rendered, err := cfg.Redacted().TOML()
+		s.Require().NoError(err)
+		s.Contains(rendered, `list_output = "yaml"`)
+	})
+}
+
+func TestEnvOverrides(t *testing.T) {
+	suite.Run(t, new(EnvOverridesSuite))
+}
@@ -0,0 +1,39 @@
+package config
+
+import "fmt"
+
+// DefaultHALeaseName is the default coordination.k8s.io Lease name used for
+// leader election when ha is enabled and lease_name is not specified.
+const DefaultHALeaseName = "kubernetes-mcp-server-leader"
+
+// HAConfig contains the high-availability options for running multiple
+// replicas behind a Route or load balancer. When enabled, the server
+// publishes a session affinity cookie so routers can stick a client to the
+// replica holding its session, and elects a leader to run the cluster-wide
+// watches so they don't run once per replica.
+type HAConfig struct {
+	// Enabled turns on the HA helpers: the session affinity cookie and
+	// leader-elected cluster-state watches.
+	Enabled bool `toml:"enabled,omitempty"`
+
+	// LeaseName is the name of the coordination.k8s.io Lease used for
+	// leader election. When empty, DefaultHALeaseName applies.
+	LeaseName string `toml:"lease_name,omitempty"`
+
+	// LeaseNamespace is the namespace of that Lease. Required when enabled.
+	// The server's ServiceAccount needs get, create, and update on Leases
+	// in it.
+	LeaseNamespace string `toml:"lease_namespace,omitempty"`
+
+	// Identity is this replica's leader election identity. When empty, the
+	// hostname (the pod name in a Kubernetes deployment) is used.
+	Identity string `toml:"identity,omitempty"`
+}
+
+// Validate checks HAConfig for invalid values.
+func (c *HAConfig) Validate() error {
+	if c.Enabled && c.LeaseNamespace == "" {
+		return fmt.Errorf("ha requires lease_namespace")
+	}
+	return nil
+}
@@ -1,11 +1,23 @@
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // DefaultRateLimitBurst is the default burst size used when rate_limit_rps is
 // set but rate_limit_burst is not specified (zero value).
 const DefaultRateLimitBurst = 10
 
+// DefaultSessionTTL is the default idle retention for persisted sessions when
+// session_persistence is enabled and session_ttl is not specified.
+const DefaultSessionTTL = Duration(30 * time.Minute)
+
+// DefaultCompressionMinBytes is the default minimum response size before
+// compression kicks in when compression is enabled and compression_min_bytes
+// is not specified.
+const DefaultCompressionMinBytes = int64(1 << 10)
+
 // HTTPConfig contains HTTP server configuration options for security.
 type HTTPConfig struct {
 	// ReadHeaderTimeout is the amount of time allowed to read request headers.
@@ -27,6 +39,30 @@ type HTTPConfig struct {
 	// Only effective when rate_limit_rps > 0.
 	// When zero, the rate limiting middleware applies DefaultRateLimitBurst.
 	RateLimitBurst int `toml:"rate_limit_burst,omitzero"`
+
+	// Compression enables response compression negotiation (Accept-Encoding)
+	// on the HTTP transports. Large tool results (multi-MB YAML lists)
+	// compress well and transfer noticeably faster to remote clients.
+	// Event streams (SSE and streamable HTTP responses) are never compressed
+	// so streaming latency is unaffected.
+	Compression bool `toml:"compression,omitempty"`
+
+	// CompressionMinBytes is the minimum response size in bytes before
+	// compression kicks in; smaller responses are sent as-is to avoid
+	// wasting CPU on payloads that fit in a packet anyway. Only effective
+	// when compression is true. When zero, DefaultCompressionMinBytes applies.
+	CompressionMinBytes int64 `toml:"compression_min_bytes,omitzero"`
+
+	// SessionPersistence enables the server-side event store for the
+	// streamable HTTP transport. Clients that reconnect with a Last-Event-ID
+	// header get the events they missed replayed, so flaky network clients
+	// don't lose long-running operations. Incompatible with stateless mode.
+	SessionPersistence bool `toml:"session_persistence,omitempty"`
+
+	// SessionTTL is how long a persisted session's events are retained after
+	// its last activity before being purged. Only effective when
+	// session_persistence is true. When zero, DefaultSessionTTL applies.
+	SessionTTL Duration `toml:"session_ttl,omitzero"`
 }
 
 // Validate checks HTTPConfig for invalid values.
@@ -38,5 +74,11 @@ func (c *HTTPConfig) Validate() error {
 	if c.RateLimitBurst < 0 {
 		return fmt.Errorf("rate_limit_burst must not be negative (got %d)", c.RateLimitBurst)
 	}
+	if c.SessionTTL < 0 {
+		return fmt.Errorf("session_ttl must not be negative (got %v)", c.SessionTTL)
+	}
+	if c.CompressionMinBytes < 0 {
+		return fmt.Errorf("compression_min_bytes must not be negative (got %d)", c.CompressionMinBytes)
+	}
 	return nil
 }
@@ -102,6 +102,37 @@ func (s *HTTPConfigSuite) TestValidate() {
 		cfg := HTTPConfig{RateLimitRPS: 10, RateLimitBurst: 0}
 		s.NoError(cfg.Validate())
 	})
+
+	s.Run("negative session TTL is rejected", func() {
+		cfg := HTTPConfig{SessionTTL: Duration(-time.Minute)}
+		err := cfg.Validate()
+		s.Error(err)
+		s.Contains(err.Error(), "session_ttl must not be negative")
+	})
+
+	s.Run("zero session TTL is valid (uses default)", func() {
+		cfg := HTTPConfig{SessionPersistence: true, SessionTTL: 0}
+		s.NoError(cfg.Validate())
+	})
+}
+
+func (s *HTTPConfigSuite) TestSessionPersistenceParsing() {
+	s.Run("parses session persistence fields", func() {
+		tomlData := []byte(`
+[http]
+session_persistence = true
+session_ttl = "10m"
+`)
+		cfg, err := ReadToml(tomlData)
+		s.Require().NoError(err)
+
+		s.True(cfg.HTTP.SessionPersistence)
+		s.Equal(10*time.Minute, cfg.HTTP.SessionTTL.Duration())
+	})
+
+	s.Run("session persistence is disabled by default", func() {
+		s.False(Default().HTTP.SessionPersistence)
+	})
 }
 
 func (s *HTTPConfigSuite) TestDefaultRateLimitBurst() {
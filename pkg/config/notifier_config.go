@@ -0,0 +1,85 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Notifier event kinds. Each notifier subscribes to a subset of these.
+const (
+	// NotifierEventToolCall is posted after every tool call (an audit entry
+	// with the tool name and outcome; arguments are never included).
+	NotifierEventToolCall = "tool_call"
+	// NotifierEventToolError is posted only for failing tool calls.
+	NotifierEventToolError = "tool_error"
+	// NotifierEventScheduledReport is posted after every scheduled tool run
+	// (see ScheduleConfig).
+	NotifierEventScheduledReport = "scheduled_report"
+)
+
+// NotifierEvents lists the valid notifier event kinds.
+var NotifierEvents = []string{NotifierEventToolCall, NotifierEventToolError, NotifierEventScheduledReport}
+
+// Notifier payload formats.
+const (
+	// NotifierFormatJSON posts the structured event payload as JSON (the
+	// default).
+	NotifierFormatJSON = "json"
+	// NotifierFormatSlack posts a {"text": "<summary>"} body compatible with
+	// Slack (and Mattermost/Discord) incoming webhooks.
+	NotifierFormatSlack = "slack"
+)
+
+var notifierNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`)
+
+// NotifierConfig configures one webhook endpoint that selected server events
+// are posted to, bridging MCP diagnostics into existing alerting.
+type NotifierConfig struct {
+	// Name uniquely identifies the notifier in logs.
+	Name string `toml:"name"`
+	// URL is the webhook endpoint events are POSTed to.
+	URL string `toml:"url"`
+	// Events selects which event kinds this notifier receives; see
+	// NotifierEvents.
+	Events []string `toml:"events"`
+	// Format selects the payload format: "json" (the default) or "slack".
+	Format string `toml:"format,omitempty"`
+	// TokenFile points at a file whose contents are sent as a bearer token
+	// on every post.
+	TokenFile string `toml:"token_file,omitempty"`
+}
+
+// Validate checks a single notifier entry for invalid values.
+func (c *NotifierConfig) Validate() error {
+	if !notifierNameRegexp.MatchString(c.Name) {
+		return fmt.Errorf("invalid name %q: must match %s", c.Name, notifierNameRegexp)
+	}
+	parsed, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url %q: %w", c.URL, err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid url %q: must be an http(s) URL", c.URL)
+	}
+	if len(c.Events) == 0 {
+		return fmt.Errorf("events must list at least one of: %s", strings.Join(NotifierEvents, ", "))
+	}
+	for _, event := range c.Events {
+		if !slices.Contains(NotifierEvents, event) {
+			return fmt.Errorf("invalid event %q: must be one of: %s", event, strings.Join(NotifierEvents, ", "))
+		}
+	}
+	if c.Format != "" && c.Format != NotifierFormatJSON && c.Format != NotifierFormatSlack {
+		return fmt.Errorf("invalid format %q: must be %q or %q", c.Format, NotifierFormatJSON, NotifierFormatSlack)
+	}
+	if tokenFile := strings.TrimSpace(c.TokenFile); tokenFile != "" {
+		if _, err := os.Stat(tokenFile); err != nil {
+			return fmt.Errorf("token_file must be a valid file path: %w", err)
+		}
+	}
+	return nil
+}
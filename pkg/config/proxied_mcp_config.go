@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// proxiedServerNamePattern constrains proxied server names to characters that
+// are safe inside tool names — the name becomes the prefix of every
+// re-exposed tool (e.g. "github" + "_" + "get_issue").
+var proxiedServerNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`)
+
+// ProxiedMCPServer configures one remote MCP server whose tools are
+// re-exposed by this server under a name prefix, giving clients a single
+// consolidated endpoint with consistent auth.
+type ProxiedMCPServer struct {
+	// Name identifies the remote server and prefixes every re-exposed tool
+	// (a remote tool "query" becomes "<name>_query"). Must be unique.
+	Name string `toml:"name"`
+
+	// URL is the remote server's streamable HTTP endpoint.
+	URL string `toml:"url"`
+
+	// AuthPassthrough forwards the caller's Authorization header to the
+	// remote server, so one bearer token authenticates the whole
+	// consolidated endpoint.
+	AuthPassthrough bool `toml:"auth_passthrough,omitempty"`
+}
+
+// Validate checks a single proxied server entry for invalid values.
+func (c *ProxiedMCPServer) Validate() error {
+	if !proxiedServerNamePattern.MatchString(c.Name) {
+		return fmt.Errorf("invalid name %q: must match %s", c.Name, proxiedServerNamePattern)
+	}
+	parsed, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url %q: %w", c.URL, err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid url %q: must be an http(s) URL", c.URL)
+	}
+	return nil
+}
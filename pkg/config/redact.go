@@ -0,0 +1,34 @@
+package config
+
+import (
+	"bytes"
+
+	"github.com/BurntSushi/toml"
+)
+
+// redactedPlaceholder replaces sensitive values in diagnostic output.
+const redactedPlaceholder = "(redacted)"
+
+// Redacted returns a shallow copy of the configuration with sensitive values
+// masked and the raw TOML primitive sections cleared (they cannot be
+// re-encoded and may embed provider credentials). Suitable for printing the
+// effective configuration in diagnostics.
+func (c *StaticConfig) Redacted() *StaticConfig {
+	redacted := *c
+	if redacted.StsClientSecret != "" {
+		redacted.StsClientSecret = redactedPlaceholder
+	}
+	redacted.ToolsetConfigs = nil
+	redacted.ClusterProviderConfigs = nil
+	return &redacted
+}
+
+// TOML renders the configuration as a TOML document, matching the format of
+// the configuration files it was loaded from.
+func (c *StaticConfig) TOML() (string, error) {
+	var buffer bytes.Buffer
+	if err := toml.NewEncoder(&buffer).Encode(c); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
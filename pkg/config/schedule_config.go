@@ -0,0 +1,42 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// scheduleNameRegexp constrains schedule names so they are safe to embed in
+// the scheduled-report:// resource URIs.
+var scheduleNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`)
+
+// ScheduleConfig configures one periodic background run of a read-only tool
+// (e.g. a health check, drift report, or backup verification). The latest
+// result of every schedule is exposed as an MCP resource
+// (scheduled-report://<name>) so dashboards and agents can consume periodic
+// reports without an external cron.
+type ScheduleConfig struct {
+	// Name uniquely identifies the schedule and forms the report resource
+	// URI (scheduled-report://<name>).
+	Name string `toml:"name"`
+	// Tool is the name of the tool to run. Only read-only tools (those with
+	// readOnlyHint) can be scheduled.
+	Tool string `toml:"tool"`
+	// Every is the interval between runs (e.g. "30m", "1h"). The first run
+	// happens right after startup.
+	Every Duration `toml:"every"`
+	// Arguments are passed to the tool on every run.
+	Arguments map[string]any `toml:"arguments,omitempty"`
+}
+
+func (c *ScheduleConfig) Validate() error {
+	if !scheduleNameRegexp.MatchString(c.Name) {
+		return fmt.Errorf("invalid name %q: must match %s", c.Name, scheduleNameRegexp)
+	}
+	if c.Tool == "" {
+		return fmt.Errorf("tool is required")
+	}
+	if c.Every.Duration() <= 0 {
+		return fmt.Errorf("every must be a positive duration")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// SnapshotConfig configures record and replay of Kubernetes API responses.
+// In record mode every non-streaming API response is appended to the snapshot
+// file while tools run against a real cluster; in replay mode recorded
+// responses are served from the snapshot without contacting a cluster. This
+// is useful for offline demos, CI of client integrations, and reproducing
+// customer issues from a captured session. The mode is fixed for the process
+// lifetime; changing it requires a restart.
+type SnapshotConfig struct {
+	// Mode selects "record" or "replay". Empty (the default) disables
+	// snapshots.
+	Mode string `toml:"mode,omitempty"`
+
+	// Path is the snapshot file. Required when a mode is set. In record mode
+	// the file is created if missing and appended to otherwise; in replay
+	// mode it must already exist.
+	Path string `toml:"path,omitempty"`
+}
+
+// Validate checks SnapshotConfig for invalid mode/path combinations and, in
+// replay mode, that the snapshot file exists.
+func (c *SnapshotConfig) Validate() error {
+	switch c.Mode {
+	case "", api.SnapshotModeRecord, api.SnapshotModeReplay:
+	default:
+		return fmt.Errorf("invalid snapshot mode %q: must be %q or %q", c.Mode, api.SnapshotModeRecord, api.SnapshotModeReplay)
+	}
+	if c.Mode != "" && c.Path == "" {
+		return fmt.Errorf("snapshot mode %q requires path", c.Mode)
+	}
+	if c.Mode == "" && c.Path != "" {
+		return fmt.Errorf("snapshot path requires mode %q or %q", api.SnapshotModeRecord, api.SnapshotModeReplay)
+	}
+	if c.Mode == api.SnapshotModeReplay {
+		if _, err := os.Stat(c.Path); err != nil {
+			return fmt.Errorf("snapshot path must be a valid file path: %w", err)
+		}
+	}
+	return nil
+}
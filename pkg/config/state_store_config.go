@@ -0,0 +1,41 @@
+package config
+
+import "fmt"
+
+// State store backend names. The statestore package registers a factory for
+// each; downstream builds may register additional backends (e.g. Redis).
+const (
+	// StateStoreBackendMemory keeps operation state in-process (the default).
+	StateStoreBackendMemory = "memory"
+	// StateStoreBackendConfigMap persists operation state in a ConfigMap so
+	// it survives restarts and is shared between HA replicas.
+	StateStoreBackendConfigMap = "configmap"
+)
+
+// StateStoreConfig configures the store for long-running operation state
+// (plan identifiers, watch cursors, collected must-gather chunks). With a
+// persistent backend, a server restart or HA replica switch doesn't lose
+// in-progress workflows.
+type StateStoreConfig struct {
+	// Backend selects the state store backend. Empty (the default) selects
+	// the in-process memory backend.
+	Backend string `toml:"backend,omitempty"`
+
+	// ConfigMapName is the name of the ConfigMap the configmap backend
+	// persists state in. Required when backend is "configmap".
+	ConfigMapName string `toml:"configmap_name,omitempty"`
+
+	// ConfigMapNamespace is the namespace of that ConfigMap. Required when
+	// backend is "configmap".
+	ConfigMapNamespace string `toml:"configmap_namespace,omitempty"`
+}
+
+// Validate checks StateStoreConfig for structurally invalid values. Backend
+// names are validated against the registered backends at startup, not here,
+// so downstream-registered backends remain configurable.
+func (c *StateStoreConfig) Validate() error {
+	if c.Backend == StateStoreBackendConfigMap && (c.ConfigMapName == "" || c.ConfigMapNamespace == "") {
+		return fmt.Errorf("state_store backend %q requires configmap_name and configmap_namespace", c.Backend)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -698,6 +699,347 @@ func (s *ValidateSuite) TestClusterAuthMode() {
 	})
 }
 
+func (s *ValidateSuite) TestLogNotifications() {
+	s.Run("empty rules are accepted", func() {
+		cfg := s.validConfig()
+		cfg.LogNotifications = nil
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("rule with known classes and level is accepted", func() {
+		cfg := s.validConfig()
+		cfg.LogNotifications = map[string]config.LogNotificationRule{
+			"core": {Classes: []string{"NotFound", "Forbidden"}, Level: "warning"},
+		}
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("unknown error class is rejected", func() {
+		cfg := s.validConfig()
+		cfg.LogNotifications = map[string]config.LogNotificationRule{
+			"core": {Classes: []string{"Nonexistent"}},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "invalid error class")
+	})
+
+	s.Run("unknown level is rejected", func() {
+		cfg := s.validConfig()
+		cfg.LogNotifications = map[string]config.LogNotificationRule{
+			"core": {Level: "verbose"},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "invalid level")
+	})
+}
+
+func (s *ValidateSuite) TestToolTimeout() {
+	s.Run("zero tool_timeout is valid (disabled)", func() {
+		cfg := s.validConfig()
+		cfg.ToolTimeout = 0
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("positive tool_timeout is valid", func() {
+		cfg := s.validConfig()
+		cfg.ToolTimeout = config.Duration(30 * time.Second)
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("negative tool_timeout is rejected", func() {
+		cfg := s.validConfig()
+		cfg.ToolTimeout = config.Duration(-time.Second)
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "tool_timeout must not be negative")
+	})
+}
+
+func (s *ValidateSuite) TestListMaxObjects() {
+	s.Run("zero list_max_objects is valid (disabled)", func() {
+		cfg := s.validConfig()
+		cfg.ListMaxObjects = 0
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("positive list_max_objects is valid", func() {
+		cfg := s.validConfig()
+		cfg.ListMaxObjects = 1000
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("negative list_max_objects is rejected", func() {
+		cfg := s.validConfig()
+		cfg.ListMaxObjects = -1
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "list_max_objects must not be negative")
+	})
+}
+
+func (s *ValidateSuite) TestStateStore() {
+	s.Run("empty state_store is valid (memory backend)", func() {
+		cfg := s.validConfig()
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("configmap backend with name and namespace is valid", func() {
+		cfg := s.validConfig()
+		cfg.StateStore = config.StateStoreConfig{
+			Backend:            config.StateStoreBackendConfigMap,
+			ConfigMapName:      "operation-state",
+			ConfigMapNamespace: "default",
+		}
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("configmap backend without a name is rejected", func() {
+		cfg := s.validConfig()
+		cfg.StateStore = config.StateStoreConfig{
+			Backend:            config.StateStoreBackendConfigMap,
+			ConfigMapNamespace: "default",
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "requires configmap_name and configmap_namespace")
+	})
+}
+
+func (s *ValidateSuite) TestHA() {
+	s.Run("disabled ha is valid", func() {
+		cfg := s.validConfig()
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("enabled ha with lease_namespace is valid", func() {
+		cfg := s.validConfig()
+		cfg.HA = config.HAConfig{Enabled: true, LeaseNamespace: "default"}
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("enabled ha without lease_namespace is rejected", func() {
+		cfg := s.validConfig()
+		cfg.HA = config.HAConfig{Enabled: true}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "ha requires lease_namespace")
+	})
+}
+
+func (s *ValidateSuite) TestProxiedMCPServers() {
+	s.Run("valid proxied server passes", func() {
+		cfg := s.validConfig()
+		cfg.ProxiedMCPServers = []config.ProxiedMCPServer{
+			{Name: "prometheus", URL: "https://prometheus-mcp.example.com/mcp"},
+		}
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("invalid name is rejected", func() {
+		cfg := s.validConfig()
+		cfg.ProxiedMCPServers = []config.ProxiedMCPServer{
+			{Name: "Not Valid", URL: "https://example.com/mcp"},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `proxied_mcp_servers[0]: invalid name "Not Valid"`)
+	})
+
+	s.Run("non-http url is rejected", func() {
+		cfg := s.validConfig()
+		cfg.ProxiedMCPServers = []config.ProxiedMCPServer{
+			{Name: "remote", URL: "unix:///tmp/mcp.sock"},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "must be an http(s) URL")
+	})
+
+	s.Run("duplicate names are rejected", func() {
+		cfg := s.validConfig()
+		cfg.ProxiedMCPServers = []config.ProxiedMCPServer{
+			{Name: "remote", URL: "https://one.example.com/mcp"},
+			{Name: "remote", URL: "https://two.example.com/mcp"},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `duplicate name "remote"`)
+	})
+}
+
+func (s *ValidateSuite) TestNotifiers() {
+	s.Run("valid notifier passes", func() {
+		cfg := s.validConfig()
+		cfg.Notifiers = []config.NotifierConfig{
+			{Name: "alerting", URL: "https://hooks.example.com/alerts", Events: []string{config.NotifierEventToolError}},
+		}
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("invalid name is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Notifiers = []config.NotifierConfig{
+			{Name: "Not Valid", URL: "https://hooks.example.com/alerts", Events: []string{config.NotifierEventToolError}},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `notifiers[0]: invalid name "Not Valid"`)
+	})
+
+	s.Run("empty events are rejected", func() {
+		cfg := s.validConfig()
+		cfg.Notifiers = []config.NotifierConfig{
+			{Name: "alerting", URL: "https://hooks.example.com/alerts"},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "events must list at least one of")
+	})
+
+	s.Run("unknown event is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Notifiers = []config.NotifierConfig{
+			{Name: "alerting", URL: "https://hooks.example.com/alerts", Events: []string{"everything"}},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `invalid event "everything"`)
+	})
+
+	s.Run("unknown format is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Notifiers = []config.NotifierConfig{
+			{Name: "alerting", URL: "https://hooks.example.com/alerts", Events: []string{config.NotifierEventToolError}, Format: "xml"},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `invalid format "xml"`)
+	})
+
+	s.Run("duplicate names are rejected", func() {
+		cfg := s.validConfig()
+		cfg.Notifiers = []config.NotifierConfig{
+			{Name: "alerting", URL: "https://one.example.com", Events: []string{config.NotifierEventToolError}},
+			{Name: "alerting", URL: "https://two.example.com", Events: []string{config.NotifierEventToolCall}},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `duplicate name "alerting"`)
+	})
+
+	s.Run("http url with require_tls is rejected", func() {
+		cfg := s.validConfig()
+		cfg.RequireTLS = true
+		cfg.Notifiers = []config.NotifierConfig{
+			{Name: "alerting", URL: "http://hooks.example.com/alerts", Events: []string{config.NotifierEventToolError}},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "notifiers[0].url")
+	})
+}
+
+func (s *ValidateSuite) TestSchedules() {
+	s.Run("valid schedule passes", func() {
+		cfg := s.validConfig()
+		cfg.Schedules = []config.ScheduleConfig{
+			{Name: "health", Tool: "events_list", Every: config.Duration(30 * time.Minute)},
+		}
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("invalid name is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Schedules = []config.ScheduleConfig{
+			{Name: "Not Valid", Tool: "events_list", Every: config.Duration(time.Hour)},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `schedules[0]: invalid name "Not Valid"`)
+	})
+
+	s.Run("missing tool is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Schedules = []config.ScheduleConfig{
+			{Name: "health", Every: config.Duration(time.Hour)},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "schedules[0]: tool is required")
+	})
+
+	s.Run("non-positive interval is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Schedules = []config.ScheduleConfig{
+			{Name: "health", Tool: "events_list"},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "every must be a positive duration")
+	})
+
+	s.Run("duplicate names are rejected", func() {
+		cfg := s.validConfig()
+		cfg.Schedules = []config.ScheduleConfig{
+			{Name: "health", Tool: "events_list", Every: config.Duration(time.Hour)},
+			{Name: "health", Tool: "nodes_list", Every: config.Duration(time.Hour)},
+		}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `duplicate name "health"`)
+	})
+}
+
+func (s *ValidateSuite) TestSnapshot() {
+	s.Run("record mode with path passes", func() {
+		cfg := s.validConfig()
+		cfg.Snapshot = config.SnapshotConfig{Mode: "record", Path: filepath.Join(s.T().TempDir(), "snapshot.jsonl")}
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("replay mode with existing file passes", func() {
+		cfg := s.validConfig()
+		path := filepath.Join(s.T().TempDir(), "snapshot.jsonl")
+		s.Require().NoError(os.WriteFile(path, []byte{}, 0600))
+		cfg.Snapshot = config.SnapshotConfig{Mode: "replay", Path: path}
+		s.NoError(cfg.Validate(s.T().Context()))
+	})
+
+	s.Run("invalid mode is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Snapshot = config.SnapshotConfig{Mode: "playback", Path: "snapshot.jsonl"}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `invalid snapshot mode "playback"`)
+	})
+
+	s.Run("mode without path is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Snapshot = config.SnapshotConfig{Mode: "record"}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), `snapshot mode "record" requires path`)
+	})
+
+	s.Run("path without mode is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Snapshot = config.SnapshotConfig{Path: "snapshot.jsonl"}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "snapshot path requires mode")
+	})
+
+	s.Run("replay mode with missing file is rejected", func() {
+		cfg := s.validConfig()
+		cfg.Snapshot = config.SnapshotConfig{Mode: "replay", Path: filepath.Join(s.T().TempDir(), "missing.jsonl")}
+		err := cfg.Validate(s.T().Context())
+		s.Require().Error(err)
+		s.Contains(err.Error(), "snapshot path must be a valid file path")
+	})
+}
+
 func TestValidate(t *testing.T) {
 	suite.Run(t, new(ValidateSuite))
 }
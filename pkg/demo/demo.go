@@ -0,0 +1,454 @@
+// Package demo provides an in-memory fake cluster for local development.
+// It serves a minimal subset of the Kubernetes API (discovery, list, get,
+// create, update, patch, delete) from an in-process HTTP server seeded with
+// realistic OpenShift objects, so MCP client developers can exercise the
+// toolsets without any cluster access. It is not a real apiserver: there is
+// no validation, no RBAC, no watches, and no subresources.
+package demo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// resourceInfo declares one resource the demo cluster serves. The discovery
+// endpoints are generated from this table, and requests for anything not in
+// it fail with a regular NotFound status.
+type resourceInfo struct {
+	group      string
+	version    string
+	resource   string
+	kind       string
+	namespaced bool
+}
+
+func (r *resourceInfo) groupVersion() string {
+	if r.group == "" {
+		return r.version
+	}
+	return r.group + "/" + r.version
+}
+
+var demoResources = []resourceInfo{
+	{group: "", version: "v1", resource: "namespaces", kind: "Namespace", namespaced: false},
+	{group: "", version: "v1", resource: "nodes", kind: "Node", namespaced: false},
+	{group: "", version: "v1", resource: "pods", kind: "Pod", namespaced: true},
+	{group: "", version: "v1", resource: "services", kind: "Service", namespaced: true},
+	{group: "", version: "v1", resource: "configmaps", kind: "ConfigMap", namespaced: true},
+	{group: "", version: "v1", resource: "events", kind: "Event", namespaced: true},
+	{group: "apps", version: "v1", resource: "deployments", kind: "Deployment", namespaced: true},
+	{group: "apps", version: "v1", resource: "replicasets", kind: "ReplicaSet", namespaced: true},
+	{group: "project.openshift.io", version: "v1", resource: "projects", kind: "Project", namespaced: false},
+	{group: "route.openshift.io", version: "v1", resource: "routes", kind: "Route", namespaced: true},
+	{group: "config.openshift.io", version: "v1", resource: "clusteroperators", kind: "ClusterOperator", namespaced: false},
+	{group: "oadp.openshift.io", version: "v1alpha1", resource: "dataprotectionapplications", kind: "DataProtectionApplication", namespaced: true},
+}
+
+func resourceByName(groupVersion, resource string) *resourceInfo {
+	for i := range demoResources {
+		if demoResources[i].groupVersion() == groupVersion && demoResources[i].resource == resource {
+			return &demoResources[i]
+		}
+	}
+	return nil
+}
+
+func resourceByKind(apiVersion, kind string) *resourceInfo {
+	for i := range demoResources {
+		if demoResources[i].groupVersion() == apiVersion && demoResources[i].kind == kind {
+			return &demoResources[i]
+		}
+	}
+	return nil
+}
+
+// Server is the in-memory demo cluster. Objects live in a mutex-guarded
+// slice; mutations from tools (create, update, delete) are visible to
+// subsequent calls, so workflows like "scale, then list" behave naturally.
+type Server struct {
+	mu         sync.RWMutex
+	objects    []*unstructured.Unstructured
+	httpServer *http.Server
+	listener   net.Listener
+	tempDir    string
+}
+
+// NewServer seeds the demo objects and starts the in-process API server on a
+// random localhost port.
+func NewServer() (*Server, error) {
+	objects, err := seedObjects()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load demo seed objects: %w", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for demo cluster: %w", err)
+	}
+	s := &Server{objects: objects, listener: listener}
+	s.httpServer = &http.Server{Handler: s}
+	go func() { _ = s.httpServer.Serve(listener) }()
+	return s, nil
+}
+
+// URL returns the demo cluster's API server URL.
+func (s *Server) URL() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+// KubeconfigPath writes a kubeconfig pointing at the demo cluster to a
+// temporary directory and returns its path. The file is removed by Close.
+func (s *Server) KubeconfigPath() (string, error) {
+	if s.tempDir == "" {
+		tempDir, err := os.MkdirTemp("", "kubernetes-mcp-server-demo-")
+		if err != nil {
+			return "", fmt.Errorf("failed to create demo kubeconfig directory: %w", err)
+		}
+		s.tempDir = tempDir
+	}
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.Clusters["demo"] = &clientcmdapi.Cluster{Server: s.URL()}
+	kubeconfig.AuthInfos["demo"] = &clientcmdapi.AuthInfo{}
+	kubeconfig.Contexts["demo"] = &clientcmdapi.Context{Cluster: "demo", AuthInfo: "demo", Namespace: "demo-shop"}
+	kubeconfig.CurrentContext = "demo"
+	path := filepath.Join(s.tempDir, "kubeconfig")
+	if err := clientcmd.WriteToFile(*kubeconfig, path); err != nil {
+		return "", fmt.Errorf("failed to write demo kubeconfig: %w", err)
+	}
+	return path, nil
+}
+
+// Close stops the API server and removes the temporary kubeconfig.
+func (s *Server) Close() error {
+	if s.tempDir != "" {
+		_ = os.RemoveAll(s.tempDir)
+	}
+	return s.httpServer.Close()
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch {
+	case req.URL.Path == "/version":
+		writeJSON(w, http.StatusOK, map[string]string{
+			"major": "1", "minor": "31", "gitVersion": "v1.31.0+demo",
+		})
+	case req.URL.Path == "/api":
+		writeJSON(w, http.StatusOK, &metav1.APIVersions{Versions: []string{"v1"}})
+	case req.URL.Path == "/apis":
+		s.serveAPIGroups(w)
+	case req.URL.Path == "/api/v1":
+		s.serveAPIResourceList(w, "v1")
+	case strings.HasPrefix(req.URL.Path, "/apis/") && len(strings.Split(strings.TrimPrefix(req.URL.Path, "/apis/"), "/")) == 2:
+		s.serveAPIResourceList(w, strings.TrimPrefix(req.URL.Path, "/apis/"))
+	case strings.HasPrefix(req.URL.Path, "/api/") || strings.HasPrefix(req.URL.Path, "/apis/"):
+		s.serveResource(w, req)
+	default:
+		writeStatusError(w, http.StatusNotFound, fmt.Sprintf("the demo cluster does not serve %s", req.URL.Path))
+	}
+}
+
+func (s *Server) serveAPIGroups(w http.ResponseWriter) {
+	seen := map[string]bool{}
+	groups := make([]metav1.APIGroup, 0)
+	for i := range demoResources {
+		info := &demoResources[i]
+		if info.group == "" || seen[info.group] {
+			continue
+		}
+		seen[info.group] = true
+		gv := metav1.GroupVersionForDiscovery{GroupVersion: info.groupVersion(), Version: info.version}
+		groups = append(groups, metav1.APIGroup{
+			Name:             info.group,
+			Versions:         []metav1.GroupVersionForDiscovery{gv},
+			PreferredVersion: gv,
+		})
+	}
+	writeJSON(w, http.StatusOK, &metav1.APIGroupList{Groups: groups})
+}
+
+func (s *Server) serveAPIResourceList(w http.ResponseWriter, groupVersion string) {
+	resources := make([]metav1.APIResource, 0)
+	for i := range demoResources {
+		info := &demoResources[i]
+		if info.groupVersion() != groupVersion {
+			continue
+		}
+		resources = append(resources, metav1.APIResource{
+			Name:       info.resource,
+			Kind:       info.kind,
+			Namespaced: info.namespaced,
+			Verbs:      metav1.Verbs{"get", "list", "create", "update", "patch", "delete"},
+		})
+	}
+	if len(resources) == 0 {
+		writeStatusError(w, http.StatusNotFound, fmt.Sprintf("the demo cluster does not serve %s", groupVersion))
+		return
+	}
+	writeJSON(w, http.StatusOK, &metav1.APIResourceList{GroupVersion: groupVersion, APIResources: resources})
+}
+
+// serveResource handles list/get/create/update/patch/delete for one resource
+// path: /api/v1/[namespaces/<ns>/]<resource>[/<name>] or the /apis/<group>/<version>
+// equivalent.
+func (s *Server) serveResource(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Query().Get("watch") == "true" {
+		writeStatusError(w, http.StatusBadRequest, "the demo cluster does not support watch")
+		return
+	}
+	groupVersion, namespace, resource, name, ok := parseResourcePath(req.URL.Path)
+	if !ok {
+		writeStatusError(w, http.StatusNotFound, fmt.Sprintf("the demo cluster does not serve %s", req.URL.Path))
+		return
+	}
+	info := resourceByName(groupVersion, resource)
+	if info == nil {
+		writeStatusError(w, http.StatusNotFound, fmt.Sprintf("the demo cluster does not serve %s in %s", resource, groupVersion))
+		return
+	}
+	switch {
+	case req.Method == http.MethodGet && name == "":
+		s.list(w, req, info, namespace)
+	case req.Method == http.MethodGet:
+		s.get(w, info, namespace, name)
+	case req.Method == http.MethodPost:
+		s.create(w, req, info, namespace)
+	case req.Method == http.MethodPut && name != "":
+		s.update(w, req, info, namespace, name)
+	case req.Method == http.MethodPatch && name != "":
+		s.patch(w, req, info, namespace, name)
+	case req.Method == http.MethodDelete && name != "":
+		s.delete(w, info, namespace, name)
+	default:
+		writeStatusError(w, http.StatusMethodNotAllowed, fmt.Sprintf("the demo cluster does not support %s on %s", req.Method, req.URL.Path))
+	}
+}
+
+// parseResourcePath splits an API request path into its addressing parts.
+func parseResourcePath(path string) (groupVersion, namespace, resource, name string, ok bool) {
+	var segments []string
+	if strings.HasPrefix(path, "/api/") {
+		segments = strings.Split(strings.TrimPrefix(path, "/api/"), "/")
+		if len(segments) < 2 {
+			return "", "", "", "", false
+		}
+		groupVersion, segments = segments[0], segments[1:]
+	} else {
+		segments = strings.Split(strings.TrimPrefix(path, "/apis/"), "/")
+		if len(segments) < 3 {
+			return "", "", "", "", false
+		}
+		groupVersion, segments = segments[0]+"/"+segments[1], segments[2:]
+	}
+	if segments[0] == "namespaces" && len(segments) >= 3 {
+		namespace, segments = segments[1], segments[2:]
+	}
+	resource = segments[0]
+	if len(segments) > 1 {
+		name = segments[1]
+	}
+	if len(segments) > 2 {
+		// Subresources (log, exec, scale, ...) are not implemented.
+		return "", "", "", "", false
+	}
+	return groupVersion, namespace, resource, name, true
+}
+
+func (s *Server) matches(object *unstructured.Unstructured, info *resourceInfo, namespace, name string) bool {
+	if object.GetAPIVersion() != info.groupVersion() || object.GetKind() != info.kind {
+		return false
+	}
+	if info.namespaced && namespace != "" && object.GetNamespace() != namespace {
+		return false
+	}
+	return name == "" || object.GetName() == name
+}
+
+func (s *Server) list(w http.ResponseWriter, req *http.Request, info *resourceInfo, namespace string) {
+	selector := labels.Everything()
+	if raw := req.URL.Query().Get("labelSelector"); raw != "" {
+		parsed, err := labels.Parse(raw)
+		if err != nil {
+			writeStatusError(w, http.StatusBadRequest, fmt.Sprintf("invalid labelSelector: %v", err))
+			return
+		}
+		selector = parsed
+	}
+	s.mu.RLock()
+	items := make([]any, 0)
+	for _, object := range s.objects {
+		if s.matches(object, info, namespace, "") && selector.Matches(labels.Set(object.GetLabels())) {
+			items = append(items, object.Object)
+		}
+	}
+	s.mu.RUnlock()
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i].(map[string]any), items[j].(map[string]any)
+		return fmt.Sprint(a["metadata"]) < fmt.Sprint(b["metadata"])
+	})
+	writeJSON(w, http.StatusOK, map[string]any{
+		"apiVersion": info.groupVersion(),
+		"kind":       info.kind + "List",
+		"metadata":   map[string]any{"resourceVersion": "1"},
+		"items":      items,
+	})
+}
+
+func (s *Server) get(w http.ResponseWriter, info *resourceInfo, namespace, name string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, object := range s.objects {
+		if s.matches(object, info, namespace, name) {
+			writeJSON(w, http.StatusOK, object.Object)
+			return
+		}
+	}
+	writeNotFound(w, info, name)
+}
+
+func (s *Server) create(w http.ResponseWriter, req *http.Request, info *resourceInfo, namespace string) {
+	object, ok := readObject(w, req)
+	if !ok {
+		return
+	}
+	if object.GetName() == "" && object.GetGenerateName() != "" {
+		object.SetName(fmt.Sprintf("%s%05x", object.GetGenerateName(), rand.Intn(1<<20)))
+	}
+	if info.namespaced && object.GetNamespace() == "" {
+		object.SetNamespace(namespace)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.objects {
+		if s.matches(existing, info, object.GetNamespace(), object.GetName()) {
+			writeStatusError(w, http.StatusConflict, fmt.Sprintf("%s %q already exists", info.resource, object.GetName()))
+			return
+		}
+	}
+	s.objects = append(s.objects, object)
+	writeJSON(w, http.StatusCreated, object.Object)
+}
+
+func (s *Server) update(w http.ResponseWriter, req *http.Request, info *resourceInfo, namespace, name string) {
+	object, ok := readObject(w, req)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, existing := range s.objects {
+		if s.matches(existing, info, namespace, name) {
+			s.objects[i] = object
+			writeJSON(w, http.StatusOK, object.Object)
+			return
+		}
+	}
+	s.objects = append(s.objects, object)
+	writeJSON(w, http.StatusCreated, object.Object)
+}
+
+// patch applies the request body as a JSON merge patch regardless of the
+// requested patch content type; for demo purposes the distinction between
+// merge, strategic-merge, and apply patches doesn't matter.
+func (s *Server) patch(w http.ResponseWriter, req *http.Request, info *resourceInfo, namespace, name string) {
+	patch, ok := readObject(w, req)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.objects {
+		if s.matches(existing, info, namespace, name) {
+			mergeInto(existing.Object, patch.Object)
+			writeJSON(w, http.StatusOK, existing.Object)
+			return
+		}
+	}
+	writeNotFound(w, info, name)
+}
+
+func (s *Server) delete(w http.ResponseWriter, info *resourceInfo, namespace, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, existing := range s.objects {
+		if s.matches(existing, info, namespace, name) {
+			s.objects = append(s.objects[:i], s.objects[i+1:]...)
+			writeJSON(w, http.StatusOK, &metav1.Status{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+				Status:   metav1.StatusSuccess,
+			})
+			return
+		}
+	}
+	writeNotFound(w, info, name)
+}
+
+// mergeInto applies patch onto target following JSON merge patch semantics:
+// maps merge recursively, null deletes, everything else replaces.
+func mergeInto(target, patch map[string]any) {
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+		patchMap, patchIsMap := value.(map[string]any)
+		targetMap, targetIsMap := target[key].(map[string]any)
+		if patchIsMap && targetIsMap {
+			mergeInto(targetMap, patchMap)
+			continue
+		}
+		target[key] = value
+	}
+}
+
+func readObject(w http.ResponseWriter, req *http.Request) (*unstructured.Unstructured, bool) {
+	object := &unstructured.Unstructured{}
+	if err := json.NewDecoder(req.Body).Decode(&object.Object); err != nil {
+		writeStatusError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return nil, false
+	}
+	return object, true
+}
+
+func writeJSON(w http.ResponseWriter, code int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+func writeNotFound(w http.ResponseWriter, info *resourceInfo, name string) {
+	writeStatusError(w, http.StatusNotFound, fmt.Sprintf("%s %q not found", info.resource, name))
+}
+
+func writeStatusError(w http.ResponseWriter, code int, message string) {
+	reason := metav1.StatusReasonInternalError
+	switch code {
+	case http.StatusNotFound:
+		reason = metav1.StatusReasonNotFound
+	case http.StatusConflict:
+		reason = metav1.StatusReasonAlreadyExists
+	case http.StatusBadRequest:
+		reason = metav1.StatusReasonBadRequest
+	case http.StatusMethodNotAllowed:
+		reason = metav1.StatusReasonMethodNotAllowed
+	}
+	writeJSON(w, code, &metav1.Status{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+		Status:   metav1.StatusFailure,
+		Code:     int32(code),
+		Reason:   reason,
+		Message:  message,
+	})
+}
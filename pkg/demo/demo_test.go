@@ -0,0 +1,103 @@
+package demo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/demo"
+)
+
+type DemoServerSuite struct {
+	suite.Suite
+	server    *demo.Server
+	clientset kubernetes.Interface
+	dynamic   dynamic.Interface
+}
+
+func (s *DemoServerSuite) SetupTest() {
+	server, err := demo.NewServer()
+	s.Require().NoError(err, "Expected demo server to start")
+	s.server = server
+	restConfig := &rest.Config{Host: server.URL()}
+	s.clientset, err = kubernetes.NewForConfig(restConfig)
+	s.Require().NoError(err, "Expected clientset for demo server")
+	s.dynamic, err = dynamic.NewForConfig(restConfig)
+	s.Require().NoError(err, "Expected dynamic client for demo server")
+}
+
+func (s *DemoServerSuite) TearDownTest() {
+	s.Require().NoError(s.server.Close())
+}
+
+func (s *DemoServerSuite) TestSeededObjects() {
+	s.Run("lists seeded pods in the demo namespace", func() {
+		pods, err := s.clientset.CoreV1().Pods("demo-shop").List(s.T().Context(), metav1.ListOptions{})
+		s.Require().NoError(err)
+		s.Len(pods.Items, 2, "expected the two seeded frontend pods")
+	})
+	s.Run("filters lists by label selector", func() {
+		pods, err := s.clientset.CoreV1().Pods("demo-shop").List(s.T().Context(), metav1.ListOptions{LabelSelector: "app=backend"})
+		s.Require().NoError(err)
+		s.Empty(pods.Items, "expected no pods for an unmatched selector")
+	})
+	s.Run("serves OpenShift routes", func() {
+		routeGvr := schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+		route, err := s.dynamic.Resource(routeGvr).Namespace("demo-shop").Get(s.T().Context(), "frontend", metav1.GetOptions{})
+		s.Require().NoError(err)
+		host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+		s.Equal("frontend-demo-shop.apps.demo.example.com", host)
+	})
+	s.Run("serves clusteroperators", func() {
+		coGvr := schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "clusteroperators"}
+		operators, err := s.dynamic.Resource(coGvr).List(s.T().Context(), metav1.ListOptions{})
+		s.Require().NoError(err)
+		s.Len(operators.Items, 2, "expected the seeded clusteroperators")
+	})
+	s.Run("serves OADP DataProtectionApplications", func() {
+		dpaGvr := schema.GroupVersionResource{Group: "oadp.openshift.io", Version: "v1alpha1", Resource: "dataprotectionapplications"}
+		dpas, err := s.dynamic.Resource(dpaGvr).Namespace("openshift-adp").List(s.T().Context(), metav1.ListOptions{})
+		s.Require().NoError(err)
+		s.Len(dpas.Items, 1, "expected the seeded DataProtectionApplication")
+	})
+}
+
+func (s *DemoServerSuite) TestMutations() {
+	s.Run("deleting a pod removes it from subsequent lists", func() {
+		err := s.clientset.CoreV1().Pods("demo-shop").Delete(s.T().Context(), "frontend-5d8c7b9f6d-q2xkv", metav1.DeleteOptions{})
+		s.Require().NoError(err)
+		pods, err := s.clientset.CoreV1().Pods("demo-shop").List(s.T().Context(), metav1.ListOptions{})
+		s.Require().NoError(err)
+		s.Len(pods.Items, 1, "expected one pod after deleting the other")
+	})
+	s.Run("patching a deployment is visible on the next get", func() {
+		_, err := s.clientset.AppsV1().Deployments("demo-shop").Patch(
+			s.T().Context(), "frontend", types.MergePatchType, []byte(`{"spec":{"replicas":5}}`), metav1.PatchOptions{})
+		s.Require().NoError(err)
+		deployment, err := s.clientset.AppsV1().Deployments("demo-shop").Get(s.T().Context(), "frontend", metav1.GetOptions{})
+		s.Require().NoError(err)
+		s.Equal(int32(5), *deployment.Spec.Replicas)
+	})
+}
+
+func (s *DemoServerSuite) TestEdgeCases() {
+	s.Run("returns NotFound for a missing object", func() {
+		_, err := s.clientset.CoreV1().Pods("demo-shop").Get(s.T().Context(), "missing", metav1.GetOptions{})
+		s.Error(err, "expected error for missing pod")
+	})
+	s.Run("rejects watch requests", func() {
+		_, err := s.clientset.CoreV1().Pods("demo-shop").Watch(s.T().Context(), metav1.ListOptions{})
+		s.Error(err, "expected error for watch in demo mode")
+	})
+}
+
+func TestDemoServer(t *testing.T) {
+	suite.Run(t, new(DemoServerSuite))
+}
@@ -0,0 +1,40 @@
+package demo
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// seedManifests holds the demo cluster's initial objects as a multi-document
+// YAML file, so the seed data reads like the manifests it imitates.
+//
+//go:embed seed.yaml
+var seedManifests string
+
+var documentSeparator = regexp.MustCompile(`\r?\n---\r?\n`)
+
+// seedObjects parses the embedded manifests into the demo cluster's initial
+// object set, rejecting any object whose kind is not in the resource table —
+// an unserveable seed object would otherwise be silently unreachable.
+func seedObjects() ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	for _, document := range documentSeparator.Split(seedManifests, -1) {
+		object := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(document), &object.Object); err != nil {
+			return nil, fmt.Errorf("failed to parse seed manifest: %w", err)
+		}
+		if len(object.Object) == 0 {
+			continue
+		}
+		if resourceByKind(object.GetAPIVersion(), object.GetKind()) == nil {
+			return nil, fmt.Errorf("seed object %s/%s has kind %s %s which is not in the demo resource table",
+				object.GetNamespace(), object.GetName(), object.GetAPIVersion(), object.GetKind())
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
@@ -0,0 +1,90 @@
+package gitops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// Config holds the GitOps toolset configuration
+type Config struct {
+	// Url is the base URL that serves the raw file content of the Git
+	// repository at the desired branch, e.g.
+	// https://raw.githubusercontent.com/org/repo/main or
+	// https://gitlab.example/org/repo/-/raw/main.
+	Url string `toml:"url"`
+	// PathPrefix is an optional directory within the repository that all
+	// manifest paths are resolved against (e.g. "manifests/production").
+	PathPrefix string `toml:"path_prefix,omitempty"`
+	// ApiTokenFile points at a file containing an access token for the Git
+	// host. Public repositories work without it.
+	ApiTokenFile         string `toml:"api_token_file,omitempty"`
+	Insecure             bool   `toml:"insecure,omitempty"`
+	CertificateAuthority string `toml:"certificate_authority,omitempty"`
+}
+
+var _ api.ExtendedConfig = (*Config)(nil)
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return errors.New("gitops config is nil")
+	}
+	if c.Url == "" {
+		return errors.New("url is required")
+	}
+	if u, err := url.Parse(c.Url); err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.New("url must be a valid URL")
+	}
+	// Validate that certificate_authority is a valid file
+	if caValue := strings.TrimSpace(c.CertificateAuthority); caValue != "" {
+		if _, err := os.Stat(caValue); err != nil {
+			return fmt.Errorf("certificate_authority must be a valid file path: %w", err)
+		}
+	}
+	if tokenFile := strings.TrimSpace(c.ApiTokenFile); tokenFile != "" {
+		if _, err := os.Stat(tokenFile); err != nil {
+			return fmt.Errorf("api_token_file must be a valid file path: %w", err)
+		}
+	}
+	return nil
+}
+
+func gitopsToolsetParser(ctx context.Context, primitive toml.Primitive, md toml.MetaData) (api.ExtendedConfig, error) {
+	var cfg Config
+	if err := md.PrimitiveDecode(primitive, &cfg); err != nil {
+		return nil, err
+	}
+
+	// Resolve file paths relative to the config directory when they are not absolute
+	configDir := config.ConfigDirPathFromContext(ctx)
+	if cfg.CertificateAuthority != "" && configDir != "" && !filepath.IsAbs(cfg.CertificateAuthority) {
+		cfg.CertificateAuthority = filepath.Join(configDir, cfg.CertificateAuthority)
+	}
+	if cfg.ApiTokenFile != "" && configDir != "" && !filepath.IsAbs(cfg.ApiTokenFile) {
+		cfg.ApiTokenFile = filepath.Join(configDir, cfg.ApiTokenFile)
+	}
+
+	// Validate TLS settings when require_tls is enabled
+	if config.RequireTLSFromContext(ctx) {
+		if err := config.ValidateURLRequiresTLS(cfg.Url, "GitOps repository URL"); err != nil {
+			return nil, err
+		}
+		if cfg.Insecure {
+			return nil, errors.New("require_tls is enabled but GitOps insecure=true disables certificate verification")
+		}
+	}
+
+	return &cfg, nil
+}
+
+func init() {
+	config.RegisterToolsetConfig("gitops", gitopsToolsetParser)
+}
@@ -0,0 +1,116 @@
+package gitops
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigSuite struct {
+	suite.Suite
+	tempDir   string
+	caFile    string
+	tokenFile string
+}
+
+func (s *ConfigSuite) SetupTest() {
+	// Create a test CA certificate and access token file
+	s.tempDir = s.T().TempDir()
+	s.caFile = filepath.Join(s.tempDir, "ca.crt")
+	s.Require().NoError(os.WriteFile(s.caFile, []byte("test ca content"), 0644), "Failed to write CA file")
+	s.tokenFile = filepath.Join(s.tempDir, "token")
+	s.Require().NoError(os.WriteFile(s.tokenFile, []byte("test-token"), 0600), "Failed to write token file")
+}
+
+func (s *ConfigSuite) TestConfigParser_ResolvesRelativePaths() {
+	// Read config with configDirPath set to tempDir to resolve relative paths
+	cfg := test.Must(config.ReadToml([]byte(`
+		[toolset_configs.gitops]
+		url = "https://raw.githubusercontent.com/org/repo/main"
+		certificate_authority = "ca.crt"
+		api_token_file = "token"
+	`), config.WithDirPath(s.tempDir)))
+
+	gitopsCfg, ok := cfg.GetToolsetConfig("gitops")
+	s.Require().True(ok, "GitOps config should be present")
+	gcfg, ok := gitopsCfg.(*Config)
+	s.Require().True(ok, "GitOps config should be of type *Config")
+
+	s.Equal(s.caFile, gcfg.CertificateAuthority, "Relative certificate_authority should be resolved to absolute path")
+	s.Equal(s.tokenFile, gcfg.ApiTokenFile, "Relative api_token_file should be resolved to absolute path")
+}
+
+func (s *ConfigSuite) TestConfigParser_RejectsInvalidTokenFile() {
+	nonExistentFileForTOML := filepath.ToSlash(filepath.Join(s.tempDir, "non-existent-token"))
+
+	cfg, err := config.ReadToml([]byte(`
+		[toolset_configs.gitops]
+		url = "https://raw.githubusercontent.com/org/repo/main"
+		api_token_file = "` + nonExistentFileForTOML + `"
+	`))
+
+	s.Require().Error(err, "Validate should reject invalid token file path")
+	s.Contains(err.Error(), "api_token_file must be a valid file path", "Error message should indicate token file path is invalid")
+	s.Nil(cfg, "Config should be nil when validation fails")
+}
+
+func (s *ConfigSuite) TestConfigParser_RejectsInsecureWithRequireTLS() {
+	_, err := config.ReadToml([]byte(`
+		require_tls = true
+		[toolset_configs.gitops]
+		url = "https://raw.githubusercontent.com/org/repo/main"
+		insecure = true
+	`))
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "insecure=true disables certificate verification")
+}
+
+func (s *ConfigSuite) TestValidate() {
+	s.Run("nil config returns error", func() {
+		var cfg *Config
+		err := cfg.Validate()
+		s.Error(err, "Expected error for nil config")
+		s.ErrorContains(err, "gitops config is nil")
+	})
+	s.Run("empty URL returns error", func() {
+		cfg := &Config{}
+		err := cfg.Validate()
+		s.Error(err, "Expected error for empty URL")
+		s.ErrorContains(err, "url is required")
+	})
+	s.Run("invalid URL returns error", func() {
+		cfg := &Config{Url: "://bad-url"}
+		err := cfg.Validate()
+		s.Error(err, "Expected error for invalid URL")
+		s.ErrorContains(err, "url must be a valid URL")
+	})
+	s.Run("HTTPS URL does not require certificate_authority", func() {
+		// Public Git hosts serve publicly trusted certificates
+		cfg := &Config{Url: "https://raw.githubusercontent.com/org/repo/main"}
+		err := cfg.Validate()
+		s.NoError(err, "HTTPS URL should not require certificate_authority")
+	})
+	s.Run("invalid certificate_authority returns error", func() {
+		cfg := &Config{
+			Url:                  "https://git.example/org/repo/-/raw/main",
+			CertificateAuthority: filepath.Join(s.tempDir, "non-existent-ca"),
+		}
+		err := cfg.Validate()
+		s.Error(err, "Expected error for invalid certificate_authority path")
+		s.ErrorContains(err, "certificate_authority must be a valid file path")
+	})
+	s.Run("valid api_token_file passes", func() {
+		cfg := &Config{Url: "https://raw.githubusercontent.com/org/repo/main", ApiTokenFile: s.tokenFile}
+		err := cfg.Validate()
+		s.NoError(err, "Valid api_token_file should pass validation")
+	})
+}
+
+func TestConfig(t *testing.T) {
+	suite.Run(t, new(ConfigSuite))
+}
@@ -0,0 +1,171 @@
+package gitops
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+	"github.com/containers/kubernetes-mcp-server/pkg/tlsutil"
+)
+
+// Repository is a read-only client for the raw file content of the
+// configured Git repository.
+type Repository struct {
+	baseURL              string
+	pathPrefix           string
+	apiTokenFile         string
+	insecure             bool
+	certificateAuthority string
+	tlsMinVersion        string
+	tlsCipherSuites      []string
+	requireTLS           func() bool
+}
+
+// NewRepository creates a new Git repository client from the toolset
+// configuration.
+func NewRepository(configProvider api.BaseConfig) *Repository {
+	repository := &Repository{
+		tlsMinVersion:   configProvider.GetTLSMinVersionConfig(),
+		tlsCipherSuites: configProvider.GetTLSCipherSuitesConfig(),
+		requireTLS:      configProvider.IsRequireTLS,
+	}
+	if cfg, ok := configProvider.GetToolsetConfig("gitops"); ok {
+		if gc, ok := cfg.(*Config); ok && gc != nil {
+			repository.baseURL = gc.Url
+			repository.pathPrefix = gc.PathPrefix
+			repository.apiTokenFile = gc.ApiTokenFile
+			repository.insecure = gc.Insecure
+			repository.certificateAuthority = gc.CertificateAuthority
+		}
+	}
+	return repository
+}
+
+// validateAndGetURL validates the client configuration and returns the full
+// URL serving the raw content of the given repository path.
+func (r *Repository) validateAndGetURL(path string) (string, error) {
+	if r == nil || r.baseURL == "" {
+		return "", fmt.Errorf("the Git repository is not configured; set url in the [toolset_configs.gitops] section")
+	}
+	resultURL, err := url.JoinPath(strings.TrimSpace(r.baseURL), r.pathPrefix, path)
+	if err != nil {
+		return "", fmt.Errorf("failed to join the Git repository URL with the manifest path: %w", err)
+	}
+	return resultURL, nil
+}
+
+func (r *Repository) createHTTPClient(ctx context.Context) (*http.Client, error) {
+	logger := klogutil.FromContext(ctx)
+	var tlsOpts []tlsutil.TLSConfigOption
+
+	if r.insecure {
+		tlsOpts = append(tlsOpts, tlsutil.WithInsecureSkipVerify(true))
+	}
+
+	if caValue := strings.TrimSpace(r.certificateAuthority); caValue != "" {
+		caPEM, err := os.ReadFile(caValue)
+		if err != nil {
+			logger.Error(err, "failed to read CA certificate from file, proceeding without custom CA", "ca_file", caValue)
+		} else {
+			// Start with the host system pool when possible so we don't drop system roots
+			var certPool *x509.CertPool
+			if systemPool, err := x509.SystemCertPool(); err == nil && systemPool != nil {
+				certPool = systemPool
+			} else {
+				certPool = x509.NewCertPool()
+			}
+			if ok := certPool.AppendCertsFromPEM(caPEM); ok {
+				tlsOpts = append(tlsOpts, tlsutil.WithRootCAs(certPool))
+			} else {
+				logger.V(0).Info("failed to append provided certificate authority; proceeding without custom CA")
+			}
+		}
+	}
+
+	tlsConfig, err := tlsutil.BuildTLSConfig(r.tlsMinVersion, r.tlsCipherSuites, tlsOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build TLS config: %w", err)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	if r.requireTLS == nil {
+		return client, nil
+	}
+	return config.NewTLSEnforcingClient(client, r.requireTLS), nil
+}
+
+// authorizationHeader returns the Authorization header for the Git host from
+// api_token_file when configured. Unlike the cluster-backed clients there is
+// no bearer-token fallback: a Git host does not accept Kubernetes tokens.
+func (r *Repository) authorizationHeader() (string, error) {
+	if tokenFile := strings.TrimSpace(r.apiTokenFile); tokenFile != "" {
+		token, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the Git access token file: %w", err)
+		}
+		return "Bearer " + strings.TrimSpace(string(token)), nil
+	}
+	return "", nil
+}
+
+// maxResponseBodySize is the maximum number of bytes read from the Git host.
+// Responses exceeding this limit are rejected to prevent unbounded memory
+// consumption from a misbehaving or compromised upstream server.
+const maxResponseBodySize = 512 << 10 // 512 KiB
+
+// FetchFile returns the raw content of the given repository path, resolved
+// against the configured path_prefix.
+func (r *Repository) FetchFile(ctx context.Context, path string) (string, error) {
+	fileURL, err := r.validateAndGetURL(path)
+	if err != nil {
+		return "", err
+	}
+
+	klogutil.FromContext(ctx).V(0).Info("Git repository fetch", "url.full", fileURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return "", err
+	}
+	authHeader, err := r.authorizationHeader()
+	if err != nil {
+		return "", err
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+	client, err := r.createHTTPClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+	if int64(len(respBody)) > maxResponseBodySize {
+		return "", fmt.Errorf("Git repository response exceeded maximum allowed size of %d bytes", maxResponseBodySize)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("path %s does not exist in the Git repository", path)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("Git repository error: status %d", resp.StatusCode)
+	}
+	return string(respBody), nil
+}
@@ -0,0 +1,102 @@
+// Package ha provides the leader election helper for multi-replica
+// deployments. Exactly one replica at a time holds the coordination.k8s.io
+// Lease and runs the components that must not run once per replica (e.g.
+// cluster-wide watches); the others stand by and take over when the leader
+// goes away.
+package ha
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+)
+
+// Lease timings follow the client-go defaults used across Kubernetes
+// controllers: a leader that stops renewing is replaced within leaseDuration.
+const (
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// RunLeaderElected blocks running leader election until ctx is cancelled.
+// onStartedLeading is invoked (with a context that is cancelled on lost
+// leadership) each time this replica acquires the lease; onStoppedLeading is
+// invoked each time it loses it. After losing the lease the replica rejoins
+// the election, so leadership can bounce back after a transient renewal
+// failure.
+func RunLeaderElected(ctx context.Context, client kubernetes.Interface, cfg config.HAConfig, onStartedLeading func(ctx context.Context), onStoppedLeading func()) error {
+	logger := klogutil.FromContext(ctx)
+	identity, err := resolveIdentity(cfg.Identity)
+	if err != nil {
+		return err
+	}
+	leaseName := cfg.LeaseName
+	if leaseName == "" {
+		leaseName = config.DefaultHALeaseName
+	}
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{Name: leaseName, Namespace: cfg.LeaseNamespace},
+		Client:    client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            leaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				logger.Info("Acquired leadership", "lease", leaseName, "identity", identity)
+				onStartedLeading(leaderCtx)
+			},
+			OnStoppedLeading: func() {
+				logger.Info("Lost leadership", "lease", leaseName, "identity", identity)
+				onStoppedLeading()
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		// Run returns when leadership is lost; rejoin until ctx is done.
+		elector.Run(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+	}
+}
+
+// resolveIdentity returns the configured identity, falling back to the
+// hostname (the pod name in a Kubernetes deployment) and, as a last resort,
+// a random identity so two replicas can never collide on an empty one.
+func resolveIdentity(configured string) (string, error) {
+	if configured != "" {
+		return configured, nil
+	}
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return hostname, nil
+	}
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "kubernetes-mcp-server-" + hex.EncodeToString(buf), nil
+}
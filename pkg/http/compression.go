@@ -0,0 +1,208 @@
+package http
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// compressionEncoders maps a Content-Encoding token to a factory for its
+// encoder, in server preference order (first match against the client's
+// Accept-Encoding wins). The table is the extension point for additional
+// codecs (e.g. zstd) without touching the negotiation or writer logic.
+var compressionEncoders = []struct {
+	name    string
+	factory func(w io.Writer) io.WriteCloser
+}{
+	{"gzip", func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) }},
+	{"deflate", func(w io.Writer) io.WriteCloser {
+		// flate.NewWriter only errors on invalid levels; DefaultCompression is valid.
+		fw, _ := flate.NewWriter(w, flate.DefaultCompression)
+		return fw
+	}},
+}
+
+// negotiateEncoding returns the first server-preferred encoding the client
+// accepts, or "" when none matches (or the client forbids it with q=0).
+func negotiateEncoding(acceptEncoding string) (string, func(w io.Writer) io.WriteCloser) {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		token, quality, hasQuality := strings.Cut(strings.TrimSpace(part), ";")
+		token = strings.ToLower(strings.TrimSpace(token))
+		if token == "" {
+			continue
+		}
+		ok := true
+		if hasQuality {
+			if value, parseErr := strconv.ParseFloat(strings.TrimPrefix(strings.TrimSpace(quality), "q="), 64); parseErr == nil && value == 0 {
+				ok = false
+			}
+		}
+		accepted[token] = ok
+	}
+	for _, encoder := range compressionEncoders {
+		if accepted[encoder.name] || (accepted["*"] && !hasKey(accepted, encoder.name)) {
+			return encoder.name, encoder.factory
+		}
+	}
+	return "", nil
+}
+
+func hasKey(m map[string]bool, key string) bool {
+	_, ok := m[key]
+	return ok
+}
+
+// CompressionMiddleware negotiates response compression via Accept-Encoding
+// for large responses. Responses below the configured minimum size are sent
+// identity-encoded, and event streams (Content-Type text/event-stream) are
+// never compressed so SSE latency is unaffected. The compression settings
+// are read per request from cfgState so SIGHUP-reloaded values take effect
+// immediately.
+func CompressionMiddleware(cfgState *config.StaticConfigState) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cfg := cfgState.Load()
+			if !cfg.HTTP.Compression {
+				next.ServeHTTP(w, r)
+				return
+			}
+			encoding, factory := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			if encoding == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			minBytes := cfg.HTTP.CompressionMinBytes
+			if minBytes == 0 {
+				minBytes = config.DefaultCompressionMinBytes
+			}
+
+			cw := &compressionResponseWriter{
+				ResponseWriter: w,
+				encoding:       encoding,
+				factory:        factory,
+				minBytes:       minBytes,
+				statusCode:     http.StatusOK,
+			}
+			defer cw.close()
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// compressionResponseWriter buffers the response until it either exceeds
+// minBytes (at which point the encoder takes over) or completes (at which
+// point the buffer is flushed identity-encoded). Headers are deferred until
+// the first write so Content-Encoding and Content-Length can still be
+// adjusted once the decision is made.
+type compressionResponseWriter struct {
+	http.ResponseWriter
+	encoding string
+	factory  func(w io.Writer) io.WriteCloser
+	minBytes int64
+
+	statusCode  int
+	wroteHeader bool
+	buffer      []byte
+	encoder     io.WriteCloser
+	// passthrough short-circuits all buffering, for event streams and for
+	// responses that already carry a Content-Encoding.
+	passthrough bool
+}
+
+func (cw *compressionResponseWriter) WriteHeader(code int) {
+	cw.statusCode = code
+}
+
+// compressible reports whether the response headers allow compression.
+func (cw *compressionResponseWriter) compressible() bool {
+	header := cw.Header()
+	if strings.HasPrefix(header.Get("Content-Type"), "text/event-stream") {
+		return false
+	}
+	return header.Get("Content-Encoding") == ""
+}
+
+func (cw *compressionResponseWriter) Write(p []byte) (int, error) {
+	if cw.passthrough {
+		return cw.ResponseWriter.Write(p)
+	}
+	if cw.encoder != nil {
+		return cw.encoder.Write(p)
+	}
+	if !cw.compressible() {
+		cw.passthrough = true
+		cw.commitHeader()
+		if len(cw.buffer) > 0 {
+			if _, err := cw.ResponseWriter.Write(cw.buffer); err != nil {
+				return 0, err
+			}
+			cw.buffer = nil
+		}
+		return cw.ResponseWriter.Write(p)
+	}
+	cw.buffer = append(cw.buffer, p...)
+	if int64(len(cw.buffer)) >= cw.minBytes {
+		cw.Header().Set("Content-Encoding", cw.encoding)
+		cw.Header().Add("Vary", "Accept-Encoding")
+		cw.Header().Del("Content-Length")
+		cw.commitHeader()
+		cw.encoder = cw.factory(cw.ResponseWriter)
+		if _, err := cw.encoder.Write(cw.buffer); err != nil {
+			return 0, err
+		}
+		cw.buffer = nil
+	}
+	return len(p), nil
+}
+
+func (cw *compressionResponseWriter) commitHeader() {
+	if cw.wroteHeader {
+		return
+	}
+	cw.wroteHeader = true
+	cw.ResponseWriter.WriteHeader(cw.statusCode)
+}
+
+// Flush makes the writer usable for event streams: the first flush on a
+// non-compressible response switches to passthrough so every subsequent
+// event reaches the client immediately.
+func (cw *compressionResponseWriter) Flush() {
+	if flushable, ok := cw.encoder.(interface{ Flush() error }); ok {
+		_ = flushable.Flush()
+	}
+	if cw.encoder == nil && !cw.passthrough {
+		cw.passthrough = true
+		cw.commitHeader()
+		if len(cw.buffer) > 0 {
+			_, _ = cw.ResponseWriter.Write(cw.buffer)
+			cw.buffer = nil
+		}
+	}
+	if flusher, ok := cw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// close finishes the response: responses that never crossed minBytes are
+// written out identity-encoded, compressed responses get their trailing
+// encoder frames flushed.
+func (cw *compressionResponseWriter) close() {
+	if cw.encoder != nil {
+		_ = cw.encoder.Close()
+		return
+	}
+	if cw.passthrough {
+		return
+	}
+	cw.commitHeader()
+	if len(cw.buffer) > 0 {
+		_, _ = cw.ResponseWriter.Write(cw.buffer)
+		cw.buffer = nil
+	}
+}
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/stretchr/testify/suite"
+)
+
+// cfgStateWithCompression returns a *config.StaticConfigState with response
+// compression enabled and the given minimum size — used by tests that drive
+// CompressionMiddleware.
+func cfgStateWithCompression(enabled bool, minBytes int64) *config.StaticConfigState {
+	return config.NewStaticConfigState(&config.StaticConfig{
+		HTTP: config.HTTPConfig{Compression: enabled, CompressionMinBytes: minBytes},
+	})
+}
+
+type CompressionMiddlewareSuite struct {
+	suite.Suite
+}
+
+// serve runs a request with the given Accept-Encoding through
+// CompressionMiddleware wrapping handler and returns the recorder.
+func (s *CompressionMiddlewareSuite) serve(cfgState *config.StaticConfigState, acceptEncoding string, handler http.HandlerFunc) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/mcp", nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	recorder := httptest.NewRecorder()
+	CompressionMiddleware(cfgState)(handler).ServeHTTP(recorder, req)
+	return recorder
+}
+
+func largePayload() string {
+	return strings.Repeat("apiVersion: v1\nkind: ConfigMap\n", 200)
+}
+
+func (s *CompressionMiddlewareSuite) TestCompressionMiddleware() {
+	s.Run("large responses", func() {
+		s.Run("compresses with gzip when the client accepts it", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 0), "gzip", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(largePayload()))
+			})
+			s.Require().Equal("gzip", recorder.Header().Get("Content-Encoding"), "response should be gzip encoded")
+			reader, err := gzip.NewReader(bytes.NewReader(recorder.Body.Bytes()))
+			s.Require().NoError(err, "body should be valid gzip")
+			decompressed, err := io.ReadAll(reader)
+			s.Require().NoError(err)
+			s.Equal(largePayload(), string(decompressed), "decompressed body should match the original payload")
+		})
+		s.Run("adds Vary Accept-Encoding", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 0), "gzip", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(largePayload()))
+			})
+			s.Equal("Accept-Encoding", recorder.Header().Get("Vary"), "compressed responses must vary on Accept-Encoding")
+		})
+	})
+	s.Run("negotiation", func() {
+		s.Run("sends identity when the client accepts nothing", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 0), "", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(largePayload()))
+			})
+			s.Empty(recorder.Header().Get("Content-Encoding"), "no encoding should be applied without Accept-Encoding")
+		})
+		s.Run("honors q=0 to forbid an encoding", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 0), "gzip;q=0, deflate", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(largePayload()))
+			})
+			s.Equal("deflate", recorder.Header().Get("Content-Encoding"), "q=0 should exclude gzip and fall back to deflate")
+		})
+		s.Run("is disabled by default", func() {
+			recorder := s.serve(cfgStateWithCompression(false, 0), "gzip", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(largePayload()))
+			})
+			s.Empty(recorder.Header().Get("Content-Encoding"), "compression must be opt-in")
+		})
+	})
+	s.Run("minimum size", func() {
+		s.Run("leaves small responses identity-encoded", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 0), "gzip", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("ok"))
+			})
+			s.Empty(recorder.Header().Get("Content-Encoding"), "responses below the minimum size should not be compressed")
+			s.Equal("ok", recorder.Body.String(), "small response body should pass through unchanged")
+		})
+		s.Run("respects a custom minimum", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 4), "gzip", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("above minimum"))
+			})
+			s.Equal("gzip", recorder.Header().Get("Content-Encoding"), "responses above the configured minimum should be compressed")
+		})
+	})
+	s.Run("event streams", func() {
+		s.Run("never compresses text/event-stream responses", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 0), "gzip", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				_, _ = w.Write([]byte(largePayload()))
+			})
+			s.Empty(recorder.Header().Get("Content-Encoding"), "event streams must stay identity-encoded")
+			s.Equal(largePayload(), recorder.Body.String(), "event stream body should pass through unchanged")
+		})
+		s.Run("flush before the threshold switches to passthrough", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 1<<20), "gzip", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("event: message\n\n"))
+				w.(http.Flusher).Flush()
+				_, _ = w.Write([]byte("event: message\n\n"))
+			})
+			s.Empty(recorder.Header().Get("Content-Encoding"), "flushed responses should not be buffered for compression")
+			s.Equal("event: message\n\nevent: message\n\n", recorder.Body.String(), "all events should reach the client")
+		})
+	})
+	s.Run("status codes", func() {
+		s.Run("preserves a non-200 status on identity responses", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 0), "gzip", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte("not found"))
+			})
+			s.Equal(http.StatusNotFound, recorder.Code, "status code should be preserved")
+		})
+		s.Run("preserves a non-200 status on compressed responses", func() {
+			recorder := s.serve(cfgStateWithCompression(true, 0), "gzip", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(largePayload()))
+			})
+			s.Equal(http.StatusInternalServerError, recorder.Code, "status code should be preserved")
+		})
+	})
+}
+
+func TestCompressionMiddleware(t *testing.T) {
+	suite.Run(t, new(CompressionMiddlewareSuite))
+}
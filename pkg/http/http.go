@@ -117,8 +117,10 @@ func Serve(ctx context.Context, mcpServer *mcp.Server, cfgState *config.StaticCo
 	// take effect immediately. Listed outermost-first (request flow order).
 	wrappedMux := chain(mux,
 		RequestMiddleware(cfgState),
+		SessionAffinityMiddleware(cfgState),
 		AuthorizationMiddleware(cfgState, oauthState),
 		MaxBodyMiddleware(cfgState),
+		CompressionMiddleware(cfgState),
 	)
 	instrumentedHandler := metricsMiddleware(wrappedMux, mcpServer)
 
@@ -148,10 +150,15 @@ func Serve(ctx context.Context, mcpServer *mcp.Server, cfgState *config.StaticCo
 		httpServer.ErrorLog = log.New(&tlsErrorFilterWriter{underlying: os.Stderr, logger: logger}, "", 0)
 	}
 
-	sseServer := mcpServer.ServeSse()
 	streamableHttpServer := mcpServer.ServeHTTP()
-	mux.Handle(sseEndpoint, sseServer)
-	mux.Handle(sseMessageEndpoint, sseServer)
+	// The legacy HTTP+SSE transport shares the tool registry and the
+	// middleware chain (auth included) with the streamable endpoint; it can
+	// be turned off once no clients depend on the deprecated transport.
+	if !staticConfig.DisableSSE {
+		sseServer := mcpServer.ServeSse()
+		mux.Handle(sseEndpoint, sseServer)
+		mux.Handle(sseMessageEndpoint, sseServer)
+	}
 	mux.Handle(mcpEndpoint, streamableHttpServer)
 	mux.HandleFunc(healthEndpoint, func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -183,6 +190,15 @@ func Serve(ctx context.Context, mcpServer *mcp.Server, cfgState *config.StaticCo
 		)
 	}
 
+	haWithMemoryStore := staticConfig.HA.Enabled &&
+		(staticConfig.StateStore.Backend == "" || staticConfig.StateStore.Backend == config.StateStoreBackendMemory)
+	if haWithMemoryStore {
+		klogutil.LogWarn(logger,
+			"HA is enabled but the state store backend is in-memory, "+
+				"long-running operation state will not survive a replica switch; consider state_store backend \"configmap\"",
+		)
+	}
+
 	serverErr := make(chan error, 1)
 	go func() {
 		var err error
@@ -207,7 +223,6 @@ func Serve(ctx context.Context, mcpServer *mcp.Server, cfgState *config.StaticCo
 	select {
 	case sig := <-sigChan:
 		logger.Info("Received signal, initiating graceful shutdown", "signal", sig.String())
-		cancel()
 	case <-ctx.Done():
 		logger.Info("Context cancelled, initiating graceful shutdown")
 	case err := <-serverErr:
@@ -215,6 +230,18 @@ func Serve(ctx context.Context, mcpServer *mcp.Server, cfgState *config.StaticCo
 		return err
 	}
 
+	// Stop admitting new tool calls and give in-flight handlers a bounded
+	// window to finish before the request base context is cancelled.
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	if err := mcpServer.Drain(drainCtx); err != nil {
+		logger.Info("Proceeding with shutdown before all in-flight tool calls completed", "reason", err.Error())
+	}
+	drainCancel()
+
+	// Cancelling the base context terminates whatever is left — long-running
+	// watches and log streams included — and unblocks their sessions.
+	cancel()
+
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer shutdownCancel()
 
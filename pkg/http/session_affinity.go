@@ -0,0 +1,89 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+const (
+	// sessionAffinityCookieName is the cookie routers hash on for sticky
+	// session routing in multi-replica deployments.
+	sessionAffinityCookieName = "kubernetes-mcp-server-session"
+	// mcpSessionIDHeader is the streamable HTTP transport's session header.
+	mcpSessionIDHeader = "Mcp-Session-Id"
+)
+
+// SessionAffinityMiddleware publishes the MCP session id as a cookie so a
+// Route or load balancer with cookie-based session affinity keeps every
+// request of a session on the replica that holds it. Only active when ha is
+// enabled; the setting is read per request from cfgState so SIGHUP reloads
+// take effect immediately.
+func SessionAffinityMiddleware(cfgState *config.StaticConfigState) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cfg := cfgState.Load()
+			if !cfg.HA.Enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(&sessionAffinityResponseWriter{ResponseWriter: w, request: r}, r)
+		})
+	}
+}
+
+// sessionAffinityResponseWriter sets the affinity cookie just before the
+// headers are written, once the session id is known: on initialize responses
+// it comes from the Mcp-Session-Id response header the transport sets, on
+// subsequent requests from the request header the client echoes back.
+type sessionAffinityResponseWriter struct {
+	http.ResponseWriter
+	request     *http.Request
+	wroteHeader bool
+}
+
+func (w *sessionAffinityResponseWriter) WriteHeader(code int) {
+	w.setAffinityCookie()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *sessionAffinityResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *sessionAffinityResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *sessionAffinityResponseWriter) setAffinityCookie() {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	sessionID := w.Header().Get(mcpSessionIDHeader)
+	if sessionID == "" {
+		sessionID = w.request.Header.Get(mcpSessionIDHeader)
+	}
+	if sessionID == "" {
+		return
+	}
+	if cookie, err := w.request.Cookie(sessionAffinityCookieName); err == nil && cookie.Value == sessionID {
+		return
+	}
+	http.SetCookie(w.ResponseWriter, &http.Cookie{
+		Name:     sessionAffinityCookieName,
+		Value:    sessionID,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   w.request.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
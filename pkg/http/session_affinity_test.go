@@ -0,0 +1,120 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// cfgStateWithHA returns a *config.StaticConfigState with the HA helpers
+// toggled — used by tests that drive SessionAffinityMiddleware.
+func cfgStateWithHA(enabled bool) *config.StaticConfigState {
+	return config.NewStaticConfigState(&config.StaticConfig{
+		HA: config.HAConfig{Enabled: enabled, LeaseNamespace: "default"},
+	})
+}
+
+type SessionAffinityMiddlewareSuite struct {
+	suite.Suite
+}
+
+// serve runs a request through SessionAffinityMiddleware wrapping handler
+// and returns the recorder. mutate may adjust the request (headers, cookies)
+// before it is served.
+func (s *SessionAffinityMiddlewareSuite) serve(cfgState *config.StaticConfigState, mutate func(r *http.Request), handler http.HandlerFunc) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	if mutate != nil {
+		mutate(req)
+	}
+	recorder := httptest.NewRecorder()
+	SessionAffinityMiddleware(cfgState)(handler).ServeHTTP(recorder, req)
+	return recorder
+}
+
+func (s *SessionAffinityMiddlewareSuite) affinityCookie(recorder *httptest.ResponseRecorder) *http.Cookie {
+	for _, cookie := range recorder.Result().Cookies() {
+		if cookie.Name == sessionAffinityCookieName {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func (s *SessionAffinityMiddlewareSuite) TestSessionAffinityMiddleware() {
+	s.Run("cookie publication", func() {
+		s.Run("sets the cookie from the Mcp-Session-Id response header", func() {
+			recorder := s.serve(cfgStateWithHA(true), nil, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set(mcpSessionIDHeader, "session-123")
+				_, _ = w.Write([]byte("ok"))
+			})
+			cookie := s.affinityCookie(recorder)
+			s.Require().NotNil(cookie, "affinity cookie should be set on initialize responses")
+			s.Equal("session-123", cookie.Value, "cookie should carry the session id")
+		})
+		s.Run("sets the cookie from the request header on follow-up requests", func() {
+			recorder := s.serve(cfgStateWithHA(true), func(r *http.Request) {
+				r.Header.Set(mcpSessionIDHeader, "session-456")
+			}, func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("ok"))
+			})
+			cookie := s.affinityCookie(recorder)
+			s.Require().NotNil(cookie, "affinity cookie should be set when the client echoes the session header")
+			s.Equal("session-456", cookie.Value)
+		})
+		s.Run("does not reset an up-to-date cookie", func() {
+			recorder := s.serve(cfgStateWithHA(true), func(r *http.Request) {
+				r.Header.Set(mcpSessionIDHeader, "session-789")
+				r.AddCookie(&http.Cookie{Name: sessionAffinityCookieName, Value: "session-789"})
+			}, func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("ok"))
+			})
+			s.Nil(s.affinityCookie(recorder), "matching cookie should not be set again")
+		})
+		s.Run("replaces a stale cookie", func() {
+			recorder := s.serve(cfgStateWithHA(true), func(r *http.Request) {
+				r.Header.Set(mcpSessionIDHeader, "session-new")
+				r.AddCookie(&http.Cookie{Name: sessionAffinityCookieName, Value: "session-old"})
+			}, func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("ok"))
+			})
+			cookie := s.affinityCookie(recorder)
+			s.Require().NotNil(cookie, "stale cookie should be replaced")
+			s.Equal("session-new", cookie.Value)
+		})
+	})
+	s.Run("no session", func() {
+		s.Run("sets no cookie when no session id is present", func() {
+			recorder := s.serve(cfgStateWithHA(true), nil, func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("ok"))
+			})
+			s.Nil(s.affinityCookie(recorder), "no cookie should be set without a session id")
+		})
+	})
+	s.Run("disabled", func() {
+		s.Run("is inert when ha is disabled", func() {
+			recorder := s.serve(cfgStateWithHA(false), nil, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set(mcpSessionIDHeader, "session-123")
+				_, _ = w.Write([]byte("ok"))
+			})
+			s.Nil(s.affinityCookie(recorder), "no cookie should be set when ha is disabled")
+		})
+	})
+	s.Run("status codes", func() {
+		s.Run("preserves a non-200 status", func() {
+			recorder := s.serve(cfgStateWithHA(true), nil, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set(mcpSessionIDHeader, "session-123")
+				w.WriteHeader(http.StatusNotFound)
+			})
+			s.Equal(http.StatusNotFound, recorder.Code, "status code should be preserved")
+			s.NotNil(s.affinityCookie(recorder), "cookie should still be set")
+		})
+	})
+}
+
+func TestSessionAffinityMiddleware(t *testing.T) {
+	suite.Run(t, new(SessionAffinityMiddlewareSuite))
+}
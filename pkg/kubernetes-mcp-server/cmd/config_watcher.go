@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+	"github.com/containers/kubernetes-mcp-server/pkg/mcp"
+	internaloauth "github.com/containers/kubernetes-mcp-server/pkg/oauth"
+)
+
+// configWatchDebounce is how long the watcher waits after the last filesystem
+// event before reloading. Editors and `kubectl cp`-style updates typically
+// produce a burst of write/rename events for a single logical change.
+const configWatchDebounce = 500 * time.Millisecond
+
+// setupConfigWatcher watches the config file (and drop-in directory, if any)
+// and triggers the same reload path as SIGHUP when their contents change.
+// Parent directories are watched rather than the files themselves so that
+// atomic replacements (rename-over, the pattern used by editors and Kubernetes
+// ConfigMap volume updates) are observed. Returns a stop function that shuts
+// the watcher down and waits for its goroutine to drain.
+func (m *MCPServerOptions) setupConfigWatcher(
+	ctx context.Context,
+	mcpServer *mcp.Server,
+	oauthState *internaloauth.State,
+	cfgState *config.StaticConfigState,
+) (stop func(), err error) {
+	logger := klogutil.FromContext(ctx)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	dropInDir := m.effectiveDropInDir()
+	var watchDirs []string
+	if m.ConfigPath != "" {
+		watchDirs = append(watchDirs, filepath.Dir(m.ConfigPath))
+	}
+	if dropInDir != "" {
+		// The drop-in directory is optional (config.Read skips it when
+		// missing), so only watch it if it exists.
+		if info, statErr := os.Stat(dropInDir); statErr == nil && info.IsDir() {
+			watchDirs = append(watchDirs, dropInDir)
+		}
+	}
+	for _, dir := range watchDirs {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return nil, err
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// Debounce timer: armed on the first relevant event, reset on each
+		// subsequent one, reload fires when it expires.
+		debounce := time.NewTimer(configWatchDebounce)
+		if !debounce.Stop() {
+			<-debounce.C
+		}
+		defer debounce.Stop()
+
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if !m.configWatchRelevant(event) {
+					continue
+				}
+				logger.V(2).Info("Config file change detected", "event", event.String())
+				debounce.Reset(configWatchDebounce)
+			case watchErr, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Error(watchErr, "Config file watcher error")
+			case <-debounce.C:
+				logger.V(1).Info("Config file changed, reloading configuration...")
+				if m.reloadConfiguration(ctx, mcpServer, oauthState, cfgState) {
+					logger.V(1).Info("Configuration reloaded successfully via file watch")
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	logger.V(2).Info("Config file watcher registered for configuration reload", "dirs", watchDirs)
+
+	return func() {
+		_ = watcher.Close()
+		<-done // Wait for goroutine to finish
+	}, nil
+}
+
+// effectiveDropInDir resolves the drop-in configuration directory the same way
+// config.Read does: an explicit --config-dir wins, otherwise the default
+// conf.d directory next to the config file is assumed.
+func (m *MCPServerOptions) effectiveDropInDir() string {
+	if m.ConfigDir != "" {
+		return m.ConfigDir
+	}
+	if m.ConfigPath != "" {
+		return filepath.Join(filepath.Dir(m.ConfigPath), config.DefaultDropInConfigDir)
+	}
+	return ""
+}
+
+// configWatchRelevant reports whether a filesystem event concerns the config
+// file or a drop-in fragment. The parent directory of the config file is
+// watched, so unrelated sibling files must be filtered out; within the drop-in
+// directory only TOML fragments are considered, matching config.Read.
+func (m *MCPServerOptions) configWatchRelevant(event fsnotify.Event) bool {
+	if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) && !event.Has(fsnotify.Rename) && !event.Has(fsnotify.Remove) {
+		return false
+	}
+	if m.ConfigPath != "" && filepath.Clean(event.Name) == filepath.Clean(m.ConfigPath) {
+		return true
+	}
+	if dropInDir := m.effectiveDropInDir(); dropInDir != "" && filepath.Dir(filepath.Clean(event.Name)) == filepath.Clean(dropInDir) {
+		return strings.HasSuffix(event.Name, ".toml")
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+//go:build !windows
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/suite"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/mcp"
+	"github.com/containers/kubernetes-mcp-server/pkg/oauth"
+)
+
+// ConfigWatcherSuite tests the --watch-config file watcher, which drives the
+// same reload path as SIGHUP when the config file or drop-in directory change.
+type ConfigWatcherSuite struct {
+	suite.Suite
+	*baseSIGHUPSetup
+	dropInConfigDir string
+	server          *mcp.Server
+	stopWatcher     func()
+}
+
+func (s *ConfigWatcherSuite) SetupTest() {
+	s.baseSIGHUPSetup = setupSIGHUPTest(s.T())
+	s.dropInConfigDir = filepath.Join(s.tempDir, "conf.d")
+	s.Require().NoError(os.Mkdir(s.dropInConfigDir, 0o755))
+}
+
+func (s *ConfigWatcherSuite) TearDownTest() {
+	// Stop the watcher goroutine before restoring klog
+	if s.stopWatcher != nil {
+		s.stopWatcher()
+	}
+	if s.server != nil {
+		s.server.Close()
+	}
+	s.teardown()
+}
+
+func (s *ConfigWatcherSuite) InitServer(configPath, configDir string) *MCPServerOptions {
+	cfg, err := config.Read(s.T().Context(), configPath, configDir)
+	s.Require().NoError(err)
+	cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+
+	provider, err := kubernetes.NewProvider(s.T().Context(), cfg)
+	s.Require().NoError(err)
+	s.server, err = mcp.NewServer(s.T().Context(), mcp.Configuration{
+		StaticConfig: cfg,
+	}, provider)
+	s.Require().NoError(err)
+
+	opts := &MCPServerOptions{
+		ConfigPath:  configPath,
+		ConfigDir:   configDir,
+		WatchConfig: true,
+		IOStreams: genericiooptions.IOStreams{
+			Out:    s.logBuffer,
+			ErrOut: s.logBuffer,
+		},
+	}
+	oauthState := oauth.NewState(&oauth.Snapshot{})
+
+	cfgState := config.NewStaticConfigState(cfg)
+	s.stopWatcher, err = opts.setupConfigWatcher(s.T().Context(), s.server, oauthState, cfgState)
+	s.Require().NoError(err)
+	return opts
+}
+
+func (s *ConfigWatcherSuite) TestWatcherReloadsConfigFromFile() {
+	// Create initial config file - start with only core toolset (no helm)
+	configPath := filepath.Join(s.tempDir, "config.toml")
+	s.Require().NoError(os.WriteFile(configPath, []byte(`
+		toolsets = ["core", "config"]
+	`), 0o644))
+	_ = s.InitServer(configPath, "")
+
+	s.Run("helm tools are not initially available", func() {
+		s.False(slices.Contains(s.server.GetEnabledTools(), "helm_list"))
+	})
+
+	// Modify the config file to add helm toolset
+	s.Require().NoError(os.WriteFile(configPath, []byte(`
+		toolsets = ["core", "config", "helm"]
+	`), 0o644))
+
+	s.Run("helm tools become available after the config file changes", func() {
+		s.Require().Eventually(func() bool {
+			return slices.Contains(s.server.GetEnabledTools(), "helm_list")
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+}
+
+func (s *ConfigWatcherSuite) TestWatcherReloadsFromDropInDirectory() {
+	// Create initial config file - with helm enabled
+	configPath := filepath.Join(s.tempDir, "config.toml")
+	s.Require().NoError(os.WriteFile(configPath, []byte(`
+		toolsets = ["core", "config", "helm"]
+	`), 0o644))
+
+	// Create initial drop-in file that removes helm
+	dropInPath := filepath.Join(s.dropInConfigDir, "10-override.toml")
+	s.Require().NoError(os.WriteFile(dropInPath, []byte(`
+		toolsets = ["core", "config"]
+	`), 0o644))
+
+	_ = s.InitServer(configPath, "")
+
+	s.Run("drop-in override removes helm from initial config", func() {
+		s.False(slices.Contains(s.server.GetEnabledTools(), "helm_list"))
+	})
+
+	// Update drop-in file to add helm back
+	s.Require().NoError(os.WriteFile(dropInPath, []byte(`
+		toolsets = ["core", "config", "helm"]
+	`), 0o644))
+
+	s.Run("helm tools become available after updating the drop-in file", func() {
+		s.Require().Eventually(func() bool {
+			return slices.Contains(s.server.GetEnabledTools(), "helm_list")
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+}
+
+func (s *ConfigWatcherSuite) TestWatcherIgnoresUnrelatedSiblingFiles() {
+	configPath := filepath.Join(s.tempDir, "config.toml")
+	s.Require().NoError(os.WriteFile(configPath, []byte(`
+		toolsets = ["core", "config"]
+	`), 0o644))
+	opts := s.InitServer(configPath, "")
+
+	s.Run("sibling file events are filtered out", func() {
+		event := fsnotify.Event{Name: filepath.Join(s.tempDir, "unrelated.txt"), Op: fsnotify.Write}
+		s.False(opts.configWatchRelevant(event), "unrelated sibling files should not trigger a reload")
+	})
+	s.Run("non-toml files in the drop-in directory are filtered out", func() {
+		event := fsnotify.Event{Name: filepath.Join(s.dropInConfigDir, ".10-override.toml.swp"), Op: fsnotify.Write}
+		s.False(opts.configWatchRelevant(event), "non-toml drop-in files should not trigger a reload")
+	})
+	s.Run("config file events are relevant", func() {
+		event := fsnotify.Event{Name: configPath, Op: fsnotify.Write}
+		s.True(opts.configWatchRelevant(event))
+	})
+}
+
+func TestConfigWatcher(t *testing.T) {
+	suite.Run(t, new(ConfigWatcherSuite))
+}
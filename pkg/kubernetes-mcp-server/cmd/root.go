@@ -18,6 +18,7 @@ import (
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/demo"
 	internalhttp "github.com/containers/kubernetes-mcp-server/pkg/http"
 	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
 	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
@@ -57,6 +58,9 @@ kubernetes-mcp-server --cluster-provider kubeconfig
 
 # start with kcp cluster provider for multi-workspace support
 kubernetes-mcp-server --cluster-provider kcp
+
+# start against an in-memory demo cluster (no cluster access required)
+kubernetes-mcp-server --demo
 `))
 )
 
@@ -66,6 +70,7 @@ const (
 	flagLogFile              = "log-file"
 	flagConfig               = "config"
 	flagConfigDir            = "config-dir"
+	flagWatchConfig          = "watch-config"
 	flagPort                 = "port"
 	flagBindAddress          = "bind-address"
 	flagSSEBaseUrl           = "sse-base-url"
@@ -86,6 +91,7 @@ const (
 	flagTLSCert              = "tls-cert"
 	flagTLSKey               = "tls-key"
 	flagRequireTLS           = "require-tls"
+	flagDemo                 = "demo"
 )
 
 type MCPServerOptions struct {
@@ -112,9 +118,11 @@ type MCPServerOptions struct {
 	TLSCert              string
 	TLSKey               string
 	RequireTLS           bool
+	Demo                 bool
 
 	ConfigPath   string
 	ConfigDir    string
+	WatchConfig  bool
 	StaticConfig *config.StaticConfig
 
 	logSink *logging.Sink
@@ -167,6 +175,7 @@ func NewMCPServer(streams genericiooptions.IOStreams) *cobra.Command {
 	cmd.Flags().StringVar(&o.LogFile, flagLogFile, o.LogFile, "Defines the server log file path. Required for logging in stdio mode; overrides stdout in HTTP mode. Set to \"stderr\" to log to the standard error stream.")
 	cmd.Flags().StringVar(&o.ConfigPath, flagConfig, o.ConfigPath, "Path of the config file.")
 	cmd.Flags().StringVar(&o.ConfigDir, flagConfigDir, o.ConfigDir, "Path to drop-in configuration directory (files loaded in lexical order). Defaults to "+config.DefaultDropInConfigDir+" relative to the config file if --config is set.")
+	cmd.Flags().BoolVar(&o.WatchConfig, flagWatchConfig, o.WatchConfig, "Watch the config file and drop-in directory and reload the configuration automatically on change (in addition to SIGHUP). Requires --config or --config-dir.")
 	cmd.Flags().StringVar(&o.Port, flagPort, o.Port, "Start a streamable HTTP and SSE HTTP server on the specified port (e.g. 8080)")
 	cmd.Flags().StringVar(&o.BindAddress, flagBindAddress, o.BindAddress, "Address to bind the HTTP server to (e.g. 127.0.0.1). Defaults to 0.0.0.0 (all interfaces)")
 	cmd.Flags().StringVar(&o.SSEBaseUrl, flagSSEBaseUrl, o.SSEBaseUrl, "SSE public base URL to use when sending the endpoint message (e.g. https://example.com)")
@@ -193,6 +202,7 @@ func NewMCPServer(streams genericiooptions.IOStreams) *cobra.Command {
 	cmd.Flags().StringVar(&o.TLSCert, flagTLSCert, o.TLSCert, "Path to TLS certificate file for HTTPS. Must be used together with --tls-key.")
 	cmd.Flags().StringVar(&o.TLSKey, flagTLSKey, o.TLSKey, "Path to TLS private key file for HTTPS. Must be used together with --tls-cert.")
 	cmd.Flags().BoolVar(&o.RequireTLS, flagRequireTLS, o.RequireTLS, "Require TLS for server and all outbound connections")
+	cmd.Flags().BoolVar(&o.Demo, flagDemo, o.Demo, "Run against an in-memory demo cluster pre-populated with sample OpenShift objects (pods, routes, clusteroperators, OADP resources). No cluster access is required. Intended for local development of MCP clients.")
 
 	return cmd
 }
@@ -204,6 +214,10 @@ func (m *MCPServerOptions) Complete(ctx context.Context, cmd *cobra.Command) err
 			return err
 		}
 		m.StaticConfig = cnf
+	} else if err := m.StaticConfig.ApplyEnvOverrides(); err != nil {
+		// Without config files, config.Read is never called, so apply the
+		// MCP_SERVER_* environment overrides to the defaults here.
+		return err
 	}
 
 	m.loadFlags(cmd)
@@ -327,6 +341,12 @@ func (m *MCPServerOptions) Validate(ctx context.Context) error {
 			return fmt.Errorf("require_tls is enabled but TLS certificates are not configured (set tls_cert and tls_key)")
 		}
 	}
+	if m.WatchConfig && m.ConfigPath == "" && m.ConfigDir == "" {
+		return fmt.Errorf("--watch-config requires --config or --config-dir to be set")
+	}
+	if m.Demo && m.StaticConfig.KubeConfig != "" {
+		return fmt.Errorf("--demo cannot be combined with --kubeconfig (the demo cluster provides its own)")
+	}
 	return nil
 }
 
@@ -356,6 +376,20 @@ func (m *MCPServerOptions) Run(ctx context.Context) error {
 		return nil
 	}
 
+	if m.Demo {
+		demoServer, err := demo.NewServer()
+		if err != nil {
+			return fmt.Errorf("failed to start demo cluster: %w", err)
+		}
+		defer func() { _ = demoServer.Close() }()
+		kubeconfigPath, err := demoServer.KubeconfigPath()
+		if err != nil {
+			return fmt.Errorf("failed to start demo cluster: %w", err)
+		}
+		m.StaticConfig.KubeConfig = kubeconfigPath
+		klogutil.FromContext(ctx).Info("Demo mode: serving an in-memory cluster, no real cluster is contacted", "server", demoServer.URL())
+	}
+
 	oidcProvider, httpClient, err := internaloauth.CreateOIDCProviderAndClient(m.StaticConfig)
 	if err != nil {
 		return err
@@ -397,6 +431,14 @@ func (m *MCPServerOptions) Run(ctx context.Context) error {
 	if m.ConfigPath != "" || m.ConfigDir != "" {
 		stopSIGHUP := m.setupSIGHUPHandler(ctx, mcpServer, oauthState, cfgState)
 		defer stopSIGHUP()
+
+		if m.WatchConfig {
+			stopWatcher, err := m.setupConfigWatcher(ctx, mcpServer, oauthState, cfgState)
+			if err != nil {
+				return fmt.Errorf("failed to watch configuration files: %w", err)
+			}
+			defer stopWatcher()
+		}
 	}
 
 	if m.StaticConfig.Port != "" {
@@ -429,56 +471,9 @@ func (m *MCPServerOptions) setupSIGHUPHandler(
 		defer close(done)
 		for range sigHupCh {
 			logger.V(1).Info("Received SIGHUP signal, reloading configuration...")
-
-			// Reload config from files
-			newConfig, err := config.Read(ctx, m.ConfigPath, m.ConfigDir)
-			if err != nil {
-				logger.Error(err, "Failed to reload configuration from disk")
-				continue
-			}
-
-			// Apply the new configuration to the MCP server first — if this fails,
-			// we skip the OAuth state and config state updates to avoid inconsistent state.
-			if err := mcpServer.ReloadConfiguration(ctx, newConfig); err != nil {
-				logger.Error(err, "Failed to apply reloaded configuration")
-				continue
+			if m.reloadConfiguration(ctx, mcpServer, oauthState, cfgState) {
+				logger.V(1).Info("Configuration reloaded successfully via SIGHUP")
 			}
-
-			// Re-apply the log destination so log_file changes and file
-			// rotations are handled correctly. Failures are logged but never
-			// fatal — the previous destination is preserved. logSink can be
-			// nil in tests that exercise the SIGHUP handler in isolation.
-			if m.logSink != nil {
-				if err := m.logSink.Reload(newConfig); err != nil {
-					logger.Error(err, "Failed to reload log destination, keeping previous one")
-				}
-			}
-			// Publish the new config so the HTTP auth middleware picks it up.
-			cfgState.Store(newConfig)
-
-			// Check if OAuth-relevant config changed and update the shared state
-			currentSnapshot := oauthState.Load()
-			if currentSnapshot == nil {
-				currentSnapshot = &internaloauth.Snapshot{}
-			}
-			newSnapshot := internaloauth.SnapshotFromConfig(newConfig, currentSnapshot.OIDCProvider, currentSnapshot.HTTPClient)
-			if currentSnapshot.HasProviderConfigChanged(newSnapshot) {
-				logger.V(1).Info("OAuth configuration changed, recreating OIDC provider...")
-				newProvider, newClient, err := internaloauth.CreateOIDCProviderAndClient(newConfig)
-				if err != nil {
-					logger.Error(err, "Failed to recreate OIDC provider during reload")
-					continue
-				}
-				newSnapshot.OIDCProvider = newProvider
-				newSnapshot.HTTPClient = newClient
-				oauthState.Store(newSnapshot)
-				logger.V(1).Info("OIDC provider and HTTP client updated successfully")
-			} else if currentSnapshot.HasWellKnownConfigChanged(newSnapshot) {
-				oauthState.Store(newSnapshot)
-				logger.V(1).Info("OAuth well-known configuration updated")
-			}
-
-			logger.V(1).Info("Configuration reloaded successfully via SIGHUP")
 		}
 	}()
 
@@ -490,3 +485,67 @@ func (m *MCPServerOptions) setupSIGHUPHandler(
 		<-done // Wait for goroutine to finish
 	}
 }
+
+// reloadConfiguration re-reads the configuration from disk and applies it to
+// the MCP server, the log sink, the shared config state, and the OAuth state.
+// It is the single reload path shared by the SIGHUP handler and the config
+// file watcher. Failures are logged and leave the previous configuration in
+// place; the return value reports whether the new configuration was applied.
+func (m *MCPServerOptions) reloadConfiguration(
+	ctx context.Context,
+	mcpServer *mcp.Server,
+	oauthState *internaloauth.State,
+	cfgState *config.StaticConfigState,
+) bool {
+	logger := klogutil.FromContext(ctx)
+
+	// Reload config from files
+	newConfig, err := config.Read(ctx, m.ConfigPath, m.ConfigDir)
+	if err != nil {
+		logger.Error(err, "Failed to reload configuration from disk")
+		return false
+	}
+
+	// Apply the new configuration to the MCP server first — if this fails,
+	// we skip the OAuth state and config state updates to avoid inconsistent state.
+	if err := mcpServer.ReloadConfiguration(ctx, newConfig); err != nil {
+		logger.Error(err, "Failed to apply reloaded configuration")
+		return false
+	}
+
+	// Re-apply the log destination so log_file changes and file
+	// rotations are handled correctly. Failures are logged but never
+	// fatal — the previous destination is preserved. logSink can be
+	// nil in tests that exercise the reload path in isolation.
+	if m.logSink != nil {
+		if err := m.logSink.Reload(newConfig); err != nil {
+			logger.Error(err, "Failed to reload log destination, keeping previous one")
+		}
+	}
+	// Publish the new config so the HTTP auth middleware picks it up.
+	cfgState.Store(newConfig)
+
+	// Check if OAuth-relevant config changed and update the shared state
+	currentSnapshot := oauthState.Load()
+	if currentSnapshot == nil {
+		currentSnapshot = &internaloauth.Snapshot{}
+	}
+	newSnapshot := internaloauth.SnapshotFromConfig(newConfig, currentSnapshot.OIDCProvider, currentSnapshot.HTTPClient)
+	if currentSnapshot.HasProviderConfigChanged(newSnapshot) {
+		logger.V(1).Info("OAuth configuration changed, recreating OIDC provider...")
+		newProvider, newClient, err := internaloauth.CreateOIDCProviderAndClient(newConfig)
+		if err != nil {
+			logger.Error(err, "Failed to recreate OIDC provider during reload")
+			return false
+		}
+		newSnapshot.OIDCProvider = newProvider
+		newSnapshot.HTTPClient = newClient
+		oauthState.Store(newSnapshot)
+		logger.V(1).Info("OIDC provider and HTTP client updated successfully")
+	} else if currentSnapshot.HasWellKnownConfigChanged(newSnapshot) {
+		oauthState.Store(newSnapshot)
+		logger.V(1).Info("OAuth well-known configuration updated")
+	}
+
+	return true
+}
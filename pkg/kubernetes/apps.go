@@ -0,0 +1,254 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/version"
+)
+
+// internalRegistry is the in-cluster service address of the OpenShift
+// integrated image registry, where S2I builds push their output.
+const internalRegistry = "image-registry.openshift-image-registry.svc:5000"
+
+// AppDeployOptions configures AppDeploy. Exactly one of Image or GitURL must
+// be set: Image deploys an existing container image, GitURL builds the
+// repository with Source-to-Image (S2I) first and deploys the result.
+type AppDeployOptions struct {
+	Namespace string
+	Name      string
+	Image     string
+	GitURL    string
+	GitRef    string
+	// Builder is the S2I builder ImageStreamTag in the openshift namespace
+	// (e.g. "nodejs:latest"). Required when GitURL is set.
+	Builder  string
+	Port     int32
+	Replicas int32
+}
+
+// AppDeploy implements the oc new-app quickstart workflow as a single call:
+// it creates a Deployment, a Service, and (on OpenShift) a Route for the
+// application, plus an ImageStream and S2I BuildConfig when deploying from a
+// Git repository. All objects carry the managed-by labels so they can be
+// identified and cleaned up later.
+func (c *Core) AppDeploy(ctx context.Context, options AppDeployOptions) ([]*unstructured.Unstructured, error) {
+	if (options.Image == "") == (options.GitURL == "") {
+		return nil, errors.New("exactly one of image or gitUrl must be provided")
+	}
+	fromGit := options.GitURL != ""
+	if fromGit && !c.supportsGroupVersion("build.openshift.io/v1") {
+		return nil, errors.New("deploying from a Git repository requires OpenShift builds (build.openshift.io/v1 is not available in this cluster); provide a pre-built image instead")
+	}
+	if fromGit && options.Builder == "" {
+		return nil, errors.New("builder is required when deploying from a Git repository (an S2I builder ImageStreamTag in the openshift namespace, e.g. nodejs:latest)")
+	}
+	name := options.Name
+	if name == "" {
+		if fromGit {
+			name = appNameFrom(options.GitURL)
+		} else {
+			name = appNameFrom(options.Image)
+		}
+	}
+	namespace := c.NamespaceOrDefault(options.Namespace)
+	port := options.Port
+	if port <= 0 {
+		port = 8080
+	}
+	replicas := options.Replicas
+	if replicas <= 0 {
+		replicas = 1
+	}
+	labels := map[string]string{
+		AppKubernetesName:      name,
+		AppKubernetesComponent: name,
+		AppKubernetesManagedBy: version.BinaryName,
+		AppKubernetesPartOf:    name,
+	}
+
+	var resources []any
+	image := options.Image
+	if fromGit {
+		// The build pushes to the ImageStream via the integrated registry;
+		// the Deployment pulls the result from there.
+		image = internalRegistry + "/" + namespace + "/" + name + ":latest"
+		resources = append(resources,
+			appImageStream(name, namespace, labels),
+			appBuildConfig(name, namespace, labels, options),
+		)
+	}
+	resources = append(resources,
+		&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To(replicas),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{AppKubernetesName: name}},
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: v1.PodSpec{Containers: []v1.Container{{
+						Name:  name,
+						Image: image,
+						Ports: []v1.ContainerPort{{ContainerPort: port}},
+					}}},
+				},
+			},
+		},
+		&v1.Service{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec: v1.ServiceSpec{
+				Selector: map[string]string{AppKubernetesName: name},
+				Type:     v1.ServiceTypeClusterIP,
+				Ports:    []v1.ServicePort{{Port: port, TargetPort: intstr.FromInt32(port)}},
+			},
+		},
+	)
+	if c.supportsGroupVersion("route.openshift.io/v1") {
+		resources = append(resources, &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "route.openshift.io/v1",
+				"kind":       "Route",
+				"metadata": map[string]interface{}{
+					"name":      name,
+					"namespace": namespace,
+					"labels":    labels,
+				},
+				"spec": map[string]interface{}{
+					"to": map[string]interface{}{
+						"kind":   "Service",
+						"name":   name,
+						"weight": 100,
+					},
+					"port": map[string]interface{}{
+						"targetPort": intstr.FromInt32(port),
+					},
+					"tls": map[string]interface{}{
+						"termination":                   "edge",
+						"insecureEdgeTerminationPolicy": "Redirect",
+					},
+				},
+			},
+		})
+	}
+
+	converter := runtime.DefaultUnstructuredConverter
+	var toCreate []*unstructured.Unstructured
+	for _, obj := range resources {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			toCreate = append(toCreate, u)
+			continue
+		}
+		m, err := converter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		u := &unstructured.Unstructured{}
+		if err = converter.FromUnstructured(m, u); err != nil {
+			return nil, err
+		}
+		toCreate = append(toCreate, u)
+	}
+	return c.resourcesCreateOrUpdate(ctx, toCreate)
+}
+
+func appImageStream(name, namespace string, labels map[string]string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "image.openshift.io/v1",
+			"kind":       "ImageStream",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+				"labels":    labels,
+			},
+		},
+	}
+}
+
+func appBuildConfig(name, namespace string, labels map[string]string, options AppDeployOptions) *unstructured.Unstructured {
+	git := map[string]interface{}{"uri": options.GitURL}
+	if options.GitRef != "" {
+		git["ref"] = options.GitRef
+	}
+	builder := options.Builder
+	if !strings.Contains(builder, ":") {
+		builder += ":latest"
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "build.openshift.io/v1",
+			"kind":       "BuildConfig",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+				"labels":    labels,
+			},
+			"spec": map[string]interface{}{
+				"source": map[string]interface{}{
+					"type": "Git",
+					"git":  git,
+				},
+				"strategy": map[string]interface{}{
+					"type": "Source",
+					"sourceStrategy": map[string]interface{}{
+						"from": map[string]interface{}{
+							"kind":      "ImageStreamTag",
+							"namespace": "openshift",
+							"name":      builder,
+						},
+					},
+				},
+				"output": map[string]interface{}{
+					"to": map[string]interface{}{
+						"kind": "ImageStreamTag",
+						"name": name + ":latest",
+					},
+				},
+				"triggers": []interface{}{
+					map[string]interface{}{"type": "ConfigChange"},
+					map[string]interface{}{"type": "ImageChange", "imageChange": map[string]interface{}{}},
+				},
+			},
+		},
+	}
+}
+
+// appNameFrom derives a DNS-1123 compatible application name from an image
+// reference or Git URL (e.g. "https://github.com/org/my-app.git" and
+// "quay.io/org/my-app:v1" both yield "my-app").
+func appNameFrom(ref string) string {
+	name := ref
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		name = name[idx+1:]
+	}
+	name = strings.TrimSuffix(name, ".git")
+	// Strip image tag or digest
+	if idx := strings.IndexAny(name, ":@"); idx >= 0 {
+		name = name[:idx]
+	}
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('-')
+		}
+	}
+	name = strings.Trim(b.String(), "-")
+	if name == "" {
+		name = "app-" + rand.String(5)
+	}
+	return name
+}
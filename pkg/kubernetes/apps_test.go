@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AppNameSuite struct {
+	suite.Suite
+}
+
+func (s *AppNameSuite) TestAppNameFrom() {
+	s.Run("image references", func() {
+		s.Run("strips registry, path, and tag", func() {
+			s.Equal("my-app", appNameFrom("quay.io/org/my-app:v1"))
+		})
+		s.Run("strips digest", func() {
+			s.Equal("my-app", appNameFrom("quay.io/org/my-app@sha256:abc123"))
+		})
+		s.Run("bare image name is kept", func() {
+			s.Equal("nginx", appNameFrom("nginx"))
+		})
+	})
+	s.Run("git URLs", func() {
+		s.Run("strips host, path, and .git suffix", func() {
+			s.Equal("my-app", appNameFrom("https://github.com/org/my-app.git"))
+		})
+		s.Run("works without .git suffix", func() {
+			s.Equal("my-app", appNameFrom("https://github.com/org/my-app"))
+		})
+	})
+	s.Run("sanitization", func() {
+		s.Run("lowercases and replaces invalid characters", func() {
+			s.Equal("my-app", appNameFrom("My_App"))
+		})
+		s.Run("trims leading and trailing dashes", func() {
+			s.Equal("app", appNameFrom("_app_"))
+		})
+		s.Run("falls back to a random name when nothing is left", func() {
+			s.True(strings.HasPrefix(appNameFrom("___"), "app-"), "expected a generated app-<suffix> name")
+		})
+	})
+}
+
+func TestAppName(t *testing.T) {
+	suite.Run(t, new(AppNameSuite))
+}
@@ -71,6 +71,18 @@ func NewKubernetes(
 		k.restConfig.UserAgent = rest.DefaultKubernetesUserAgent()
 	}
 
+	// Snapshot record/replay wraps first so it sits innermost: record
+	// captures exactly what went over the wire and replay intercepts before
+	// any network access.
+	if mode := baseConfig.GetSnapshotMode(); mode != "" {
+		store, err := openSnapshotStore(mode, baseConfig.GetSnapshotPath())
+		if err != nil {
+			return nil, err
+		}
+		k.restConfig.Wrap(func(original http.RoundTripper) http.RoundTripper {
+			return &SnapshotRoundTripper{delegate: original, store: store, record: mode == api.SnapshotModeRecord}
+		})
+	}
 	k.restConfig.Wrap(func(original http.RoundTripper) http.RoundTripper {
 		return NewAccessControlRoundTripper(ctx, AccessControlRoundTripperConfig{
 			Delegate:                  original,
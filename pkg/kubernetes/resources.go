@@ -39,9 +39,61 @@ func (c *Core) ResourcesList(ctx context.Context, gvk *schema.GroupVersionKind,
 	if options.AsTable {
 		return c.resourcesListAsTable(ctx, gvk, gvr, namespace, options)
 	}
+	if options.MaxObjects > 0 {
+		return c.resourcesListPaginated(ctx, gvr, namespace, options)
+	}
 	return c.DynamicClient().Resource(*gvr).Namespace(namespace).List(ctx, options.ListOptions)
 }
 
+// listPageSize is the chunk size used when a list is paginated to enforce
+// ListOptions.MaxObjects; pages never hold more than this many objects in
+// flight on top of the accumulated result.
+const listPageSize = int64(500)
+
+// resourcesListPaginated lists in pages of at most listPageSize objects and
+// aggregates them, aborting with an error as soon as the result would exceed
+// options.MaxObjects — the remaining pages are never requested or decoded.
+func (c *Core) resourcesListPaginated(ctx context.Context, gvr *schema.GroupVersionResource, namespace string, options api.ListOptions) (runtime.Unstructured, error) {
+	pageOptions := options.ListOptions
+	var aggregated *unstructured.UnstructuredList
+	for {
+		pageOptions.Limit = listPageSize
+		// Request one object past the cap so overflow is detected even when
+		// the server elides the continue token on an exactly-full last page.
+		if remaining := options.MaxObjects - c.listedObjectCount(aggregated); remaining < listPageSize {
+			pageOptions.Limit = remaining + 1
+		}
+		page, err := c.DynamicClient().Resource(*gvr).Namespace(namespace).List(ctx, pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		if aggregated == nil {
+			aggregated = page
+		} else {
+			aggregated.Items = append(aggregated.Items, page.Items...)
+		}
+		if c.listedObjectCount(aggregated) > options.MaxObjects {
+			return nil, fmt.Errorf("list exceeds the configured maximum of %d objects (list_max_objects), "+
+				"narrow the query with a namespace, labelSelector, or fieldSelector", options.MaxObjects)
+		}
+		pageOptions.Continue = page.GetContinue()
+		if pageOptions.Continue == "" {
+			break
+		}
+	}
+	// The continue token of the last page must not leak into the aggregated
+	// result — it only covers the final chunk.
+	aggregated.SetContinue("")
+	return aggregated, nil
+}
+
+func (c *Core) listedObjectCount(list *unstructured.UnstructuredList) int64 {
+	if list == nil {
+		return 0
+	}
+	return int64(len(list.Items))
+}
+
 func (c *Core) ResourcesGet(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
 	gvr, err := c.resourceFor(gvk)
 	if err != nil {
@@ -145,6 +197,11 @@ func (c *Core) resourcesListAsTable(ctx context.Context, gvk *schema.GroupVersio
 		url = append(url, "namespaces", namespace)
 	}
 	url = append(url, gvr.Resource)
+	// Table responses decode every row into memory at once; request one row
+	// past the cap so an oversized list is rejected instead of aggregated.
+	if options.MaxObjects > 0 && (options.Limit == 0 || options.Limit > options.MaxObjects) {
+		options.Limit = options.MaxObjects + 1
+	}
 	var table metav1.Table
 	err := c.CoreV1().RESTClient().
 		Get().
@@ -159,6 +216,10 @@ func (c *Core) resourcesListAsTable(ctx context.Context, gvk *schema.GroupVersio
 	if err != nil {
 		return nil, err
 	}
+	if options.MaxObjects > 0 && int64(len(table.Rows)) > options.MaxObjects {
+		return nil, fmt.Errorf("list exceeds the configured maximum of %d objects (list_max_objects), "+
+			"narrow the query with a namespace, labelSelector, or fieldSelector", options.MaxObjects)
+	}
 	// Add metav1.Table apiVersion and kind to the unstructured object (server may not return these fields)
 	table.SetGroupVersionKind(metav1.SchemeGroupVersion.WithKind("Table"))
 	// Add additional columns for fields that aren't returned by the server
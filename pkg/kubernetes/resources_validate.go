@@ -0,0 +1,113 @@
+package kubernetes
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/version"
+)
+
+// ResourceValidation holds the server-side dry-run outcome for a single
+// manifest document.
+type ResourceValidation struct {
+	Object *unstructured.Unstructured
+	// Warnings surfaced by the API server during the dry-run (e.g. deprecation
+	// notices or admission webhook warnings).
+	Warnings []string
+	// Err is the validation failure (schema, CEL rule, or admission rejection),
+	// nil when the document is valid.
+	Err error
+}
+
+// ResourcesValidate performs a server-side dry-run apply of each document in
+// the provided multi-document YAML or JSON manifest, without persisting
+// anything. The API server runs the full validation chain (OpenAPI schema,
+// CEL validation rules, admission webhooks), so the per-document errors and
+// warnings match what a real create_or_update would produce.
+func (c *Core) ResourcesValidate(ctx context.Context, resource string) ([]ResourceValidation, error) {
+	separator := regexp.MustCompile(`\r?\n---\r?\n`)
+	documents := separator.Split(resource, -1)
+	var parsedResources []*unstructured.Unstructured
+	for _, document := range documents {
+		var obj unstructured.Unstructured
+		if err := yaml.NewYAMLToJSONDecoder(strings.NewReader(document)).Decode(&obj); err != nil {
+			return nil, err
+		}
+		// Match the create_or_update behavior: status is controller-owned.
+		delete(obj.Object, "status")
+		parsedResources = append(parsedResources, &obj)
+	}
+
+	// Use a dedicated dynamic client so API server warning headers can be
+	// captured per request instead of going to the default warning handler.
+	warnings := &warningCollector{}
+	restConfig := rest.CopyConfig(c.RESTConfig())
+	restConfig.WarningHandlerWithContext = warnings
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ResourceValidation, 0, len(parsedResources))
+	for _, obj := range parsedResources {
+		result := ResourceValidation{Object: obj}
+		gvk := obj.GroupVersionKind()
+		gvr, rErr := c.resourceFor(&gvk)
+		if rErr != nil {
+			result.Err = rErr
+			results = append(results, result)
+			continue
+		}
+		namespace := obj.GetNamespace()
+		if namespaced, nsErr := c.isNamespaced(&gvk); nsErr == nil && namespaced {
+			namespace = c.NamespaceOrDefault(namespace)
+		}
+		warnings.reset()
+		_, result.Err = dynamicClient.Resource(*gvr).Namespace(namespace).Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+			FieldManager: version.BinaryName,
+			Force:        true,
+			DryRun:       []string{metav1.DryRunAll},
+		})
+		result.Warnings = warnings.drain()
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// warningCollector accumulates API server warning headers for the request in
+// flight.
+type warningCollector struct {
+	mu       sync.Mutex
+	warnings []string
+}
+
+var _ rest.WarningHandlerWithContext = (*warningCollector)(nil)
+
+func (w *warningCollector) HandleWarningHeaderWithContext(_ context.Context, _ int, _ string, text string) {
+	if text == "" {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.warnings = append(w.warnings, text)
+}
+
+func (w *warningCollector) reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.warnings = nil
+}
+
+func (w *warningCollector) drain() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.warnings
+}
@@ -0,0 +1,128 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// s2iBuilderNamespace is where OpenShift ships the shared builder
+// ImageStreams that S2I builds reference.
+const s2iBuilderNamespace = "openshift"
+
+// S2IBuilderTag is a single tag of a builder ImageStream (e.g. "20-ubi9").
+type S2IBuilderTag struct {
+	Name string
+	// Supports lists the languages/frameworks the tag advertises via the
+	// "supports" annotation (e.g. "nodejs:20,nodejs").
+	Supports string
+	// Version is the language/framework version from the "version" annotation.
+	Version string
+}
+
+// S2IBuilder is an ImageStream in the openshift namespace with at least one
+// tag annotated as an S2I builder.
+type S2IBuilder struct {
+	Name string
+	Tags []S2IBuilderTag
+}
+
+// S2IBuilders lists the Source-to-Image builder ImageStreams available in the
+// openshift namespace. A tag counts as a builder when its "tags" annotation
+// contains "builder" and not "hidden", following the convention used by the
+// OpenShift sample ImageStreams.
+func (c *Core) S2IBuilders(ctx context.Context) ([]S2IBuilder, error) {
+	if !c.supportsGroupVersion("image.openshift.io/v1") {
+		return nil, errors.New("image.openshift.io/v1 is not available in this cluster (S2I builders require OpenShift)")
+	}
+	imageStreams, err := c.DynamicClient().
+		Resource(schema.GroupVersionResource{Group: "image.openshift.io", Version: "v1", Resource: "imagestreams"}).
+		Namespace(s2iBuilderNamespace).
+		List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var builders []S2IBuilder
+	for _, imageStream := range imageStreams.Items {
+		builder := S2IBuilder{Name: imageStream.GetName()}
+		tags, _, _ := unstructured.NestedSlice(imageStream.Object, "spec", "tags")
+		for _, rawTag := range tags {
+			tag, ok := rawTag.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := tag["name"].(string)
+			annotations, _ := tag["annotations"].(map[string]interface{})
+			tagKinds, _ := annotations["tags"].(string)
+			kinds := strings.Split(tagKinds, ",")
+			if !slices.Contains(kinds, "builder") || slices.Contains(kinds, "hidden") {
+				continue
+			}
+			supports, _ := annotations["supports"].(string)
+			version, _ := annotations["version"].(string)
+			builder.Tags = append(builder.Tags, S2IBuilderTag{Name: name, Supports: supports, Version: version})
+		}
+		if len(builder.Tags) > 0 {
+			builders = append(builders, builder)
+		}
+	}
+	slices.SortFunc(builders, func(a, b S2IBuilder) int { return strings.Compare(a.Name, b.Name) })
+	return builders, nil
+}
+
+// s2iManifestHint maps a manifest file commonly found at the root of a source
+// repository to the S2I builder language it indicates.
+type s2iManifestHint struct {
+	File     string
+	Language string
+}
+
+// s2iManifestHints is ordered by decreasing specificity: the first matching
+// file wins.
+var s2iManifestHints = []s2iManifestHint{
+	{"package.json", "nodejs"},
+	{"pom.xml", "java"},
+	{"build.gradle", "java"},
+	{"build.gradle.kts", "java"},
+	{"go.mod", "golang"},
+	{"requirements.txt", "python"},
+	{"pyproject.toml", "python"},
+	{"setup.py", "python"},
+	{"Pipfile", "python"},
+	{"Gemfile", "ruby"},
+	{"composer.json", "php"},
+	{"global.json", "dotnet"},
+}
+
+// S2IManifestCandidates returns the manifest file names worth looking for at
+// the root of a source repository when suggesting a builder.
+func S2IManifestCandidates() []string {
+	candidates := make([]string, 0, len(s2iManifestHints))
+	for _, hint := range s2iManifestHints {
+		candidates = append(candidates, hint.File)
+	}
+	return candidates
+}
+
+// SuggestS2IBuilder inspects a list of repository root file names and returns
+// the S2I builder language the repository most likely needs, plus the
+// manifest file that triggered the suggestion. Returns empty strings when no
+// known manifest is present.
+func SuggestS2IBuilder(files []string) (language, manifest string) {
+	for _, hint := range s2iManifestHints {
+		if slices.Contains(files, hint.File) {
+			return hint.Language, hint.File
+		}
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, ".csproj") || strings.HasSuffix(file, ".fsproj") {
+			return "dotnet", file
+		}
+	}
+	return "", ""
+}
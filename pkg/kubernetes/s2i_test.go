@@ -0,0 +1,56 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SuggestS2IBuilderSuite struct {
+	suite.Suite
+}
+
+func (s *SuggestS2IBuilderSuite) TestSuggestS2IBuilder() {
+	s.Run("detects languages from manifest files", func() {
+		s.Run("package.json suggests nodejs", func() {
+			language, manifest := SuggestS2IBuilder([]string{"README.md", "package.json"})
+			s.Equal("nodejs", language)
+			s.Equal("package.json", manifest)
+		})
+		s.Run("pom.xml suggests java", func() {
+			language, _ := SuggestS2IBuilder([]string{"pom.xml", "src"})
+			s.Equal("java", language)
+		})
+		s.Run("go.mod suggests golang", func() {
+			language, _ := SuggestS2IBuilder([]string{"go.mod", "go.sum", "main.go"})
+			s.Equal("golang", language)
+		})
+		s.Run("csproj suggests dotnet", func() {
+			language, manifest := SuggestS2IBuilder([]string{"MyApp.csproj"})
+			s.Equal("dotnet", language)
+			s.Equal("MyApp.csproj", manifest)
+		})
+	})
+	s.Run("ambiguous repositories", func() {
+		s.Run("more specific manifest wins over requirements.txt", func() {
+			language, manifest := SuggestS2IBuilder([]string{"requirements.txt", "package.json"})
+			s.Equal("nodejs", language)
+			s.Equal("package.json", manifest)
+		})
+	})
+	s.Run("edge cases", func() {
+		s.Run("returns empty for unknown files", func() {
+			language, manifest := SuggestS2IBuilder([]string{"README.md", "LICENSE"})
+			s.Empty(language)
+			s.Empty(manifest)
+		})
+		s.Run("returns empty for nil input", func() {
+			language, _ := SuggestS2IBuilder(nil)
+			s.Empty(language)
+		})
+	})
+}
+
+func TestSuggestS2IBuilder(t *testing.T) {
+	suite.Run(t, new(SuggestS2IBuilderSuite))
+}
@@ -0,0 +1,191 @@
+package kubernetes
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// SnapshotRoundTripper records Kubernetes API responses to a snapshot file
+// (record mode) or serves them from a previously recorded snapshot without
+// contacting a cluster (replay mode). It sits innermost in the transport
+// chain so record captures exactly what went over the wire and replay
+// intercepts before any network access. Streaming exchanges (watches,
+// exec/attach upgrades) pass through unrecorded: their bodies never end, so
+// they cannot be captured, and replay returns an error for them.
+type SnapshotRoundTripper struct {
+	delegate http.RoundTripper
+	store    *snapshotStore
+	record   bool
+}
+
+var _ http.RoundTripper = (*SnapshotRoundTripper)(nil)
+
+func (rt *SnapshotRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.delegate
+}
+
+func (rt *SnapshotRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !rt.record {
+		return rt.store.replay(req)
+	}
+	resp, err := rt.delegate.RoundTrip(req)
+	if err != nil || isStreamingExchange(req, resp) {
+		return resp, err
+	}
+	return rt.store.record(req, resp)
+}
+
+// isStreamingExchange reports whether the exchange holds the connection open
+// (watch long-polls, SPDY/websocket upgrades) and therefore cannot be
+// captured by reading the response body to EOF.
+func isStreamingExchange(req *http.Request, resp *http.Response) bool {
+	return req.URL.Query().Get("watch") == "true" ||
+		req.Header.Get("Upgrade") != "" ||
+		resp.StatusCode == http.StatusSwitchingProtocols
+}
+
+// snapshotEntry is one recorded exchange, serialized as a JSON line in the
+// snapshot file. The body is base64-encoded by encoding/json.
+type snapshotEntry struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   []byte      `json:"body,omitempty"`
+}
+
+// snapshotStore is the shared backing for one snapshot file: an append-only
+// file handle in record mode, a lookup table keyed by method and URL in
+// replay mode. Repeated recordings of the same request overwrite each other
+// on replay (last one wins). Stores are shared per path so every derived
+// client appends to, or reads from, the same snapshot.
+type snapshotStore struct {
+	mu      sync.Mutex
+	path    string
+	entries map[string]*snapshotEntry
+	file    *os.File
+}
+
+var (
+	snapshotStoresMu sync.Mutex
+	snapshotStores   = map[string]*snapshotStore{}
+)
+
+// openSnapshotStore returns the shared store for path, creating it on first
+// use: record mode opens the file for appending, replay mode loads every
+// recorded entry into memory.
+func openSnapshotStore(mode, path string) (*snapshotStore, error) {
+	snapshotStoresMu.Lock()
+	defer snapshotStoresMu.Unlock()
+	if store, ok := snapshotStores[path]; ok {
+		return store, nil
+	}
+	store := &snapshotStore{path: path}
+	if mode == api.SnapshotModeRecord {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open snapshot file for recording: %w", err)
+		}
+		store.file = file
+	} else {
+		entries, err := loadSnapshotEntries(path)
+		if err != nil {
+			return nil, err
+		}
+		store.entries = entries
+	}
+	snapshotStores[path] = store
+	return store, nil
+}
+
+func loadSnapshotEntries(path string) (map[string]*snapshotEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot file for replay: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+	entries := make(map[string]*snapshotEntry)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		entry := &snapshotEntry{}
+		if err := json.Unmarshal(line, entry); err != nil {
+			return nil, fmt.Errorf("failed to parse snapshot file %s: %w", path, err)
+		}
+		entries[entry.Method+" "+entry.URL] = entry
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read snapshot file %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+func snapshotKey(req *http.Request) string {
+	url := req.URL.Path
+	if req.URL.RawQuery != "" {
+		url += "?" + req.URL.RawQuery
+	}
+	return req.Method + " " + url
+}
+
+// record captures the response body, appends the exchange to the snapshot
+// file, and returns the response with its body restored for the caller.
+func (s *snapshotStore) record(req *http.Request, resp *http.Response) (*http.Response, error) {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body for snapshot: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	key := snapshotKey(req)
+	entry := &snapshotEntry{
+		Method: req.Method,
+		URL:    key[len(req.Method)+1:],
+		Status: resp.StatusCode,
+		Header: resp.Header,
+		Body:   body,
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize snapshot entry: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot entry: %w", err)
+	}
+	return resp, nil
+}
+
+// replay serves the recorded response for req, or an error when the snapshot
+// holds no matching exchange.
+func (s *snapshotStore) replay(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	entry, ok := s.entries[snapshotKey(req)]
+	s.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("snapshot %s has no recorded response for %s", s.path, snapshotKey(req))
+	}
+	return &http.Response{
+		StatusCode:    entry.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        entry.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+	}, nil
+}
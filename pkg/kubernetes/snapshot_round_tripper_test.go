@@ -0,0 +1,123 @@
+package kubernetes
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SnapshotRoundTripperTestSuite struct {
+	suite.Suite
+	snapshotPath string
+}
+
+func (s *SnapshotRoundTripperTestSuite) SetupTest() {
+	s.snapshotPath = filepath.Join(s.T().TempDir(), "snapshot.jsonl")
+}
+
+func (s *SnapshotRoundTripperTestSuite) recordStore() *snapshotStore {
+	file, err := os.OpenFile(s.snapshotPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	s.Require().NoError(err, "Expected to open snapshot file for recording")
+	s.T().Cleanup(func() { _ = file.Close() })
+	return &snapshotStore{path: s.snapshotPath, file: file}
+}
+
+func (s *SnapshotRoundTripperTestSuite) replayStore() *snapshotStore {
+	entries, err := loadSnapshotEntries(s.snapshotPath)
+	s.Require().NoError(err, "Expected to load snapshot entries for replay")
+	return &snapshotStore{path: s.snapshotPath, entries: entries}
+}
+
+func (s *SnapshotRoundTripperTestSuite) TestRecordAndReplay() {
+	delegateCalled := false
+	recorder := &SnapshotRoundTripper{
+		delegate: &mockRoundTripper{
+			called: &delegateCalled,
+			onRequest: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"kind":"PodList","items":[]}`))
+			},
+		},
+		store:  s.recordStore(),
+		record: true,
+	}
+	resp, err := recorder.RoundTrip(httptest.NewRequest("GET", "/api/v1/namespaces/default/pods?limit=500", nil))
+	s.Require().NoError(err, "Expected no error recording the exchange")
+	s.Run("record returns the response with its body intact", func() {
+		body, readErr := io.ReadAll(resp.Body)
+		s.Require().NoError(readErr)
+		s.Equal(`{"kind":"PodList","items":[]}`, string(body))
+	})
+
+	replayDelegateCalled := false
+	replayer := &SnapshotRoundTripper{
+		delegate: &mockRoundTripper{called: &replayDelegateCalled},
+		store:    s.replayStore(),
+	}
+	replayed, err := replayer.RoundTrip(httptest.NewRequest("GET", "/api/v1/namespaces/default/pods?limit=500", nil))
+	s.Require().NoError(err, "Expected no error replaying the exchange")
+	s.Run("replay serves the recorded status", func() {
+		s.Equal(http.StatusOK, replayed.StatusCode)
+	})
+	s.Run("replay serves the recorded body", func() {
+		body, readErr := io.ReadAll(replayed.Body)
+		s.Require().NoError(readErr)
+		s.Equal(`{"kind":"PodList","items":[]}`, string(body))
+	})
+	s.Run("replay serves the recorded headers", func() {
+		s.Equal("application/json", replayed.Header.Get("Content-Type"))
+	})
+	s.Run("replay does not contact the cluster", func() {
+		s.False(replayDelegateCalled, "Expected delegate not to be called in replay mode")
+	})
+}
+
+func (s *SnapshotRoundTripperTestSuite) TestReplayWithoutRecordedResponse() {
+	delegateCalled := false
+	replayer := &SnapshotRoundTripper{
+		delegate: &mockRoundTripper{called: &delegateCalled},
+		store:    &snapshotStore{path: s.snapshotPath, entries: map[string]*snapshotEntry{}},
+	}
+	resp, err := replayer.RoundTrip(httptest.NewRequest("GET", "/api/v1/namespaces", nil))
+	s.Run("returns error for unrecorded request", func() {
+		s.Error(err, "expected error for request missing from the snapshot")
+		s.Nil(resp, "response should be nil when error occurs")
+	})
+	s.Run("does not contact the cluster", func() {
+		s.False(delegateCalled, "Expected delegate not to be called in replay mode")
+	})
+}
+
+func (s *SnapshotRoundTripperTestSuite) TestStreamingExchangesAreNotRecorded() {
+	delegateCalled := false
+	recorder := &SnapshotRoundTripper{
+		delegate: &mockRoundTripper{
+			called: &delegateCalled,
+			onRequest: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		store:  s.recordStore(),
+		record: true,
+	}
+	_, err := recorder.RoundTrip(httptest.NewRequest("GET", "/api/v1/pods?watch=true", nil))
+	s.Require().NoError(err, "Expected no error passing the watch through")
+	s.Run("watch request passes through to the cluster", func() {
+		s.True(delegateCalled, "Expected delegate to be called for the watch")
+	})
+	s.Run("watch request is not written to the snapshot", func() {
+		content, readErr := os.ReadFile(s.snapshotPath)
+		s.Require().NoError(readErr)
+		s.Empty(content, "snapshot file should be empty after a watch-only session")
+	})
+}
+
+func TestSnapshotRoundTripper(t *testing.T) {
+	suite.Run(t, new(SnapshotRoundTripperTestSuite))
+}
@@ -0,0 +1,124 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// positiveConditionTypes are condition types where status True is the healthy
+// state (the Kubernetes "normal-true" convention).
+var positiveConditionTypes = map[string]bool{
+	"Ready":       true,
+	"Available":   true,
+	"Succeeded":   true,
+	"Initialized": true,
+	"Established": true,
+	"Healthy":     true,
+	"Upgradeable": true,
+	"Reconciled":  true,
+	"Synced":      true,
+}
+
+// negativeConditionTypes are condition types where status True signals a
+// problem (the "abnormal-true" convention).
+var negativeConditionTypes = map[string]bool{
+	"Degraded":           true,
+	"Failed":             true,
+	"Unavailable":        true,
+	"Stalled":            true,
+	"ReconcileError":     true,
+	"MemoryPressure":     true,
+	"DiskPressure":       true,
+	"PIDPressure":        true,
+	"NetworkUnavailable": true,
+}
+
+// StatusSummary is the interpreted health of an object's status block.
+type StatusSummary struct {
+	// Healthy is false when any condition is in its abnormal state or the
+	// controller has not observed the latest spec generation.
+	Healthy bool
+	// Problems lists the reasons the object is considered unhealthy.
+	Problems []string
+	// Conditions renders one line per status condition with an OK/WARN marker.
+	Conditions []string
+}
+
+// SummarizeStatus interprets the common status patterns of any custom or
+// built-in resource: Ready/Available/Degraded-style conditions (both the
+// normal-true and abnormal-true conventions) and observedGeneration lag.
+// Condition types it does not recognize are reported without a verdict.
+func SummarizeStatus(obj *unstructured.Unstructured) StatusSummary {
+	summary := StatusSummary{Healthy: true}
+
+	generation := obj.GetGeneration()
+	if observed, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration"); found && generation > 0 && observed < generation {
+		summary.Healthy = false
+		summary.Problems = append(summary.Problems,
+			fmt.Sprintf("controller has not observed the latest spec (observedGeneration %d < generation %d)", observed, generation))
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, entry := range conditions {
+		condition, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _, _ := unstructured.NestedString(condition, "type")
+		status, _, _ := unstructured.NestedString(condition, "status")
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		message, _, _ := unstructured.NestedString(condition, "message")
+
+		detail := ""
+		if reason != "" {
+			detail = " (" + reason
+			if message != "" {
+				detail += ": " + message
+			}
+			detail += ")"
+		} else if message != "" {
+			detail = " (" + message + ")"
+		}
+
+		marker := ""
+		isTrue := status == "True"
+		switch {
+		case positiveConditionTypes[conditionType]:
+			if isTrue {
+				marker = "OK"
+			} else {
+				marker = "WARN"
+				summary.Healthy = false
+				summary.Problems = append(summary.Problems, fmt.Sprintf("%s is %s%s", conditionType, status, detail))
+			}
+		case negativeConditionTypes[conditionType]:
+			if isTrue {
+				marker = "WARN"
+				summary.Healthy = false
+				summary.Problems = append(summary.Problems, fmt.Sprintf("%s is True%s", conditionType, detail))
+			} else {
+				marker = "OK"
+			}
+		}
+		line := fmt.Sprintf("- %s: %s%s", conditionType, status, detail)
+		if marker != "" {
+			line = fmt.Sprintf("- [%s] %s: %s%s", marker, conditionType, status, detail)
+		}
+		summary.Conditions = append(summary.Conditions, line)
+	}
+	return summary
+}
+
+// String renders the summary as a short human-readable report.
+func (s StatusSummary) String() string {
+	verdict := "Healthy"
+	if !s.Healthy {
+		verdict = "NOT healthy:\n- " + strings.Join(s.Problems, "\n- ")
+	}
+	if len(s.Conditions) == 0 {
+		return verdict + "\n\nNo status conditions reported"
+	}
+	return verdict + "\n\nConditions:\n" + strings.Join(s.Conditions, "\n")
+}
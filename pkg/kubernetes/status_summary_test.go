@@ -0,0 +1,91 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type StatusSummarySuite struct {
+	suite.Suite
+}
+
+func (s *StatusSummarySuite) objectWithConditions(conditions ...map[string]interface{}) *unstructured.Unstructured {
+	entries := make([]interface{}, 0, len(conditions))
+	for _, condition := range conditions {
+		entries = append(entries, condition)
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{"conditions": entries},
+	}}
+}
+
+func (s *StatusSummarySuite) TestSummarizeStatus() {
+	s.Run("healthy conditions", func() {
+		s.Run("normal-true condition with status True is healthy", func() {
+			summary := SummarizeStatus(s.objectWithConditions(
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			))
+			s.True(summary.Healthy, "Ready=True should be healthy")
+		})
+		s.Run("abnormal-true condition with status False is healthy", func() {
+			summary := SummarizeStatus(s.objectWithConditions(
+				map[string]interface{}{"type": "Degraded", "status": "False"},
+			))
+			s.True(summary.Healthy, "Degraded=False should be healthy")
+		})
+		s.Run("unrecognized condition types carry no verdict", func() {
+			summary := SummarizeStatus(s.objectWithConditions(
+				map[string]interface{}{"type": "SomethingCustom", "status": "False"},
+			))
+			s.True(summary.Healthy, "unrecognized conditions should not affect health")
+		})
+	})
+	s.Run("unhealthy conditions", func() {
+		s.Run("normal-true condition with status False is a problem", func() {
+			summary := SummarizeStatus(s.objectWithConditions(
+				map[string]interface{}{"type": "Available", "status": "False", "reason": "MinimumReplicasUnavailable"},
+			))
+			s.False(summary.Healthy, "Available=False should be unhealthy")
+		})
+		s.Run("problem includes reason and message", func() {
+			summary := SummarizeStatus(s.objectWithConditions(
+				map[string]interface{}{"type": "Degraded", "status": "True", "reason": "Broken", "message": "it broke"},
+			))
+			s.Equal([]string{"Degraded is True (Broken: it broke)"}, summary.Problems)
+		})
+	})
+	s.Run("observedGeneration lag", func() {
+		s.Run("observed behind generation is a problem", func() {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"generation": int64(3)},
+				"status":   map[string]interface{}{"observedGeneration": int64(2)},
+			}}
+			summary := SummarizeStatus(obj)
+			s.False(summary.Healthy, "lagging observedGeneration should be unhealthy")
+		})
+		s.Run("observed matching generation is healthy", func() {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"generation": int64(3)},
+				"status":   map[string]interface{}{"observedGeneration": int64(3)},
+			}}
+			summary := SummarizeStatus(obj)
+			s.True(summary.Healthy, "up-to-date observedGeneration should be healthy")
+		})
+	})
+	s.Run("edge cases", func() {
+		s.Run("object without status is healthy", func() {
+			summary := SummarizeStatus(&unstructured.Unstructured{Object: map[string]interface{}{}})
+			s.True(summary.Healthy, "object without status should default to healthy")
+		})
+		s.Run("String reports missing conditions", func() {
+			summary := SummarizeStatus(&unstructured.Unstructured{Object: map[string]interface{}{}})
+			s.Contains(summary.String(), "No status conditions reported")
+		})
+	})
+}
+
+func TestStatusSummary(t *testing.T) {
+	suite.Run(t, new(StatusSummarySuite))
+}
@@ -0,0 +1,184 @@
+package mcp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+const (
+	// attachmentURIPrefix prefixes the URIs under which oversized tool
+	// outputs are exposed as readable MCP resources.
+	attachmentURIPrefix = "mcp-attachment://"
+	// attachmentTTL bounds how long a stored attachment stays readable.
+	attachmentTTL = 30 * time.Minute
+	// attachmentPurgeInterval is how often the janitor reaps expired
+	// attachments.
+	attachmentPurgeInterval = time.Minute
+	// attachmentPreviewBytes is how much of the original output the inline
+	// summary keeps so the client can decide whether to fetch the rest.
+	attachmentPreviewBytes = 2048
+)
+
+type attachment struct {
+	sessionID string
+	content   string
+	created   time.Time
+}
+
+// attachmentStore holds tool outputs that exceeded result_attachment_threshold
+// so they can be served as MCP resources instead of inline text. Attachments
+// are scoped to the session that produced them and expire after attachmentTTL;
+// a janitor goroutine reaps expired entries so the store cannot grow without
+// bound.
+type attachmentStore struct {
+	mu          sync.Mutex
+	attachments map[string]*attachment
+	done        chan struct{}
+	stopOnce    sync.Once
+}
+
+func newAttachmentStore() *attachmentStore {
+	s := &attachmentStore{
+		attachments: make(map[string]*attachment),
+		done:        make(chan struct{}),
+	}
+	go s.purgeLoop()
+	return s
+}
+
+func (s *attachmentStore) purgeLoop() {
+	ticker := time.NewTicker(attachmentPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.purgeExpired(time.Now())
+		}
+	}
+}
+
+// purgeExpired removes every attachment older than attachmentTTL relative to now.
+func (s *attachmentStore) purgeExpired(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, a := range s.attachments {
+		if now.Sub(a.created) > attachmentTTL {
+			delete(s.attachments, id)
+		}
+	}
+}
+
+// put stores content on behalf of sessionID and returns the attachment URI.
+func (s *attachmentStore) put(sessionID, content string) string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	id := hex.EncodeToString(buf)
+	s.mu.Lock()
+	s.attachments[id] = &attachment{sessionID: sessionID, content: content, created: time.Now()}
+	s.mu.Unlock()
+	return attachmentURIPrefix + id
+}
+
+// get returns the content stored under uri. Reads are refused for sessions
+// other than the one that produced the attachment.
+func (s *attachmentStore) get(sessionID, uri string) (string, bool) {
+	id := strings.TrimPrefix(uri, attachmentURIPrefix)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.attachments[id]
+	if !ok || a.sessionID != sessionID {
+		return "", false
+	}
+	return a.content, true
+}
+
+func (s *attachmentStore) Close() {
+	s.stopOnce.Do(func() { close(s.done) })
+}
+
+// maybeAttachResult replaces an oversized successful tool result with a
+// preview plus a resource link to the full output. The full output is stored
+// session-scoped in the attachment store; the client fetches it on demand
+// with resources/read. Returns the result unchanged when attachments are
+// disabled, the result is an error or already small enough, or the request
+// has no session to scope the attachment to. Tools declaring an output schema
+// are exempt: their successful results must carry conforming
+// structuredContent, which the preview-plus-link replacement would strip.
+func (s *Server) maybeAttachResult(request *mcp.CallToolRequest, cfg *Configuration, tool api.ServerTool, result *mcp.CallToolResult, content string) *mcp.CallToolResult {
+	toolName := tool.Tool.Name
+	threshold := cfg.ResultAttachmentThreshold
+	if threshold <= 0 || result.IsError || int64(len(content)) <= threshold {
+		return result
+	}
+	if tool.Tool.OutputSchema != nil {
+		return result
+	}
+	session, ok := request.GetSession().(*mcp.ServerSession)
+	if !ok || session == nil || session.ID() == "" {
+		return result
+	}
+	// Register the resource template on first use so clients discover the
+	// URI scheme; deployments that never exceed the threshold never
+	// advertise it.
+	s.attachmentTemplateOnce.Do(func() {
+		s.server.AddResourceTemplate(&mcp.ResourceTemplate{
+			URITemplate: attachmentURIPrefix + "{id}",
+			Name:        "tool-result-attachment",
+			Description: "Full output of a tool call that exceeded result_attachment_threshold",
+			MIMEType:    "text/plain",
+		}, s.readAttachment)
+	})
+	uri := s.attachments.put(session.ID(), content)
+	preview := content
+	if len(preview) > attachmentPreviewBytes {
+		preview = preview[:attachmentPreviewBytes] + "\n... (truncated)"
+	}
+	summary := fmt.Sprintf(
+		"The %s output is %d bytes and exceeds the configured result_attachment_threshold (%d bytes). "+
+			"The full output is available as resource %s (expires in %s) - fetch it with resources/read if the preview below is insufficient.\n\nPreview:\n%s",
+		toolName, len(content), threshold, uri, attachmentTTL, preview,
+	)
+	size := int64(len(content))
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary},
+			&mcp.ResourceLink{
+				URI:         uri,
+				Name:        toolName + "-output",
+				Description: "Full " + toolName + " output",
+				MIMEType:    "text/plain",
+				Size:        &size,
+			},
+		},
+	}
+}
+
+// readAttachment serves resources/read for mcp-attachment:// URIs.
+func (s *Server) readAttachment(_ context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	sessionID := ""
+	if session, ok := req.GetSession().(*mcp.ServerSession); ok && session != nil {
+		sessionID = session.ID()
+	}
+	content, ok := s.attachments.get(sessionID, req.Params.URI)
+	if !ok {
+		return nil, fmt.Errorf("attachment %s not found: it may have expired or belong to another session", req.Params.URI)
+	}
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{{
+			URI:      req.Params.URI,
+			MIMEType: "text/plain",
+			Text:     content,
+		}},
+	}, nil
+}
@@ -0,0 +1,69 @@
+package mcp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AttachmentStoreSuite struct {
+	suite.Suite
+	store *attachmentStore
+}
+
+func (s *AttachmentStoreSuite) SetupTest() {
+	s.store = newAttachmentStore()
+}
+
+func (s *AttachmentStoreSuite) TearDownTest() {
+	s.store.Close()
+}
+
+func (s *AttachmentStoreSuite) TestPutGet() {
+	s.Run("returns stored content for the owning session", func() {
+		uri := s.store.put("session-1", "large output")
+		s.True(strings.HasPrefix(uri, attachmentURIPrefix), "expected attachment URI prefix")
+		content, ok := s.store.get("session-1", uri)
+		s.True(ok)
+		s.Equal("large output", content)
+	})
+
+	s.Run("refuses cross-session reads", func() {
+		uri := s.store.put("session-1", "private output")
+		_, ok := s.store.get("session-2", uri)
+		s.False(ok, "attachment must not be readable from another session")
+	})
+
+	s.Run("returns false for unknown URIs", func() {
+		_, ok := s.store.get("session-1", attachmentURIPrefix+"nonexistent")
+		s.False(ok)
+	})
+
+	s.Run("generates distinct URIs per attachment", func() {
+		uri1 := s.store.put("session-1", "one")
+		uri2 := s.store.put("session-1", "two")
+		s.NotEqual(uri1, uri2)
+	})
+}
+
+func (s *AttachmentStoreSuite) TestExpiry() {
+	s.Run("purge reaps attachments older than the TTL", func() {
+		uri := s.store.put("session-1", "stale output")
+		s.store.purgeExpired(time.Now().Add(attachmentTTL + time.Minute))
+		_, ok := s.store.get("session-1", uri)
+		s.False(ok, "expected the expired attachment to be reaped")
+	})
+
+	s.Run("purge keeps attachments within the TTL", func() {
+		uri := s.store.put("session-1", "fresh output")
+		s.store.purgeExpired(time.Now())
+		_, ok := s.store.get("session-1", uri)
+		s.True(ok, "expected the fresh attachment to survive the purge")
+	})
+}
+
+func TestAttachmentStore(t *testing.T) {
+	suite.Run(t, new(AttachmentStoreSuite))
+}
@@ -109,6 +109,11 @@ func createTestData(ctx context.Context) {
 		Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "a-configmap-to-delete"}}, metav1.CreateOptions{})
 }
 
+// BaseMcpSuite is this package's private twin of mcptest.Suite. The mcp
+// package's own tests cannot import mcptest (mcptest imports mcp, and
+// internal test files may not close that cycle), so the scaffolding lives
+// here too; keep the two in sync. Toolset tests outside this package embed
+// mcptest.Suite instead.
 type BaseMcpSuite struct {
 	suite.Suite
 	*test.McpClient
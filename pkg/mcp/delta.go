@@ -0,0 +1,245 @@
+package mcp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+const (
+	// deltaTTL bounds how long a cached list result stays comparable; a call
+	// repeated after the TTL returns the full output again.
+	deltaTTL = 30 * time.Minute
+	// deltaPurgeInterval is how often the janitor reaps expired entries.
+	deltaPurgeInterval = time.Minute
+)
+
+// deltaEntry is the cached outcome of one list call: the raw content hash
+// for the fast unchanged check, and the parsed objects keyed by identity for
+// the per-object delta.
+type deltaEntry struct {
+	contentHash string
+	objects     map[string]string
+	created     time.Time
+}
+
+// deltaStore memoizes the last successful result of every list tool call per
+// session, tool, and argument set so repeated identical calls can return a
+// compact delta instead of the full listing. Entries expire after deltaTTL
+// and a janitor goroutine reaps them, mirroring the attachment store.
+type deltaStore struct {
+	mu       sync.Mutex
+	entries  map[string]*deltaEntry
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func newDeltaStore() *deltaStore {
+	s := &deltaStore{
+		entries: make(map[string]*deltaEntry),
+		done:    make(chan struct{}),
+	}
+	go s.purgeLoop()
+	return s
+}
+
+func (s *deltaStore) purgeLoop() {
+	ticker := time.NewTicker(deltaPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.purgeExpired(time.Now())
+		}
+	}
+}
+
+func (s *deltaStore) purgeExpired(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, entry := range s.entries {
+		if now.Sub(entry.created) > deltaTTL {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// swap stores entry under key and returns the previous entry, if any.
+func (s *deltaStore) swap(key string, entry *deltaEntry) (*deltaEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	previous, ok := s.entries[key]
+	s.entries[key] = entry
+	return previous, ok
+}
+
+func (s *deltaStore) Close() {
+	s.stopOnce.Do(func() { close(s.done) })
+}
+
+// deltaCacheKey identifies one memoized call: the session, the tool, and a
+// digest of the exact arguments, so a call with different arguments never
+// compares against another call's result.
+func deltaCacheKey(sessionID, toolName string, arguments map[string]any) string {
+	// json.Marshal sorts map keys, so equal argument sets digest equally.
+	args, _ := json.Marshal(arguments)
+	digest := sha256.Sum256(args)
+	return sessionID + "\x00" + toolName + "\x00" + hex.EncodeToString(digest[:])
+}
+
+// objectIdentity keys a listed object by what identifies it across calls.
+func objectIdentity(object map[string]any) string {
+	kind, _ := object["kind"].(string)
+	metadata, _ := object["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	if kind == "" || name == "" {
+		return ""
+	}
+	return kind + "/" + namespace + "/" + name
+}
+
+// parseListedObjects parses content as a YAML (or JSON) array of objects and
+// returns them keyed by identity with a canonical JSON rendering for change
+// detection. Returns false when the content is not such a listing (e.g. table
+// output), in which case only the whole-content unchanged check applies.
+func parseListedObjects(content string) (map[string]string, bool) {
+	var items []map[string]any
+	if err := yaml.Unmarshal([]byte(content), &items); err != nil || len(items) == 0 {
+		return nil, false
+	}
+	objects := make(map[string]string, len(items))
+	for _, item := range items {
+		identity := objectIdentity(item)
+		if identity == "" {
+			return nil, false
+		}
+		rendered, err := json.Marshal(item)
+		if err != nil {
+			return nil, false
+		}
+		objects[identity] = string(rendered)
+	}
+	return objects, true
+}
+
+// maybeDeltaResult replaces the successful result of a repeated identical
+// list call with a compact delta against the previous call of this session:
+// unchanged listings collapse to a one-line notice, and changed listings
+// report the added, changed, and removed objects only. The latest result is
+// always stored so the next call compares against it. Returns the result and
+// content unchanged when the delta cache is disabled, the tool is not a
+// read-only list tool, the call failed, or the request has no session to
+// scope the cache to.
+func (s *Server) maybeDeltaResult(request *mcp.CallToolRequest, cfg *Configuration, tool api.ServerTool, toolCallRequest *ToolCallRequest, result *mcp.CallToolResult, content string) (*mcp.CallToolResult, string) {
+	if !cfg.ResultDelta || result.IsError || content == "" {
+		return result, content
+	}
+	if !ptr.Deref(tool.Tool.Annotations.ReadOnlyHint, false) || !strings.HasSuffix(tool.Tool.Name, "_list") {
+		return result, content
+	}
+	// Tools that declare an output schema promise conforming structuredContent
+	// on every successful result; replacing theirs with a delta notice would
+	// violate that. Leave them untouched.
+	if tool.Tool.OutputSchema != nil {
+		return result, content
+	}
+	session, ok := request.GetSession().(*mcp.ServerSession)
+	if !ok || session == nil || session.ID() == "" {
+		return result, content
+	}
+	digest := sha256.Sum256([]byte(content))
+	entry := &deltaEntry{contentHash: hex.EncodeToString(digest[:]), created: time.Now()}
+	objects, parsed := parseListedObjects(content)
+	if parsed {
+		entry.objects = objects
+	}
+	key := deltaCacheKey(session.ID(), tool.Tool.Name, toolCallRequest.GetArguments())
+	previous, ok := s.deltas.swap(key, entry)
+	if !ok || time.Since(previous.created) > deltaTTL {
+		return result, content
+	}
+	since := time.Since(previous.created).Round(time.Second)
+	if previous.contentHash == entry.contentHash {
+		text := fmt.Sprintf("No changes since the previous identical %s call %s ago; the %d-byte output is unchanged", tool.Tool.Name, since, len(content))
+		return NewTextResult(text, nil), text
+	}
+	if !parsed || previous.objects == nil {
+		// Non-object output (e.g. table format) can only take the unchanged
+		// shortcut above; a changed listing is returned in full.
+		return result, content
+	}
+	summary, total := deltaSummary(previous.objects, entry.objects)
+	if total == 0 {
+		// Same objects, different rendering (e.g. reordering): not a delta
+		// worth reporting, return the full listing.
+		return result, content
+	}
+	text := fmt.Sprintf("%d of %d object(s) differ from the previous identical %s call %s ago; only the delta is shown:\n\n%s",
+		total, len(entry.objects), tool.Tool.Name, since, summary)
+	return NewTextResult(text, nil), text
+}
+
+// deltaSummary renders the per-object delta between the previous and current
+// listings and returns it with the total number of differing objects.
+func deltaSummary(previous, current map[string]string) (string, int) {
+	var added, changed, removed []string
+	for identity := range current {
+		previousRendering, ok := previous[identity]
+		if !ok {
+			added = append(added, identity)
+		} else if previousRendering != current[identity] {
+			changed = append(changed, identity)
+		}
+	}
+	for identity := range previous {
+		if _, ok := current[identity]; !ok {
+			removed = append(removed, identity)
+		}
+	}
+	total := len(added) + len(changed) + len(removed)
+	if total == 0 {
+		return "", 0
+	}
+	sort.Strings(added)
+	sort.Strings(changed)
+	sort.Strings(removed)
+
+	var sections []string
+	renderObjects := func(identities []string) string {
+		var rendered []string
+		for _, identity := range identities {
+			var object map[string]any
+			_ = json.Unmarshal([]byte(current[identity]), &object)
+			objectYAML, err := yaml.Marshal(object)
+			if err != nil {
+				continue
+			}
+			rendered = append(rendered, string(objectYAML))
+		}
+		return strings.Join(rendered, "---\n")
+	}
+	if len(added) > 0 {
+		sections = append(sections, fmt.Sprintf("Added (%d):\n%s", len(added), renderObjects(added)))
+	}
+	if len(changed) > 0 {
+		sections = append(sections, fmt.Sprintf("Changed (%d):\n%s", len(changed), renderObjects(changed)))
+	}
+	if len(removed) > 0 {
+		sections = append(sections, fmt.Sprintf("Removed (%d):\n- %s", len(removed), strings.Join(removed, "\n- ")))
+	}
+	return strings.Join(sections, "\n"), total
+}
@@ -0,0 +1,182 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	apiextensionsv1spec "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	openshiftToolset "github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift"
+)
+
+var etcdCRDs = []*apiextensionsv1spec.CustomResourceDefinition{
+	test.CRD("operator.openshift.io", "v1alpha1", "etcdbackups", "EtcdBackup", "etcdbackup", false),
+}
+
+// EtcdNotOpenShiftSuite runs before EtcdSuite installs the EtcdBackup CRD, so
+// the envtest cluster still looks like a cluster without the backup API.
+type EtcdNotOpenShiftSuite struct {
+	BaseMcpSuite
+}
+
+func (s *EtcdNotOpenShiftSuite) TestEtcdToolsNotExposed() {
+	s.Run("etcd tools are filtered out on clusters without the EtcdBackup API", func() {
+		s.Cfg.Toolsets = append(s.Cfg.Toolsets, (&openshiftToolset.Toolset{}).GetName())
+		s.InitMcpClient()
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		for _, tool := range tools.Tools {
+			for _, name := range []string{"etcd_backup_trigger", "etcd_backups_list", "etcd_backup_verify"} {
+				s.NotEqualf(name, tool.Name, "tool %s should not be exposed on a cluster without the EtcdBackup API", name)
+			}
+		}
+	})
+}
+
+type EtcdSuite struct {
+	BaseMcpSuite
+}
+
+func (s *EtcdSuite) SetupSuite() {
+	_, err := envtest.InstallCRDs(test.EnvTestRestConfig(), envtest.CRDInstallOptions{CRDs: etcdCRDs})
+	s.Require().NoError(err)
+}
+
+func (s *EtcdSuite) TearDownSuite() {
+	s.Require().NoError(envtest.UninstallCRDs(test.EnvTestRestConfig(), envtest.CRDInstallOptions{CRDs: etcdCRDs}))
+}
+
+func (s *EtcdSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.Cfg.Toolsets = append(s.Cfg.Toolsets, (&openshiftToolset.Toolset{}).GetName())
+	s.InitMcpClient()
+}
+
+func (s *EtcdSuite) TearDownTest() {
+	s.Require().NoError(s.etcdBackups().DeleteCollection(s.T().Context(), metav1.DeleteOptions{}, metav1.ListOptions{}))
+	s.BaseMcpSuite.TearDownTest()
+}
+
+func (s *EtcdSuite) etcdBackups() dynamic.ResourceInterface {
+	return dynamic.NewForConfigOrDie(test.EnvTestRestConfig()).
+		Resource(schema.GroupVersionResource{Group: "operator.openshift.io", Version: "v1alpha1", Resource: "etcdbackups"})
+}
+
+// createEtcdBackup creates an EtcdBackup with the given status condition. The
+// CRD fake has no status subresource, so the status is stored on create.
+func (s *EtcdSuite) createEtcdBackup(name, conditionType, status, message string) {
+	backup := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "operator.openshift.io/v1alpha1",
+		"kind":       "EtcdBackup",
+		"metadata":   map[string]any{"name": name},
+		"spec":       map[string]any{"pvcName": "etcd-backup-pvc"},
+	}}
+	if conditionType != "" {
+		backup.Object["status"] = map[string]any{"conditions": []any{
+			map[string]any{"type": conditionType, "status": status, "message": message},
+		}}
+	}
+	_, err := s.etcdBackups().Create(s.T().Context(), backup, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create EtcdBackup")
+}
+
+func (s *EtcdSuite) TestBackupTrigger() {
+	s.Run("etcd_backup_trigger with missing pvcName argument returns error", func() {
+		toolResult, _ := s.CallTool("etcd_backup_trigger", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to trigger the etcd backup: pvcName parameter required", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("etcd_backup_trigger creates an EtcdBackup", func() {
+		toolResult, err := s.CallTool("etcd_backup_trigger", map[string]interface{}{"pvcName": "etcd-backup-pvc"})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Regexpf(`^Created EtcdBackup backup-[a-z0-9]+; `, toolResult.Content[0].(*mcp.TextContent).Text,
+				"unexpected result %v", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("stores the PVC name in the spec", func() {
+			backups, listErr := s.etcdBackups().List(s.T().Context(), metav1.ListOptions{})
+			s.Require().NoError(listErr, "failed to list EtcdBackups")
+			s.Require().Len(backups.Items, 1)
+			pvcName, _, _ := unstructured.NestedString(backups.Items[0].Object, "spec", "pvcName")
+			s.Equal("etcd-backup-pvc", pvcName)
+		})
+	})
+}
+
+func (s *EtcdSuite) TestBackupsList() {
+	s.Run("etcd_backups_list with no backups", func() {
+		toolResult, err := s.CallTool("etcd_backups_list", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("No EtcdBackup resources found; no etcd backups were taken through the cluster-backup mechanism",
+			toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("etcd_backups_list reports the backup states", func() {
+		s.createEtcdBackup("backup-completed", "BackupCompleted", "True", "snapshot saved")
+		s.createEtcdBackup("backup-failed", "BackupFailed", "True", "no space left")
+		s.createEtcdBackup("backup-running", "", "", "")
+		toolResult, err := s.CallTool("etcd_backups_list", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Containsf(text, "EtcdBackups (3, newest first):", "unexpected result %v", text)
+		s.Containsf(text, "- backup-completed: completed, 0s old (snapshot saved)", "unexpected result %v", text)
+		s.Containsf(text, "- backup-failed: failed, 0s old (no space left)", "unexpected result %v", text)
+		s.Containsf(text, "- backup-running: in progress, 0s old", "unexpected result %v", text)
+	})
+}
+
+func (s *EtcdSuite) TestBackupVerify() {
+	s.Run("etcd_backup_verify with no backups reports a problem", func() {
+		toolResult, err := s.CallTool("etcd_backup_verify", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("etcd backup verification:\n- [PROBLEM] no completed etcd backup exists; trigger one with etcd_backup_trigger",
+			toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("etcd_backup_verify reports failed backups", func() {
+		s.createEtcdBackup("backup-failed", "BackupFailed", "True", "no space left")
+		toolResult, err := s.CallTool("etcd_backup_verify", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("etcd backup verification:\n"+
+			"- 1 backup(s) failed; check them with etcd_backups_list\n"+
+			"- [PROBLEM] no completed etcd backup exists; trigger one with etcd_backup_trigger",
+			toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("etcd_backup_verify with a recent completed backup", func() {
+		s.createEtcdBackup("backup-completed", "BackupCompleted", "True", "snapshot saved")
+		toolResult, err := s.CallTool("etcd_backup_verify", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Containsf(text, "- the most recent completed backup backup-completed is 0s old, within the maximum age of 24h",
+			"unexpected result %v", text)
+	})
+	s.Run("etcd_backup_verify honors maxAgeHours", func() {
+		// The backup was just created, so a zero maximum age makes it stale.
+		toolResult, err := s.CallTool("etcd_backup_verify", map[string]interface{}{"maxAgeHours": 0})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Containsf(text, "- [PROBLEM] the most recent completed backup backup-completed is 0s old, which exceeds the maximum age of 0h; trigger a fresh backup",
+			"unexpected result %v", text)
+	})
+}
+
+func TestEtcdNotOpenShift(t *testing.T) {
+	suite.Run(t, new(EtcdNotOpenShiftSuite))
+}
+
+func TestEtcd(t *testing.T) {
+	suite.Run(t, new(EtcdSuite))
+}
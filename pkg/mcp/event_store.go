@@ -0,0 +1,103 @@
+package mcp
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ttlEventStore wraps the SDK's in-memory event store with idle expiry: a
+// session whose last activity is older than the TTL has its persisted events
+// purged, bounding the memory held for clients that never reconnect. While a
+// session is live, reconnecting clients replay missed events via the
+// Last-Event-ID header.
+type ttlEventStore struct {
+	inner *mcp.MemoryEventStore
+	ttl   time.Duration
+
+	mu         sync.Mutex
+	lastActive map[string]time.Time
+	stop       chan struct{}
+	stopOnce   sync.Once
+}
+
+var _ mcp.EventStore = (*ttlEventStore)(nil)
+
+// sessionPurgeInterval is how often expired sessions are swept.
+const sessionPurgeInterval = time.Minute
+
+func newTTLEventStore(ttl time.Duration) *ttlEventStore {
+	store := &ttlEventStore{
+		inner:      mcp.NewMemoryEventStore(nil),
+		ttl:        ttl,
+		lastActive: make(map[string]time.Time),
+		stop:       make(chan struct{}),
+	}
+	go store.purgeLoop()
+	return store
+}
+
+func (s *ttlEventStore) Open(ctx context.Context, sessionID, streamID string) error {
+	s.touch(sessionID)
+	return s.inner.Open(ctx, sessionID, streamID)
+}
+
+func (s *ttlEventStore) Append(ctx context.Context, sessionID, streamID string, data []byte) error {
+	s.touch(sessionID)
+	return s.inner.Append(ctx, sessionID, streamID, data)
+}
+
+func (s *ttlEventStore) After(ctx context.Context, sessionID, streamID string, index int) iter.Seq2[[]byte, error] {
+	s.touch(sessionID)
+	return s.inner.After(ctx, sessionID, streamID, index)
+}
+
+func (s *ttlEventStore) SessionClosed(ctx context.Context, sessionID string) error {
+	s.mu.Lock()
+	delete(s.lastActive, sessionID)
+	s.mu.Unlock()
+	return s.inner.SessionClosed(ctx, sessionID)
+}
+
+// Close stops the background purge loop.
+func (s *ttlEventStore) Close() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+func (s *ttlEventStore) touch(sessionID string) {
+	s.mu.Lock()
+	s.lastActive[sessionID] = time.Now()
+	s.mu.Unlock()
+}
+
+func (s *ttlEventStore) purgeLoop() {
+	ticker := time.NewTicker(sessionPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.purgeExpired()
+		}
+	}
+}
+
+func (s *ttlEventStore) purgeExpired() {
+	deadline := time.Now().Add(-s.ttl)
+	var expired []string
+	s.mu.Lock()
+	for sessionID, last := range s.lastActive {
+		if last.Before(deadline) {
+			expired = append(expired, sessionID)
+			delete(s.lastActive, sessionID)
+		}
+	}
+	s.mu.Unlock()
+	for _, sessionID := range expired {
+		_ = s.inner.SessionClosed(context.Background(), sessionID)
+	}
+}
@@ -0,0 +1,225 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type HpaSuite struct {
+	BaseMcpSuite
+}
+
+func (s *HpaSuite) TestHpaList() {
+	s.InitMcpClient()
+	s.Run("hpa_list (no autoscalers)", func() {
+		toolResult, err := s.CallTool("hpa_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns no autoscalers message", func() {
+			s.Equal("No HorizontalPodAutoscalers found in namespace 'default'", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("hpa_list (with autoscaler)", func() {
+		client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+		_, err := client.AutoscalingV2().HorizontalPodAutoscalers("default").Create(s.T().Context(), &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "an-hpa"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "a-deployment",
+				},
+				MinReplicas: ptr.To(int32(2)),
+				MaxReplicas: 5,
+			},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create horizontal pod autoscaler")
+		toolResult, err := s.CallTool("hpa_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("lists the autoscaler with target and bounds", func() {
+			s.Equal("HorizontalPodAutoscalers (1):\n- an-hpa: targets Deployment/a-deployment, min=2, max=5, current=0, desired=0",
+				toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+}
+
+func (s *HpaSuite) TestHpaGet() {
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "diagnosable"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "a-deployment",
+			},
+			MaxReplicas: 3,
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: v1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: ptr.To(int32(80)),
+					},
+				},
+			}},
+		},
+	}
+	created, err := client.AutoscalingV2().HorizontalPodAutoscalers("default").Create(s.T().Context(), hpa, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create horizontal pod autoscaler")
+	s.Run("hpa_get (missing name)", func() {
+		toolResult, err := s.CallTool("hpa_get", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing argument", func() {
+			s.Equal("failed to get horizontal pod autoscaler: name parameter required", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("hpa_get (nonexistent autoscaler)", func() {
+		toolResult, err := s.CallTool("hpa_get", map[string]interface{}{"name": "not-there"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing autoscaler", func() {
+			s.Containsf(toolResult.Content[0].(*mcp.TextContent).Text, "failed to get horizontal pod autoscaler 'default/not-there'",
+				"unexpected result %v", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("hpa_get (valid autoscaler)", func() {
+		toolResult, err := s.CallTool("hpa_get", map[string]interface{}{"name": "diagnosable"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Run("summarizes target and bounds", func() {
+			s.Containsf(text, "HorizontalPodAutoscaler default/diagnosable targets Deployment/a-deployment (min=1, max=3)", "unexpected result %v", text)
+		})
+		s.Run("reports current vs target metrics", func() {
+			s.Containsf(text, "Metrics (current vs target):\n- cpu: <unknown> (target 80% average utilization)", "unexpected result %v", text)
+		})
+	})
+	s.Run("hpa_get (metrics unavailable)", func() {
+		created.Status.Conditions = []autoscalingv2.HorizontalPodAutoscalerCondition{{
+			Type:    autoscalingv2.ScalingActive,
+			Status:  v1.ConditionFalse,
+			Reason:  "FailedGetResourceMetric",
+			Message: "unable to get metrics for resource cpu",
+		}}
+		_, err := client.AutoscalingV2().HorizontalPodAutoscalers("default").UpdateStatus(s.T().Context(), created, metav1.UpdateOptions{})
+		s.Require().NoError(err, "failed to update horizontal pod autoscaler status")
+		toolResult, err := s.CallTool("hpa_get", map[string]interface{}{"name": "diagnosable"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Run("warns about unavailable metrics", func() {
+			s.Containsf(text, "WARNING: metrics are unavailable (FailedGetResourceMetric: unable to get metrics for resource cpu)", "unexpected result %v", text)
+		})
+		s.Run("suggests checking the metrics-server", func() {
+			s.Containsf(text, "verify that the metrics-server (or the relevant custom metrics adapter) is installed and healthy", "unexpected result %v", text)
+		})
+	})
+}
+
+func (s *HpaSuite) TestHpaCreate() {
+	s.InitMcpClient()
+	s.Run("hpa_create (missing target)", func() {
+		toolResult, err := s.CallTool("hpa_create", map[string]interface{}{"name": "incomplete"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing argument", func() {
+			s.Equal("failed to create horizontal pod autoscaler: target_api_version parameter required", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("hpa_create (max_replicas lower than min_replicas)", func() {
+		toolResult, err := s.CallTool("hpa_create", map[string]interface{}{
+			"name":               "bad-bounds",
+			"target_api_version": "apps/v1",
+			"target_kind":        "Deployment",
+			"target_name":        "a-deployment",
+			"min_replicas":       3,
+			"max_replicas":       1,
+			"cpu_utilization":    80,
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes invalid bounds", func() {
+			s.Equal("failed to create horizontal pod autoscaler: max_replicas must be at least 1 and not lower than min_replicas",
+				toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("hpa_create (no utilization target)", func() {
+		toolResult, err := s.CallTool("hpa_create", map[string]interface{}{
+			"name":               "no-metrics",
+			"target_api_version": "apps/v1",
+			"target_kind":        "Deployment",
+			"target_name":        "a-deployment",
+			"max_replicas":       3,
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing utilization target", func() {
+			s.Equal("failed to create horizontal pod autoscaler: at least one of cpu_utilization or memory_utilization is required",
+				toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("hpa_create (valid)", func() {
+		toolResult, err := s.CallTool("hpa_create", map[string]interface{}{
+			"name":               "created",
+			"target_api_version": "apps/v1",
+			"target_kind":        "Deployment",
+			"target_name":        "a-deployment",
+			"min_replicas":       2,
+			"max_replicas":       4,
+			"cpu_utilization":    75,
+			"memory_utilization": 85,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("reports the created autoscaler", func() {
+			s.Equal("Created HorizontalPodAutoscaler 'default/created' targeting Deployment/a-deployment (min=2, max=4)",
+				toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("creates the autoscaler with both utilization metrics", func() {
+			client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+			hpa, getErr := client.AutoscalingV2().HorizontalPodAutoscalers("default").Get(s.T().Context(), "created", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get horizontal pod autoscaler")
+			s.Require().Len(hpa.Spec.Metrics, 2, "expected cpu and memory metrics")
+			s.Equal(int32(75), ptr.Deref(hpa.Spec.Metrics[0].Resource.Target.AverageUtilization, 0), "cpu utilization target")
+			s.Equal(int32(85), ptr.Deref(hpa.Spec.Metrics[1].Resource.Target.AverageUtilization, 0), "memory utilization target")
+		})
+	})
+}
+
+func TestHpa(t *testing.T) {
+	suite.Run(t, new(HpaSuite))
+}
@@ -0,0 +1,271 @@
+package mcp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type JobsSuite struct {
+	BaseMcpSuite
+}
+
+// jobTemplateSpec is a minimal valid Job spec shared by the seeded Jobs and
+// CronJob job templates.
+func jobTemplateSpec() batchv1.JobSpec {
+	return batchv1.JobSpec{
+		Template: v1.PodTemplateSpec{
+			Spec: v1.PodSpec{
+				RestartPolicy: v1.RestartPolicyNever,
+				Containers: []v1.Container{{
+					Name:  "worker",
+					Image: "busybox",
+				}},
+			},
+		},
+	}
+}
+
+func (s *JobsSuite) TestJobsList() {
+	s.InitMcpClient()
+	s.Run("jobs_list (no jobs)", func() {
+		toolResult, err := s.CallTool("jobs_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns no jobs message", func() {
+			s.Equal("No Jobs or CronJobs found in namespace 'default'", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("jobs_list (with jobs and cronjobs)", func() {
+		client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+		_, err := client.BatchV1().Jobs("default").Create(s.T().Context(), &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-job"},
+			Spec:       jobTemplateSpec(),
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create job")
+		_, err = client.BatchV1().CronJobs("default").Create(s.T().Context(), &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-cronjob"},
+			Spec: batchv1.CronJobSpec{
+				Schedule:    "*/5 * * * *",
+				Suspend:     ptr.To(true),
+				JobTemplate: batchv1.JobTemplateSpec{Spec: jobTemplateSpec()},
+			},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create cronjob")
+		toolResult, err := s.CallTool("jobs_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Run("lists the job with its status", func() {
+			s.Containsf(text, "Jobs (1):\n- a-job: pending", "unexpected result %v", text)
+		})
+		s.Run("lists the cronjob with schedule and suspension", func() {
+			s.Containsf(text, "- a-cronjob: schedule=*/5 * * * *, SUSPENDED", "unexpected result %v", text)
+		})
+		s.Run("reports the cronjob never completed", func() {
+			s.Containsf(text, "never completed successfully", "unexpected result %v", text)
+		})
+	})
+}
+
+func (s *JobsSuite) TestCronJobsTrigger() {
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	_, err := client.BatchV1().CronJobs("default").Create(s.T().Context(), &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "triggerable"},
+		Spec: batchv1.CronJobSpec{
+			Schedule:    "0 0 * * *",
+			JobTemplate: batchv1.JobTemplateSpec{Spec: jobTemplateSpec()},
+		},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create cronjob")
+	s.Run("cronjobs_trigger (missing name)", func() {
+		toolResult, err := s.CallTool("cronjobs_trigger", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing argument", func() {
+			s.Equal("failed to trigger cronjob: name parameter required", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("cronjobs_trigger (nonexistent cronjob)", func() {
+		toolResult, err := s.CallTool("cronjobs_trigger", map[string]interface{}{"name": "not-there"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing cronjob", func() {
+			s.Containsf(toolResult.Content[0].(*mcp.TextContent).Text, "failed to trigger cronjob 'default/not-there'",
+				"unexpected result %v", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("cronjobs_trigger (valid cronjob)", func() {
+		toolResult, err := s.CallTool("cronjobs_trigger", map[string]interface{}{"name": "triggerable"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Run("reports the created job", func() {
+			s.Regexpf("^Created job 'default/triggerable-manual-\\d+' from cronjob 'triggerable'$", text,
+				"unexpected result %v", text)
+		})
+		s.Run("creates a job annotated as a manual instantiation", func() {
+			jobs, listErr := client.BatchV1().Jobs("default").List(s.T().Context(), metav1.ListOptions{})
+			s.Require().NoError(listErr, "failed to list jobs")
+			var created *batchv1.Job
+			for i := range jobs.Items {
+				if strings.HasPrefix(jobs.Items[i].Name, "triggerable-manual-") {
+					created = &jobs.Items[i]
+				}
+			}
+			s.Require().NotNil(created, "expected a job created from the cronjob template")
+			s.Equal("manual", created.Annotations["cronjob.kubernetes.io/instantiate"],
+				"created job should carry the manual instantiation annotation")
+		})
+	})
+}
+
+func (s *JobsSuite) TestCronJobsSuspend() {
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	_, err := client.BatchV1().CronJobs("default").Create(s.T().Context(), &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "suspendable"},
+		Spec: batchv1.CronJobSpec{
+			Schedule:    "0 0 * * *",
+			JobTemplate: batchv1.JobTemplateSpec{Spec: jobTemplateSpec()},
+		},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create cronjob")
+	s.Run("cronjobs_suspend (missing name)", func() {
+		toolResult, err := s.CallTool("cronjobs_suspend", map[string]interface{}{"suspend": true})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing argument", func() {
+			s.Equal("failed to suspend cronjob: name parameter required", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("cronjobs_suspend (nonexistent cronjob)", func() {
+		toolResult, err := s.CallTool("cronjobs_suspend", map[string]interface{}{"name": "not-there", "suspend": true})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing cronjob", func() {
+			s.Containsf(toolResult.Content[0].(*mcp.TextContent).Text, "failed to suspend cronjob 'default/not-there'",
+				"unexpected result %v", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("cronjobs_suspend (suspend=true)", func() {
+		toolResult, err := s.CallTool("cronjobs_suspend", map[string]interface{}{"name": "suspendable", "suspend": true})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("reports the suspension", func() {
+			s.Equal("CronJob 'default/suspendable' suspended", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("sets spec.suspend", func() {
+			cronJob, getErr := client.BatchV1().CronJobs("default").Get(s.T().Context(), "suspendable", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get cronjob")
+			s.True(ptr.Deref(cronJob.Spec.Suspend, false), "cronjob should be suspended")
+		})
+	})
+	s.Run("cronjobs_suspend (already suspended)", func() {
+		toolResult, err := s.CallTool("cronjobs_suspend", map[string]interface{}{"name": "suspendable", "suspend": true})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("reports it as a no-op", func() {
+			s.Equal("CronJob 'default/suspendable' is already suspended", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("cronjobs_suspend (resume)", func() {
+		toolResult, err := s.CallTool("cronjobs_suspend", map[string]interface{}{"name": "suspendable", "suspend": false})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("reports the resumption", func() {
+			s.Equal("CronJob 'default/suspendable' resumed", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("clears spec.suspend", func() {
+			cronJob, getErr := client.BatchV1().CronJobs("default").Get(s.T().Context(), "suspendable", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get cronjob")
+			s.False(ptr.Deref(cronJob.Spec.Suspend, true), "cronjob should be resumed")
+		})
+	})
+}
+
+func (s *JobsSuite) TestJobsLog() {
+	s.InitMcpClient()
+	s.Run("jobs_log (missing name)", func() {
+		toolResult, err := s.CallTool("jobs_log", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing argument", func() {
+			s.Equal("failed to get job logs: name parameter required", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("jobs_log (job without pods)", func() {
+		toolResult, err := s.CallTool("jobs_log", map[string]interface{}{"name": "no-pods"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns no pods message", func() {
+			s.Equal("No pods found for job 'default/no-pods'", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("jobs_log (job with pod)", func() {
+		// envtest has no kubelet, so the pod produces no logs; the tool
+		// still has to find the pod by its job-name label and say so.
+		client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+		_, err := client.CoreV1().Pods("default").Create(s.T().Context(), &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "a-job-pod",
+				Labels: map[string]string{"job-name": "a-job"},
+			},
+			Spec: v1.PodSpec{
+				RestartPolicy: v1.RestartPolicyNever,
+				Containers: []v1.Container{{
+					Name:  "worker",
+					Image: "busybox",
+				}},
+			},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create job pod")
+		toolResult, err := s.CallTool("jobs_log", map[string]interface{}{"name": "a-job"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("resolves the job's pod", func() {
+			s.Equal("Pod 'default/a-job-pod' (latest pod of job 'a-job') has produced no logs",
+				toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+}
+
+func TestJobs(t *testing.T) {
+	suite.Run(t, new(JobsSuite))
+}
@@ -0,0 +1,168 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type KeysSuite struct {
+	BaseMcpSuite
+}
+
+func (s *KeysSuite) TestConfigMapsGetKey() {
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	_, err := client.CoreV1().ConfigMaps("default").Create(s.T().Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-configmap-with-keys"},
+		Data:       map[string]string{"config.yaml": "verbosity: 3", "plain": "text"},
+		BinaryData: map[string][]byte{"blob.bin": {0x00, 0x01, 0x02}},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create configmap")
+	s.Run("configmaps_get_key with missing key argument returns error", func() {
+		toolResult, _ := s.CallTool("configmaps_get_key", map[string]interface{}{"name": "a-configmap-with-keys"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to get configmap key: key parameter required", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("configmaps_get_key with nonexistent configmap returns error", func() {
+		toolResult, _ := s.CallTool("configmaps_get_key", map[string]interface{}{"name": "not-there", "key": "plain"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Containsf(toolResult.Content[0].(*mcp.TextContent).Text, "failed to get configmap default/not-there",
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("configmaps_get_key with missing key lists the available keys", func() {
+		toolResult, _ := s.CallTool("configmaps_get_key", map[string]interface{}{"name": "a-configmap-with-keys", "key": "missing"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("configmap default/a-configmap-with-keys has no key missing (available keys: blob.bin, config.yaml, plain)",
+			toolResult.Content[0].(*mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("configmaps_get_key with binary key returns error", func() {
+		toolResult, _ := s.CallTool("configmaps_get_key", map[string]interface{}{"name": "a-configmap-with-keys", "key": "blob.bin"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("key blob.bin of configmap default/a-configmap-with-keys holds binary data (3 bytes) and cannot be returned as text",
+			toolResult.Content[0].(*mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("configmaps_get_key returns the raw value", func() {
+		toolResult, err := s.CallTool("configmaps_get_key", map[string]interface{}{"name": "a-configmap-with-keys", "key": "config.yaml"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("verbosity: 3", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+}
+
+func (s *KeysSuite) TestConfigMapsSetKey() {
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	_, err := client.CoreV1().ConfigMaps("default").Create(s.T().Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-configmap-to-edit"},
+		Data:       map[string]string{"existing": "old-value"},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create configmap")
+	s.Run("configmaps_set_key with missing value argument returns error", func() {
+		toolResult, _ := s.CallTool("configmaps_set_key", map[string]interface{}{"name": "a-configmap-to-edit", "key": "existing"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to set configmap key: value parameter required", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("configmaps_set_key adds a new key", func() {
+		toolResult, err := s.CallTool("configmaps_set_key", map[string]interface{}{
+			"name": "a-configmap-to-edit", "key": "added", "value": "new-value",
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Key added added to configmap default/a-configmap-to-edit", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("stores the value", func() {
+			configMap, getErr := client.CoreV1().ConfigMaps("default").Get(s.T().Context(), "a-configmap-to-edit", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get configmap")
+			s.Equal("new-value", configMap.Data["added"])
+		})
+	})
+	s.Run("configmaps_set_key refuses to replace an existing value without overwrite", func() {
+		toolResult, _ := s.CallTool("configmaps_set_key", map[string]interface{}{
+			"name": "a-configmap-to-edit", "key": "existing", "value": "replacement",
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Equalf("configmap default/a-configmap-to-edit already has a value for key existing: pass overwrite to replace it",
+				toolResult.Content[0].(*mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("leaves the value untouched", func() {
+			configMap, getErr := client.CoreV1().ConfigMaps("default").Get(s.T().Context(), "a-configmap-to-edit", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get configmap")
+			s.Equal("old-value", configMap.Data["existing"])
+		})
+	})
+	s.Run("configmaps_set_key with the current value is a no-op", func() {
+		toolResult, err := s.CallTool("configmaps_set_key", map[string]interface{}{
+			"name": "a-configmap-to-edit", "key": "existing", "value": "old-value",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("Key existing of configmap default/a-configmap-to-edit already has the provided value", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("configmaps_set_key with overwrite replaces the value", func() {
+		toolResult, err := s.CallTool("configmaps_set_key", map[string]interface{}{
+			"name": "a-configmap-to-edit", "key": "existing", "value": "replacement", "overwrite": true,
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Key existing updated in configmap default/a-configmap-to-edit", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("stores the new value", func() {
+			configMap, getErr := client.CoreV1().ConfigMaps("default").Get(s.T().Context(), "a-configmap-to-edit", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get configmap")
+			s.Equal("replacement", configMap.Data["existing"])
+		})
+	})
+}
+
+func (s *KeysSuite) TestSecretsGetKey() {
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	// The typed client base64-encodes Data transparently; the tool must decode
+	// it back to the plaintext on reveal.
+	_, err := client.CoreV1().Secrets("default").Create(s.T().Context(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-secret-with-keys"},
+		Data:       map[string][]byte{"password": []byte("s3cr3t"), "token": []byte("abcdef")},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create secret")
+	s.Run("secrets_get_key with nonexistent secret returns error", func() {
+		toolResult, _ := s.CallTool("secrets_get_key", map[string]interface{}{"name": "not-there", "key": "password"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Containsf(toolResult.Content[0].(*mcp.TextContent).Text, "failed to get secret default/not-there",
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("secrets_get_key with missing key lists the available keys", func() {
+		toolResult, _ := s.CallTool("secrets_get_key", map[string]interface{}{"name": "a-secret-with-keys", "key": "missing"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("secret default/a-secret-with-keys has no key missing (available keys: password, token)",
+			toolResult.Content[0].(*mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("secrets_get_key redacts the value by default", func() {
+		toolResult, err := s.CallTool("secrets_get_key", map[string]interface{}{"name": "a-secret-with-keys", "key": "password"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("Key password of secret default/a-secret-with-keys holds 6 bytes (redacted); pass reveal to return the plaintext",
+			toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("secrets_get_key with reveal returns the decoded plaintext", func() {
+		toolResult, err := s.CallTool("secrets_get_key", map[string]interface{}{"name": "a-secret-with-keys", "key": "password", "reveal": true})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("s3cr3t", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+}
+
+func TestKeys(t *testing.T) {
+	suite.Run(t, new(KeysSuite))
+}
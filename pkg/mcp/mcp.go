@@ -14,15 +14,18 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"golang.org/x/time/rate"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/ha"
 	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
 	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 	"github.com/containers/kubernetes-mcp-server/pkg/metrics"
 	"github.com/containers/kubernetes-mcp-server/pkg/output"
 	"github.com/containers/kubernetes-mcp-server/pkg/prompts"
+	"github.com/containers/kubernetes-mcp-server/pkg/statestore"
 	"github.com/containers/kubernetes-mcp-server/pkg/tokenexchange"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
 	"github.com/containers/kubernetes-mcp-server/pkg/version"
@@ -36,6 +39,10 @@ type Configuration struct {
 	SDKLogger  *slog.Logger
 	listOutput output.Output
 	toolsets   []api.Toolset
+	// toolToolsets maps tool name to the name of the toolset that contributed
+	// it. Populated by collectApplicableTools before the Configuration is
+	// published to lock-free readers.
+	toolToolsets map[string]string
 }
 
 func (c *Configuration) Toolsets() []api.Toolset {
@@ -65,6 +72,13 @@ func (c *Configuration) warmCaches() {
 	c.Toolsets()
 }
 
+// ToolsetForTool returns the name of the toolset that contributed the named
+// tool, or "" when the tool is unknown (e.g. injected by a mutator rather
+// than a toolset).
+func (c *Configuration) ToolsetForTool(name string) string {
+	return c.toolToolsets[name]
+}
+
 func (c *Configuration) isToolApplicable(tool api.ServerTool) bool {
 	if c.ReadOnly && !ptr.Deref(tool.Tool.Annotations.ReadOnlyHint, false) {
 		return false
@@ -116,6 +130,43 @@ type Server struct {
 	metrics                  *metrics.Metrics // Metrics collection system
 	rateLimitDone            chan struct{}    // Closed to stop the rate limiter reaper goroutine
 	closeOnce                sync.Once
+	// eventStore is the TTL-bounded session event store created by ServeHTTP
+	// when session persistence is enabled; closed with the server.
+	eventStoreMu sync.Mutex
+	eventStore   *ttlEventStore
+	// drainMu pairs the draining check with inFlight.Add so that Drain's
+	// Wait cannot miss a tool call admitted after the flag was set.
+	drainMu  sync.Mutex
+	draining bool
+	inFlight sync.WaitGroup
+	// attachments stores oversized tool outputs served as MCP resources;
+	// the resource template is registered lazily on first use.
+	attachments            *attachmentStore
+	attachmentTemplateOnce sync.Once
+	// deltas memoizes list results per session so repeated identical list
+	// calls return a compact delta when result_delta is enabled.
+	deltas *deltaStore
+	// notifier posts selected server events to the configured webhook
+	// endpoints; nil when no notifiers are configured. The notifier set is
+	// fixed for the process lifetime; changing it requires a restart.
+	notifier *notifier
+	// scheduler runs the configured periodic tool schedules and serves their
+	// latest results as scheduled-report:// resources; nil when no schedules
+	// are configured. The schedule set is fixed for the process lifetime;
+	// changing it requires a restart.
+	scheduler *scheduler
+	// operationState is the store for long-running operation state, handed
+	// to tool handlers via ToolHandlerParams. The backend is fixed for the
+	// process lifetime; changing it requires a restart.
+	operationState statestore.Store
+	// haLeaderCtx holds the leadership context while this replica is the HA
+	// leader, nil otherwise. Watch (re)starts use it so watches started
+	// during a reload still stop when leadership is lost.
+	haLeaderCtx atomic.Pointer[context.Context]
+	// proxiedClients holds the connections to the remote MCP servers whose
+	// tools are re-exposed under a name prefix. The set is fixed for the
+	// process lifetime; changing it requires a restart.
+	proxiedClients []*proxiedMCPClient
 }
 
 func NewServer(ctx context.Context, configuration Configuration, targetProvider internalk8s.Provider) (*Server, error) {
@@ -141,7 +192,9 @@ func NewServer(ctx context.Context, configuration Configuration, targetProvider
 				Instructions: configuration.ServerInstructions,
 				Logger:       sdkLogger,
 			}),
-		p: targetProvider,
+		p:           targetProvider,
+		attachments: newAttachmentStore(),
+		deltas:      newDeltaStore(),
 	}
 	s.configuration.Store(&configuration)
 
@@ -157,8 +210,26 @@ func NewServer(ctx context.Context, configuration Configuration, targetProvider
 	}
 	s.metrics = metricsInstance
 
+	// The CoreV1 client is resolved per operation so the configmap backend
+	// keeps working across credential rotation and even when no cluster
+	// credentials are available at startup.
+	s.operationState, err = statestore.New(statestore.FactoryParams{
+		Config: configuration.StateStore,
+		CoreV1Client: func(ctx context.Context) (corev1client.CoreV1Interface, error) {
+			k, clientErr := targetProvider.GetDerivedKubernetes(ctx, targetProvider.GetDefaultTarget())
+			if clientErr != nil {
+				return nil, clientErr
+			}
+			return k.CoreV1(), nil
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize state store: %w", err)
+	}
+
 	s.server.AddReceivingMiddleware(sessionInjectionMiddleware)
 	s.server.AddReceivingMiddleware(traceContextPropagationMiddleware)
+	s.server.AddReceivingMiddleware(s.drainingMiddleware())
 	s.rateLimitDone = make(chan struct{})
 	s.server.AddReceivingMiddleware(
 		rateLimitingMiddleware(s.rateLimitDone, func() (rate.Limit, int) {
@@ -181,15 +252,71 @@ func NewServer(ctx context.Context, configuration Configuration, targetProvider
 	// path entirely; protocolSendingMiddleware makes them visible at V(6).
 	s.server.AddSendingMiddleware(protocolSendingMiddleware)
 
+	for _, proxied := range configuration.ProxiedMCPServers {
+		s.proxiedClients = append(s.proxiedClients, newProxiedMCPClient(ctx, proxied))
+	}
+
 	err = s.applyToolsets(ctx, s.configuration.Load())
 	if err != nil {
 		return nil, err
 	}
-	s.p.WatchTargets(ctx, s.reapplyToolsets)
+	if len(configuration.Notifiers) > 0 {
+		s.notifier = newNotifier(configuration.Notifiers, configuration.IsRequireTLS)
+	}
+	if len(configuration.Schedules) > 0 {
+		s.startScheduler(ctx, configuration.Schedules)
+	}
+	if configuration.HA.Enabled {
+		// In a multi-replica deployment only the leader runs the
+		// cluster-state watches; followers take over when it goes away.
+		go s.runLeaderElectedWatches(ctx, configuration.HA)
+	} else {
+		s.startTargetWatches(ctx)
+	}
 
 	return s, nil
 }
 
+// startTargetWatches (re)starts the provider's cluster-state watches. In HA
+// mode watches only run on the leader: non-leaders no-op, and on the leader
+// the leadership context supersedes ctx so a watch restarted during a reload
+// still stops when leadership is lost.
+func (s *Server) startTargetWatches(ctx context.Context) {
+	if s.configuration.Load().HA.Enabled {
+		leaderCtx := s.haLeaderCtx.Load()
+		if leaderCtx == nil {
+			return
+		}
+		ctx = *leaderCtx
+	}
+	s.p.WatchTargets(ctx, s.reapplyToolsets)
+}
+
+// runLeaderElectedWatches participates in leader election and runs the
+// cluster-state watches only while this replica holds the lease. If the
+// leader election client cannot be built, the watches run unguarded so a
+// degraded replica still keeps its own tool surface up to date.
+func (s *Server) runLeaderElectedWatches(ctx context.Context, haConfig config.HAConfig) {
+	logger := klogutil.FromContext(ctx)
+	k, err := s.p.GetDerivedKubernetes(ctx, s.p.GetDefaultTarget())
+	if err != nil {
+		logger.Error(err, "Failed to build leader election client, running cluster-state watches unguarded")
+		s.p.WatchTargets(ctx, s.reapplyToolsets)
+		return
+	}
+	err = ha.RunLeaderElected(ctx, k, haConfig,
+		func(leaderCtx context.Context) {
+			s.haLeaderCtx.Store(&leaderCtx)
+			s.p.WatchTargets(leaderCtx, s.reapplyToolsets)
+		},
+		func() { s.haLeaderCtx.Store(nil) },
+	)
+	if err != nil {
+		logger.Error(err, "Leader election failed, running cluster-state watches unguarded")
+		s.p.WatchTargets(ctx, s.reapplyToolsets)
+	}
+}
+
 // reapplyToolsets is the provider's WatchTargets callback (signature
 // func() error). It re-applies the currently-installed configuration when
 // cluster state changes. The nil arg tells applyToolsets to re-read
@@ -254,6 +381,10 @@ func (s *Server) applyToolsets(ctx context.Context, cfg *Configuration) error {
 	if err != nil {
 		return err
 	}
+	// Remote tools are already in SDK form; appending them here enrolls
+	// them in the same commit and enabled-tools bookkeeping as local ones.
+	// They are filtered against cfg like local tools were above.
+	convertedTools = append(convertedTools, s.collectProxiedTools(ctx, cfg)...)
 	convertedPrompts, err := convertItems(applicablePrompts,
 		func(p api.ServerPrompt) string { return p.Prompt.Name },
 		func(p api.ServerPrompt) (*mcp.Prompt, mcp.PromptHandler, error) {
@@ -323,7 +454,7 @@ func (s *Server) applyToolsets(ctx context.Context, cfg *Configuration) error {
 	s.mu.Unlock()
 
 	// Start new watch
-	s.p.WatchTargets(ctx, s.reapplyToolsets)
+	s.startTargetWatches(ctx)
 	return nil
 }
 
@@ -392,18 +523,25 @@ func (s *Server) collectApplicableTools(cfg *Configuration) []api.ServerTool {
 	mutator := ComposeMutators(
 		WithTargetParameter(s.p.GetDefaultTarget(), s.p.GetTargetParameterName(), s.p.IsMultiTarget()),
 		WithTargetListTool(s.p.GetDefaultTarget(), s.p.GetTargetParameterName(), s.p),
+		WithClusterFlavorCLI(func() bool {
+			return s.p.AnyTargetHasGVKs(context.TODO(), openShiftMarkerGVKs)
+		}),
 		WithToolOverrides(cfg.ToolOverrides),
+		WithTimeoutParameter(),
 	)
 
 	tools := make([]api.ServerTool, 0)
+	toolToolsets := make(map[string]string)
 	for _, toolset := range cfg.Toolsets() {
 		for _, tool := range toolset.GetTools(s.p) {
 			tool = mutator(tool)
 			if filter(tool) {
 				tools = append(tools, tool)
+				toolToolsets[tool.Tool.Name] = toolset.GetName()
 			}
 		}
 	}
+	cfg.toolToolsets = toolToolsets
 	return tools
 }
 
@@ -496,9 +634,26 @@ func (s *Server) ServeSse() *mcp.SSEHandler {
 }
 
 func (s *Server) ServeHTTP() *mcp.StreamableHTTPHandler {
+	cfg := s.configuration.Load()
+	// Session persistence: persist stream events server-side so clients that
+	// reconnect with a Last-Event-ID header get missed events replayed.
+	// Sessions idle beyond the configured TTL are purged.
+	var eventStore mcp.EventStore
+	if cfg.HTTP.SessionPersistence && !cfg.Stateless {
+		ttl := cfg.HTTP.SessionTTL
+		if ttl == 0 {
+			ttl = config.DefaultSessionTTL
+		}
+		store := newTTLEventStore(ttl.Duration())
+		s.eventStoreMu.Lock()
+		s.eventStore = store
+		s.eventStoreMu.Unlock()
+		eventStore = store
+	}
 	return mcp.NewStreamableHTTPHandler(func(request *http.Request) *mcp.Server {
 		return s.server
 	}, &mcp.StreamableHTTPOptions{
+		EventStore: eventStore,
 		// Stateless mode configuration from server settings.
 		// When Stateless is true, the server will not send notifications to clients
 		// (e.g., tools/list_changed, prompts/list_changed). This disables dynamic
@@ -579,9 +734,62 @@ func (s *Server) ReloadConfiguration(ctx context.Context, newConfig *config.Stat
 	return nil
 }
 
+// beginToolCall registers a tool call with the in-flight tracker. It returns
+// false once the server is draining, in which case the call must be rejected
+// instead of executed.
+func (s *Server) beginToolCall() bool {
+	s.drainMu.Lock()
+	defer s.drainMu.Unlock()
+	if s.draining {
+		return false
+	}
+	s.inFlight.Add(1)
+	return true
+}
+
+// Drain stops accepting new tool calls and waits for in-flight handlers to
+// complete, bounded by ctx. Callers should cancel the request base context
+// (which terminates long-running watches and log streams) only after Drain
+// returns, so that short handlers get a chance to finish cleanly instead of
+// being killed mid-flight. Drain is idempotent and safe to call before
+// Shutdown.
+func (s *Server) Drain(ctx context.Context) error {
+	s.drainMu.Lock()
+	s.draining = true
+	s.drainMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("timed out waiting for in-flight tool calls: %w", ctx.Err())
+	}
+}
+
 func (s *Server) Close() {
 	s.closeOnce.Do(func() {
 		close(s.rateLimitDone)
+		s.attachments.Close()
+		s.deltas.Close()
+		if s.scheduler != nil {
+			s.scheduler.Close()
+		}
+		if s.operationState != nil {
+			_ = s.operationState.Close()
+		}
+		for _, proxied := range s.proxiedClients {
+			proxied.close()
+		}
+		s.eventStoreMu.Lock()
+		if s.eventStore != nil {
+			s.eventStore.Close()
+		}
+		s.eventStoreMu.Unlock()
 		if s.p != nil {
 			s.p.Close()
 		}
@@ -603,14 +811,7 @@ func (s *Server) Shutdown(ctx context.Context) error {
 // Use this for tools that return human-readable text output.
 func NewTextResult(content string, err error) *mcp.CallToolResult {
 	if err != nil {
-		return &mcp.CallToolResult{
-			IsError: true,
-			Content: []mcp.Content{
-				&mcp.TextContent{
-					Text: err.Error(),
-				},
-			},
-		}
+		return newErrorResult(err)
 	}
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
@@ -621,6 +822,23 @@ func NewTextResult(content string, err error) *mcp.CallToolResult {
 	}
 }
 
+// newErrorResult builds the IsError CallToolResult shared by NewTextResult
+// and NewStructuredResult. The text content carries the full error message
+// for humans; the api.ToolCallError envelope is attached as structuredContent
+// so agent clients can branch on the error code and retryable flag instead of
+// pattern-matching the message.
+func newErrorResult(err error) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		IsError: true,
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: err.Error(),
+			},
+		},
+		StructuredContent: api.NewToolCallError(err),
+	}
+}
+
 // NewStructuredResult creates an MCP CallToolResult with structured content.
 // The Content field contains the JSON-serialized form of structuredContent
 // for backward compatibility with MCP clients that don't support structuredContent.
@@ -638,14 +856,7 @@ func NewTextResult(content string, err error) *mcp.CallToolResult {
 // parse programmatically.
 func NewStructuredResult(content string, structuredContent any, err error) *mcp.CallToolResult {
 	if err != nil {
-		return &mcp.CallToolResult{
-			IsError: true,
-			Content: []mcp.Content{
-				&mcp.TextContent{
-					Text: err.Error(),
-				},
-			},
-		}
+		return newErrorResult(err)
 	}
 	result := &mcp.CallToolResult{
 		Content: []mcp.Content{
@@ -0,0 +1,117 @@
+// Package mcptest provides envtest-backed scaffolding for integration tests
+// that exercise toolsets through a real MCP server. Toolset authors (OADP,
+// externalsecrets, kiali, ...) embed Suite instead of copying the pkg/mcp
+// test suite: Suite starts the server from a StaticConfig, connects an MCP
+// client to it, and exposes the client's ListTools/CallTool/GetPrompt
+// helpers plus log-notification capture.
+package mcptest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	apiextensionsv1spec "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	mcpserver "github.com/containers/kubernetes-mcp-server/pkg/mcp"
+)
+
+// Main initializes the shared envtest environment, runs the package's tests,
+// and tears the environment down again. Call it from the test package's
+// TestMain; optional setup functions run once after the environment is up
+// (e.g. to seed namespaces or RBAC the tests rely on).
+func Main(m *testing.M, setup ...func(ctx context.Context)) {
+	test.EnvTest()
+
+	ctx := context.Background()
+	for _, fn := range setup {
+		fn(ctx)
+	}
+
+	code := m.Run()
+
+	_ = test.StopEnvTest()
+	os.Exit(code)
+}
+
+// Suite is the base suite for MCP server integration tests. SetupTest resets
+// Cfg to the upstream defaults pointed at the shared envtest cluster; tests
+// tweak Cfg (enable a toolset, merge TOML, ...) and then call InitMcpClient
+// to start the server and connect the embedded client to it.
+type Suite struct {
+	suite.Suite
+	*test.McpClient
+	// McpServer is the server InitMcpClient started, for tests that exercise
+	// server APIs directly (metrics, configuration reload, ...).
+	McpServer *mcpserver.Server
+	// Provider is the Kubernetes provider backing McpServer.
+	Provider internalk8s.Provider
+	// Cfg is the configuration InitMcpClient builds the server from. It starts
+	// from config.BaseDefault() so downstream default overrides don't leak
+	// into the test environment.
+	Cfg *config.StaticConfig
+}
+
+func (s *Suite) SetupTest() {
+	s.Cfg = config.BaseDefault()
+	s.Cfg.ListOutput = "yaml"
+	s.Cfg.KubeConfig = test.EnvTestKubeconfigFile(s.T())
+}
+
+func (s *Suite) TearDownTest() {
+	if s.McpClient != nil {
+		s.Close()
+	}
+	if s.McpServer != nil {
+		s.McpServer.Close()
+	}
+	if s.Provider != nil {
+		s.Provider.Close()
+	}
+}
+
+func (s *Suite) InitMcpClient(options ...test.McpClientOption) {
+	var err error
+	s.Provider, err = internalk8s.NewProvider(s.T().Context(), s.Cfg)
+	s.Require().NoError(err, "Expected no error creating k8s provider")
+	s.McpServer, err = mcpserver.NewServer(s.T().Context(), mcpserver.Configuration{StaticConfig: s.Cfg}, s.Provider)
+	s.Require().NoError(err, "Expected no error creating MCP server")
+	s.McpClient = test.NewMcpClient(s.T(), s.McpServer.ServeHTTP(), options...)
+}
+
+// StartCapturingLogNotifications begins capturing log notifications.
+// Must be called BEFORE the tool call that triggers the notification.
+// This method sets the logging level to debug to ensure all log messages are received.
+func (s *Suite) StartCapturingLogNotifications() *test.NotificationCapture {
+	// Set logging level to debug to receive all log messages
+	err := s.SetLoggingLevel(mcp.LoggingLevel("debug"))
+	s.Require().NoError(err, "failed to set logging level")
+
+	return s.StartCapturingNotifications()
+}
+
+// RestConfig returns the admin rest.Config for the shared envtest
+// environment, for seeding test data with typed or dynamic clients.
+func RestConfig() *rest.Config {
+	return test.EnvTestRestConfig()
+}
+
+// DynamicClient returns a dynamic client for the shared envtest environment,
+// the usual way toolset tests seed custom resources their tools act on.
+func DynamicClient() dynamic.Interface {
+	return dynamic.NewForConfigOrDie(test.EnvTestRestConfig())
+}
+
+// CRD builds a minimal CustomResourceDefinition with an open (x-kubernetes-
+// preserve-unknown-fields) schema, ready to install with envtest.InstallCRDs
+// in a suite's SetupSuite.
+func CRD(group, version, plural, kind, singular string, namespaced bool) *apiextensionsv1spec.CustomResourceDefinition {
+	return test.CRD(group, version, plural, kind, singular, namespaced)
+}
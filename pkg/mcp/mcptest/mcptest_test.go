@@ -0,0 +1,111 @@
+package mcptest_test
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1spec "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/mcp/mcptest"
+	oadpToolset "github.com/containers/kubernetes-mcp-server/pkg/toolsets/oadp"
+)
+
+func TestMain(m *testing.M) {
+	mcptest.Main(m)
+}
+
+var veleroCRDs = []*apiextensionsv1spec.CustomResourceDefinition{
+	mcptest.CRD("velero.io", "v1", "backups", "Backup", "backup", true),
+	mcptest.CRD("velero.io", "v1", "restores", "Restore", "restore", true),
+	mcptest.CRD("velero.io", "v1", "backupstoragelocations", "BackupStorageLocation", "backupstoragelocation", true),
+	mcptest.CRD("oadp.openshift.io", "v1alpha1", "dataprotectionapplications", "DataProtectionApplication", "dataprotectionapplication", true),
+}
+
+// HarnessSuite exercises the harness the way a downstream toolset test would,
+// using the OADP toolset as the worked example: install the toolset's CRDs in
+// SetupSuite, seed a custom resource with the dynamic client, and drive the
+// toolset through the embedded MCP client.
+type HarnessSuite struct {
+	mcptest.Suite
+}
+
+func (s *HarnessSuite) SetupSuite() {
+	_, err := envtest.InstallCRDs(mcptest.RestConfig(), envtest.CRDInstallOptions{CRDs: veleroCRDs})
+	s.Require().NoError(err)
+
+	_, _ = kubernetes.NewForConfigOrDie(mcptest.RestConfig()).CoreV1().Namespaces().
+		Create(s.T().Context(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "openshift-adp"}}, metav1.CreateOptions{})
+}
+
+func (s *HarnessSuite) TearDownSuite() {
+	s.Require().NoError(envtest.UninstallCRDs(mcptest.RestConfig(), envtest.CRDInstallOptions{CRDs: veleroCRDs}))
+}
+
+func (s *HarnessSuite) SetupTest() {
+	s.Suite.SetupTest()
+	s.Cfg.Toolsets = append(s.Cfg.Toolsets, (&oadpToolset.Toolset{}).GetName())
+	s.InitMcpClient()
+}
+
+func (s *HarnessSuite) TestToolsAndPromptsExposed() {
+	s.Run("core tools are listed", func() {
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		s.NotEmpty(tools.Tools, "expected the configured toolsets' tools to be listed")
+	})
+
+	s.Run("the enabled toolset's prompt is listed", func() {
+		prompts, err := s.ListPrompts()
+		s.Require().NoError(err)
+		var found bool
+		for _, prompt := range prompts.Prompts {
+			if prompt.Name == "oadp-troubleshoot" {
+				found = true
+				break
+			}
+		}
+		s.True(found, "expected the oadp-troubleshoot prompt to be listed")
+	})
+}
+
+func (s *HarnessSuite) TestSeededCustomResourceIsVisible() {
+	backup := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "velero.io/v1",
+			"kind":       "Backup",
+			"metadata": map[string]any{
+				"name":      "harness-backup",
+				"namespace": "openshift-adp",
+			},
+			"spec": map[string]any{
+				"includedNamespaces": []any{"default"},
+			},
+		},
+	}
+	_, err := mcptest.DynamicClient().Resource(schema.GroupVersionResource{
+		Group: "velero.io", Version: "v1", Resource: "backups",
+	}).Namespace("openshift-adp").Create(s.T().Context(), backup, metav1.CreateOptions{})
+	s.Require().NoError(err)
+
+	s.Run("prompt handler sees the seeded backup", func() {
+		result, err := s.GetPrompt("oadp-troubleshoot", map[string]string{
+			"backup": "harness-backup",
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(result)
+		s.Require().NotEmpty(result.Messages)
+		text := result.Messages[0].Content.(*mcp.TextContent).Text
+		s.Contains(text, "Backup: harness-backup", "expected the prompt to include the seeded backup")
+	})
+}
+
+func TestHarness(t *testing.T) {
+	suite.Run(t, new(HarnessSuite))
+}
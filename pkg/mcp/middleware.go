@@ -328,6 +328,31 @@ func getMcpReqUserAgent(req mcp.Request) string {
 // conflict with standard JSON-RPC codes or existing SDK application codes.
 const CodeRateLimitExceeded = -32029
 
+// CodeServerShuttingDown is the JSON-RPC error code returned for tool calls
+// that arrive after graceful shutdown has begun. The value -32030 sits next
+// to CodeRateLimitExceeded and echoes HTTP 503.
+const CodeServerShuttingDown = -32030
+
+// drainingMiddleware rejects new tool calls once Server.Drain has been
+// called and tracks in-flight tool calls so Drain can wait for them to
+// complete. Non-tool methods (initialize, ping, list requests) pass through
+// unconditionally so clients can still finish protocol exchanges while the
+// server drains.
+func (s *Server) drainingMiddleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != "tools/call" {
+				return next(ctx, method, req)
+			}
+			if !s.beginToolCall() {
+				return nil, &jsonrpc.Error{Code: CodeServerShuttingDown, Message: "server is shutting down, no new tool calls are accepted"}
+			}
+			defer s.inFlight.Done()
+			return next(ctx, method, req)
+		}
+	}
+}
+
 // rateLimitingMiddleware creates a per-session rate limiting middleware.
 // Each session gets its own rate.Limiter keyed by session ID.
 // Requests with an empty session ID (e.g. STDIO transport before initialization) bypass rate limiting.
@@ -1,12 +1,18 @@
 package mcp
 
 import (
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/bench"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/chaos"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/config"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/core"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/gatewayapi"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/gitops"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/helm"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/kcp"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/keda"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/kiali"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/kubevirt"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/netobserv"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/sandbox"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/tekton"
 )
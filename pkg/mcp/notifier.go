@@ -0,0 +1,159 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+	"github.com/containers/kubernetes-mcp-server/pkg/version"
+)
+
+// notifierPostTimeout bounds each webhook post so a slow endpoint cannot pile
+// up goroutines.
+const notifierPostTimeout = 10 * time.Second
+
+// notifierEvent is the payload posted to webhook endpoints. Tool arguments
+// are deliberately absent: they may carry secrets and the webhook endpoint is
+// outside the server's trust boundary.
+type notifierEvent struct {
+	// Event is one of the config.NotifierEvent* kinds.
+	Event string `json:"event"`
+	// Tool is the tool that ran, when the event concerns a tool call.
+	Tool string `json:"tool,omitempty"`
+	// Schedule is the schedule name, for scheduled_report events.
+	Schedule string `json:"schedule,omitempty"`
+	// Error is the failure message, empty on success.
+	Error string `json:"error,omitempty"`
+	// Summary is a one-line human-readable description, also used as the
+	// Slack message text.
+	Summary   string    `json:"summary"`
+	Timestamp time.Time `json:"timestamp"`
+	Server    string    `json:"server"`
+}
+
+// notifier fans selected server events out to the configured webhook
+// endpoints. Posts are asynchronous and best-effort: a failing endpoint is
+// logged and never blocks or fails the tool call that produced the event.
+// Like the proxied clients, the notifier set is fixed for the process
+// lifetime; changing it requires a restart.
+type notifier struct {
+	configs []config.NotifierConfig
+	client  *http.Client
+}
+
+func newNotifier(configs []config.NotifierConfig, requireTLS func() bool) *notifier {
+	return &notifier{
+		configs: configs,
+		client:  config.NewTLSEnforcingClient(&http.Client{Timeout: notifierPostTimeout}, requireTLS),
+	}
+}
+
+// notify posts event to every notifier subscribed to its kind. It returns
+// immediately; the posts run in their own goroutines detached from the
+// caller's cancellation so a finished tool call doesn't abort them.
+func (n *notifier) notify(ctx context.Context, event notifierEvent) {
+	event.Timestamp = time.Now()
+	event.Server = version.BinaryName
+	for _, cfg := range n.configs {
+		subscribed := false
+		for _, kind := range cfg.Events {
+			if kind == event.Event {
+				subscribed = true
+				break
+			}
+		}
+		if !subscribed {
+			continue
+		}
+		go n.post(context.WithoutCancel(ctx), cfg, event)
+	}
+}
+
+func (n *notifier) post(ctx context.Context, cfg config.NotifierConfig, event notifierEvent) {
+	logger := klogutil.FromContext(ctx)
+	var body []byte
+	var err error
+	if cfg.Format == config.NotifierFormatSlack {
+		body, err = json.Marshal(map[string]string{"text": event.Summary})
+	} else {
+		body, err = json.Marshal(event)
+	}
+	if err != nil {
+		logger.Error(err, "Failed to marshal notifier payload", "notifier", cfg.Name)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, notifierPostTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		logger.Error(err, "Failed to build notifier request", "notifier", cfg.Name)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if tokenFile := strings.TrimSpace(cfg.TokenFile); tokenFile != "" {
+		token, err := os.ReadFile(tokenFile)
+		if err != nil {
+			logger.Error(err, "Failed to read notifier token file", "notifier", cfg.Name)
+			return
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		logger.Error(err, "Failed to post notifier event", "notifier", cfg.Name, "event", event.Event)
+		return
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		logger.Error(fmt.Errorf("status %d", resp.StatusCode), "Notifier endpoint rejected event", "notifier", cfg.Name, "event", event.Event)
+	}
+}
+
+// notifyToolCallWebhooks posts the tool-call audit entry and, for failing
+// calls, the tool_error event to the configured webhook notifiers. No-op when
+// no notifiers are configured.
+func (s *Server) notifyToolCallWebhooks(ctx context.Context, toolName string, toolErr error) {
+	if s.notifier == nil {
+		return
+	}
+	event := notifierEvent{
+		Event:   config.NotifierEventToolCall,
+		Tool:    toolName,
+		Summary: fmt.Sprintf("Tool %s completed", toolName),
+	}
+	if toolErr != nil {
+		event.Error = toolErr.Error()
+		event.Summary = fmt.Sprintf("Tool %s failed: %s", toolName, toolErr.Error())
+	}
+	s.notifier.notify(ctx, event)
+	if toolErr != nil {
+		event.Event = config.NotifierEventToolError
+		s.notifier.notify(ctx, event)
+	}
+}
+
+// notifyScheduleWebhooks posts the outcome of a scheduled tool run to the
+// configured webhook notifiers. No-op when no notifiers are configured.
+func (s *Server) notifyScheduleWebhooks(ctx context.Context, schedule config.ScheduleConfig, runErr string) {
+	if s.notifier == nil {
+		return
+	}
+	event := notifierEvent{
+		Event:    config.NotifierEventScheduledReport,
+		Tool:     schedule.Tool,
+		Schedule: schedule.Name,
+		Error:    runErr,
+		Summary:  fmt.Sprintf("Scheduled %s run of %s completed; report at %s%s", schedule.Name, schedule.Tool, scheduledReportURIPrefix, schedule.Name),
+	}
+	if runErr != "" {
+		event.Summary = fmt.Sprintf("Scheduled %s run of %s failed: %s", schedule.Name, schedule.Tool, runErr)
+	}
+	s.notifier.notify(ctx, event)
+}
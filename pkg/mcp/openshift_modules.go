@@ -1,11 +1,16 @@
 package mcp
 
 import (
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/acs"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/externalsecrets"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/mustgather"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/netedge"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/oadp"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshiftai"
 	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/ovnkubernetes"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/quay"
+	_ "github.com/containers/kubernetes-mcp-server/pkg/toolsets/secretsmanagement"
 	_ "github.com/rhobs/obs-mcp/pkg/toolset"
 )
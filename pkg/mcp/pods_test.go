@@ -490,6 +490,45 @@ func (s *PodsSuite) TestPodsDelete() {
 	})
 }
 
+func (s *PodsSuite) TestPodsDeleteBackup() {
+	s.Cfg.BackupBeforeDelete = true
+	s.InitMcpClient()
+	kc := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	for _, name := range []string{"backed-up-pod", "unbacked-pod"} {
+		_, err := kc.CoreV1().Pods("default").Create(s.T().Context(), &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "nginx", Image: "nginx"}}},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create pod %s", name)
+	}
+	s.Run("pods_delete with backup_before_delete returns the backup YAML", func() {
+		toolResult, err := s.CallTool("pods_delete", map[string]interface{}{"name": "backed-up-pod"})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Run("includes the backup header", func() {
+			s.Truef(strings.HasPrefix(text, "Pod deleted successfully\n# Backup of the deleted pod (restore with resources_create_or_update):\n"),
+				"unexpected result %v", text)
+		})
+		s.Run("includes the deleted pod's YAML", func() {
+			s.Containsf(text, "name: backed-up-pod", "unexpected result %v", text)
+			s.Containsf(text, "image: nginx", "unexpected result %v", text)
+		})
+	})
+	s.Run("pods_delete with backup=false suppresses the backup", func() {
+		toolResult, err := s.CallTool("pods_delete", map[string]interface{}{"name": "unbacked-pod", "backup": false})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns the plain success message", func() {
+			s.Equal("Pod deleted successfully", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+}
+
 func (s *PodsSuite) TestPodsDeleteDenied() {
 	s.Require().NoError(toml.Unmarshal([]byte(`
 		denied_resources = [ { version = "v1", kind = "Pod" } ]
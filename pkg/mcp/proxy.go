@@ -0,0 +1,207 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/confirmation"
+	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/version"
+)
+
+// proxiedDiscoveryTimeout bounds how long a reload waits for one remote MCP
+// server's tool list before skipping it for that reload.
+const proxiedDiscoveryTimeout = 10 * time.Second
+
+// proxiedMCPClient maintains the connection to one remote MCP server whose
+// tools are re-exposed under a name prefix. The session is dialed lazily and
+// redialed after a transport failure, so a remote server that is down at
+// startup or restarts mid-session recovers on the next call.
+type proxiedMCPClient struct {
+	cfg config.ProxiedMCPServer
+	// connectCtx is the server-lifetime context sessions are dialed with,
+	// so a session outlives the individual tool call that triggered the
+	// dial.
+	connectCtx context.Context
+
+	mu      sync.Mutex
+	session *mcp.ClientSession
+}
+
+func newProxiedMCPClient(connectCtx context.Context, cfg config.ProxiedMCPServer) *proxiedMCPClient {
+	return &proxiedMCPClient{cfg: cfg, connectCtx: connectCtx}
+}
+
+func (c *proxiedMCPClient) getSession() (*mcp.ClientSession, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.session != nil {
+		return c.session, nil
+	}
+	client := mcp.NewClient(&mcp.Implementation{
+		Name:    version.BinaryName,
+		Version: version.Version,
+	}, nil)
+	var httpClient *http.Client
+	if c.cfg.AuthPassthrough {
+		httpClient = &http.Client{Transport: &authForwardingTransport{base: http.DefaultTransport}}
+	}
+	session, err := client.Connect(c.connectCtx, &mcp.StreamableClientTransport{
+		Endpoint:   c.cfg.URL,
+		HTTPClient: httpClient,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to proxied MCP server %q: %w", c.cfg.Name, err)
+	}
+	c.session = session
+	return session, nil
+}
+
+// resetSession drops the current session so the next call redials. Only the
+// session the failed call used is dropped — a concurrent redial's fresh
+// session is left alone.
+func (c *proxiedMCPClient) resetSession(failed *mcp.ClientSession) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.session == failed {
+		_ = c.session.Close()
+		c.session = nil
+	}
+}
+
+func (c *proxiedMCPClient) listTools(ctx context.Context) ([]*mcp.Tool, error) {
+	session, err := c.getSession()
+	if err != nil {
+		return nil, err
+	}
+	result, err := session.ListTools(ctx, nil)
+	if err != nil {
+		c.resetSession(session)
+		return nil, err
+	}
+	return result.Tools, nil
+}
+
+func (c *proxiedMCPClient) callTool(ctx context.Context, name string, arguments any) (*mcp.CallToolResult, error) {
+	session, err := c.getSession()
+	if err != nil {
+		return nil, err
+	}
+	result, err := session.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: arguments})
+	if err != nil {
+		c.resetSession(session)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *proxiedMCPClient) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.session != nil {
+		_ = c.session.Close()
+		c.session = nil
+	}
+}
+
+// authForwardingTransport injects the caller's Authorization header (put in
+// the context by authHeaderPropagationMiddleware) into outbound requests to
+// the remote server, so one bearer token authenticates the whole
+// consolidated endpoint.
+type authForwardingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *authForwardingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if authHeader, ok := req.Context().Value(internalk8s.OAuthAuthorizationHeader).(string); ok && authHeader != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(string(internalk8s.OAuthAuthorizationHeader), authHeader)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// collectProxiedTools lists every configured remote server's tools and
+// returns them renamed with the server's prefix, already in SDK form. Remote
+// tools go through the same applicability filter as local ones (judged by the
+// annotations the remote advertises), so read_only, disable_destructive, and
+// enabled_tools/disabled_tools apply to them too. A remote that cannot be
+// reached is logged and skipped for this reload — its tools reappear on the
+// next reload once it is back.
+func (s *Server) collectProxiedTools(ctx context.Context, cfg *Configuration) []convertedItem[*mcp.Tool, mcp.ToolHandler] {
+	logger := klogutil.FromContext(ctx)
+	var converted []convertedItem[*mcp.Tool, mcp.ToolHandler]
+	for _, client := range s.proxiedClients {
+		discoveryCtx, cancel := context.WithTimeout(ctx, proxiedDiscoveryTimeout)
+		tools, err := client.listTools(discoveryCtx)
+		cancel()
+		if err != nil {
+			logger.Error(err, "Skipping unreachable proxied MCP server", "server", client.cfg.Name)
+			continue
+		}
+		for _, tool := range tools {
+			item := s.proxiedToolItem(client, tool)
+			if !cfg.isToolApplicable(proxiedServerTool(item.name, tool)) {
+				continue
+			}
+			converted = append(converted, item)
+		}
+	}
+	return converted
+}
+
+// proxiedServerTool adapts a remote SDK tool (under its prefixed name) into
+// the api.ServerTool shape isToolApplicable judges. A remote tool without
+// annotations gets none, so it is dropped in read-only mode — a remote that
+// doesn't declare readOnlyHint can't be trusted not to write.
+func proxiedServerTool(name string, tool *mcp.Tool) api.ServerTool {
+	annotations := api.ToolAnnotations{}
+	if tool.Annotations != nil {
+		annotations = api.ToolAnnotations{
+			Title:           tool.Annotations.Title,
+			ReadOnlyHint:    ptr.To(tool.Annotations.ReadOnlyHint),
+			DestructiveHint: tool.Annotations.DestructiveHint,
+			IdempotentHint:  ptr.To(tool.Annotations.IdempotentHint),
+			OpenWorldHint:   tool.Annotations.OpenWorldHint,
+		}
+	}
+	return api.ServerTool{Tool: api.Tool{Name: name, Annotations: annotations}}
+}
+
+// proxiedToolItem renames tool with client's prefix and binds a handler that
+// forwards the call (with the original tool name) to the remote server. The
+// handler enforces the same confirmation rules as local tools before
+// forwarding.
+func (s *Server) proxiedToolItem(client *proxiedMCPClient, tool *mcp.Tool) convertedItem[*mcp.Tool, mcp.ToolHandler] {
+	remoteName := tool.Name
+	proxied := *tool
+	proxied.Name = client.cfg.Name + "_" + remoteName
+	if proxied.Description != "" {
+		proxied.Description += "\n"
+	}
+	proxied.Description += fmt.Sprintf("(proxied from the %q MCP server)", client.cfg.Name)
+	var destructiveHint *bool
+	if tool.Annotations != nil {
+		destructiveHint = tool.Annotations.DestructiveHint
+	}
+	handler := func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Snapshot the live configuration like ServerToolToGoSdkTool does, so
+		// confirmation rules follow config reloads.
+		if confirmErr := confirmation.CheckToolRules(
+			ctx, s.configuration.Load(), &sessionElicitor{},
+			proxied.Name, destructiveHint,
+		); confirmErr != nil {
+			return NewTextResult("", confirmErr), nil
+		}
+		return client.callTool(ctx, remoteName, req.Params.Arguments)
+	}
+	return convertedItem[*mcp.Tool, mcp.ToolHandler]{name: proxied.Name, item: &proxied, handler: handler}
+}
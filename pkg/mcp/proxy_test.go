@@ -0,0 +1,134 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+type ProxySuite struct {
+	BaseMcpSuite
+	remoteServer *httptest.Server
+	// capturedAuth records the Authorization header the remote saw on the
+	// last tools/call request.
+	capturedAuth string
+}
+
+func (s *ProxySuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.capturedAuth = ""
+	remote := mcp.NewServer(&mcp.Implementation{Name: "remote", Version: "0.0.0"}, nil)
+	remote.AddTool(&mcp.Tool{
+		Name:        "echo",
+		Description: "Echoes back the provided message",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"message": map[string]any{"type": "string"},
+			},
+		},
+	}, func(_ context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var arguments map[string]any
+		_ = json.Unmarshal(req.Params.Arguments, &arguments)
+		text, _ := arguments["message"].(string)
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "echo: " + text}}}, nil
+	})
+	remote.AddTool(&mcp.Tool{
+		Name:        "list_things",
+		Description: "Lists things",
+		Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+		InputSchema: map[string]any{"type": "object"},
+	}, func(_ context.Context, _ *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "things"}}}, nil
+	})
+	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server { return remote }, nil)
+	s.remoteServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.capturedAuth = r.Header.Get("Authorization")
+		handler.ServeHTTP(w, r)
+	}))
+	s.Cfg.ProxiedMCPServers = []config.ProxiedMCPServer{
+		{Name: "remote", URL: s.remoteServer.URL, AuthPassthrough: true},
+	}
+}
+
+func (s *ProxySuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.remoteServer != nil {
+		s.remoteServer.Close()
+	}
+}
+
+func (s *ProxySuite) TestProxiedTools() {
+	s.InitMcpClient()
+	s.Run("remote tools are listed with the server's prefix", func() {
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		var proxied *mcp.Tool
+		for _, tool := range tools.Tools {
+			if tool.Name == "remote_echo" {
+				proxied = tool
+			}
+		}
+		s.Require().NotNil(proxied, "expected the remote echo tool to be re-exposed as remote_echo")
+		s.Contains(proxied.Description, `proxied from the "remote" MCP server`, "description should state the tool's origin")
+	})
+	s.Run("calls are forwarded to the remote server", func() {
+		result, err := s.CallTool("remote_echo", map[string]any{"message": "hello"})
+		s.Require().NoError(err)
+		s.Require().False(result.IsError, "proxied call should succeed")
+		s.Len(result.Content, 1)
+		s.Equal("echo: hello", result.Content[0].(*mcp.TextContent).Text, "remote result should be returned verbatim")
+	})
+}
+
+func (s *ProxySuite) TestProxiedToolsInReadOnlyMode() {
+	s.Run("read-only mode keeps only remote tools that declare readOnlyHint", func() {
+		s.Cfg.ReadOnly = true
+		s.InitMcpClient()
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		names := make([]string, 0, len(tools.Tools))
+		for _, tool := range tools.Tools {
+			names = append(names, tool.Name)
+		}
+		s.NotContains(names, "remote_echo", "a remote tool without annotations must not be exposed in read-only mode")
+		s.Contains(names, "remote_list_things", "a remote tool declaring readOnlyHint should remain exposed")
+	})
+}
+
+func (s *ProxySuite) TestProxiedToolsRespectDisabledTools() {
+	s.Run("disabled_tools applies to the prefixed remote tool name", func() {
+		s.Cfg.DisabledTools = []string{"remote_echo"}
+		s.InitMcpClient()
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		for _, tool := range tools.Tools {
+			s.NotEqual("remote_echo", tool.Name, "disabled remote tools must not be exposed")
+		}
+	})
+}
+
+func (s *ProxySuite) TestUnreachableRemote() {
+	s.Run("an unreachable remote is skipped without failing startup", func() {
+		s.Cfg.ProxiedMCPServers = []config.ProxiedMCPServer{
+			{Name: "gone", URL: "http://127.0.0.1:1/mcp"},
+		}
+		s.InitMcpClient()
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		for _, tool := range tools.Tools {
+			s.NotContains(tool.Name, "gone_", "no tools should be exposed for an unreachable remote")
+		}
+	})
+}
+
+func TestProxy(t *testing.T) {
+	suite.Run(t, new(ProxySuite))
+}
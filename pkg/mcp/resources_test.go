@@ -1,8 +1,11 @@
 package mcp
 
 import (
-	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"context"
+	"fmt"
 	"regexp"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"strings"
 	"testing"
 	"time"
@@ -752,7 +755,7 @@ func (s *ResourcesSuite) TestResourcesDelete() {
 	s.Run("resources_delete with missing name returns error", func() {
 		toolResult, _ := s.CallTool("resources_delete", map[string]interface{}{"apiVersion": "v1", "kind": "Namespace"})
 		s.Truef(toolResult.IsError, "call tool should fail")
-		s.Equalf("failed to delete resource, missing argument name", toolResult.Content[0].(*mcp.TextContent).Text,
+		s.Equalf("failed to delete resource, missing argument name (or a labelSelector/fieldSelector for bulk deletion)", toolResult.Content[0].(*mcp.TextContent).Text,
 			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
 	})
 	s.Run("resources_delete with nonexistent resource", func() {
@@ -813,6 +816,176 @@ func (s *ResourcesSuite) TestResourcesDelete() {
 	})
 }
 
+func (s *ResourcesSuite) TestResourcesDeleteBackup() {
+	s.Cfg.BackupBeforeDelete = true
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	for _, name := range []string{"backed-up-configmap", "unbacked-configmap"} {
+		_, err := client.CoreV1().ConfigMaps("default").Create(s.T().Context(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Data:       map[string]string{"key": "value"},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create configmap %s", name)
+	}
+	s.Run("resources_delete with backup_before_delete returns the backup YAML", func() {
+		toolResult, err := s.CallTool("resources_delete", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "name": "backed-up-configmap",
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Run("includes the backup header", func() {
+			s.Truef(strings.HasPrefix(text, "Resource deleted successfully\n# Backup of the deleted resource (restore with resources_create_or_update):\n"),
+				"unexpected result %v", text)
+		})
+		s.Run("includes the deleted resource's YAML", func() {
+			s.Containsf(text, "name: backed-up-configmap", "unexpected result %v", text)
+			s.Containsf(text, "key: value", "unexpected result %v", text)
+		})
+		s.Run("deletes the ConfigMap", func() {
+			_, getErr := client.CoreV1().ConfigMaps("default").Get(s.T().Context(), "backed-up-configmap", metav1.GetOptions{})
+			s.Error(getErr, "ConfigMap not deleted")
+		})
+	})
+	s.Run("resources_delete with backup=false suppresses the backup", func() {
+		toolResult, err := s.CallTool("resources_delete", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "name": "unbacked-configmap", "backup": false,
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns the plain success message", func() {
+			s.Equal("Resource deleted successfully", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+	})
+	s.Run("resources_delete with backup=true and nonexistent resource fails the backup", func() {
+		toolResult, _ := s.CallTool("resources_delete", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "name": "nonexistent-configmap", "backup": true,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Containsf(toolResult.Content[0].(*mcp.TextContent).Text, "failed to back up resource before deleting it:",
+			"unexpected result %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+}
+
+func (s *ResourcesSuite) TestResourcesDeleteNameAndSelectorMutuallyExclusive() {
+	s.InitMcpClient()
+	s.Run("resources_delete with both name and labelSelector returns error", func() {
+		toolResult, _ := s.CallTool("resources_delete", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "name": "a-configmap", "labelSelector": "bulk=true",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to delete resource, name and labelSelector/fieldSelector are mutually exclusive", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+}
+
+// seedBulkDeleteConfigMaps creates count ConfigMaps labeled bulk=true in the
+// default namespace for the selector-based delete tests.
+func (s *ResourcesSuite) seedBulkDeleteConfigMaps(count int) {
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	for i := 0; i < count; i++ {
+		_, err := client.CoreV1().ConfigMaps("default").Create(s.T().Context(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("bulk-configmap-%d", i),
+				Labels: map[string]string{"bulk": "true"},
+			},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create bulk configmap")
+	}
+}
+
+func (s *ResourcesSuite) TestResourcesBulkDeleteConfirmed() {
+	var elicitedMessage string
+	s.InitMcpClient(test.WithElicitationHandler(
+		func(_ context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			elicitedMessage = req.Params.Message
+			return &mcp.ElicitResult{Action: "accept"}, nil
+		},
+	))
+	s.seedBulkDeleteConfigMaps(3)
+	s.Run("resources_delete with selector matching nothing deletes nothing", func() {
+		toolResult, err := s.CallTool("resources_delete", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "labelSelector": "bulk=nope",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("No resources match the provided selectors, nothing deleted", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("resources_delete refuses when matches exceed maxObjects", func() {
+		toolResult, _ := s.CallTool("resources_delete", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "labelSelector": "bulk=true", "maxObjects": 2,
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+		})
+		s.Run("describes the cap", func() {
+			s.Equalf("refusing bulk delete: the selectors match 3 objects, above the maxObjects limit of 2; narrow the selectors or raise maxObjects explicitly",
+				toolResult.Content[0].(*mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("leaves the resources untouched", func() {
+			client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+			configMaps, listErr := client.CoreV1().ConfigMaps("default").List(s.T().Context(), metav1.ListOptions{LabelSelector: "bulk=true"})
+			s.Require().NoError(listErr, "failed to list configmaps")
+			s.Len(configMaps.Items, 3, "cap refusal must not delete anything")
+		})
+	})
+	s.Run("resources_delete with labelSelector and accepted confirmation", func() {
+		toolResult, err := s.CallTool("resources_delete", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "labelSelector": "bulk=true",
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("previews the matches in the confirmation prompt", func() {
+			s.Contains(elicitedMessage, "About to delete 3 ConfigMap object(s):")
+			s.Contains(elicitedMessage, "- default/bulk-configmap-0")
+		})
+		s.Run("reports the deleted objects", func() {
+			text := toolResult.Content[0].(*mcp.TextContent).Text
+			s.Containsf(text, "Deleted 3 of 3 matching ConfigMap object(s):", "unexpected result %v", text)
+			s.Containsf(text, "- default/bulk-configmap-1", "unexpected result %v", text)
+		})
+		s.Run("deletes the matching ConfigMaps", func() {
+			client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+			configMaps, listErr := client.CoreV1().ConfigMaps("default").List(s.T().Context(), metav1.ListOptions{LabelSelector: "bulk=true"})
+			s.Require().NoError(listErr, "failed to list configmaps")
+			s.Empty(configMaps.Items, "all matching configmaps should be deleted")
+		})
+	})
+}
+
+func (s *ResourcesSuite) TestResourcesBulkDeleteDeclined() {
+	s.InitMcpClient(test.WithElicitationHandler(
+		func(_ context.Context, _ *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			return &mcp.ElicitResult{Action: "decline"}, nil
+		},
+	))
+	s.seedBulkDeleteConfigMaps(1)
+	s.Run("resources_delete with labelSelector and declined confirmation", func() {
+		toolResult, err := s.CallTool("resources_delete", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "labelSelector": "bulk=true",
+		})
+		s.Run("returns without error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "a declined confirmation is not a tool error")
+		})
+		s.Run("reports nothing was deleted", func() {
+			s.Equal("Bulk delete not confirmed by the user, nothing deleted", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("leaves the resources untouched", func() {
+			client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+			configMaps, listErr := client.CoreV1().ConfigMaps("default").List(s.T().Context(), metav1.ListOptions{LabelSelector: "bulk=true"})
+			s.Require().NoError(listErr, "failed to list configmaps")
+			s.Len(configMaps.Items, 1, "declined bulk delete must not delete anything")
+		})
+	})
+}
+
 func (s *ResourcesSuite) TestResourcesDeleteDenied() {
 	s.Require().NoError(toml.Unmarshal([]byte(`
 		denied_resources = [
@@ -0,0 +1,190 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+// scheduledReportURIPrefix prefixes the URIs under which the latest result of
+// every configured schedule is exposed as a readable MCP resource.
+const scheduledReportURIPrefix = "scheduled-report://"
+
+// scheduledReport is the stored outcome of the most recent run of a schedule.
+type scheduledReport struct {
+	ran     time.Time
+	content string
+	err     string
+}
+
+// scheduler runs the configured read-only tools periodically and keeps their
+// latest result for the scheduled-report:// resources. Like the operation
+// state backend, the schedule set is fixed for the process lifetime; changing
+// it requires a restart.
+type scheduler struct {
+	server   *Server
+	mu       sync.Mutex
+	reports  map[string]*scheduledReport
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// backgroundElicitor is the Elicitor handed to scheduled runs: there is no
+// MCP session to ask, so any elicitation (e.g. a confirmation prompt) refuses
+// instead of hanging the schedule.
+type backgroundElicitor struct{}
+
+var _ api.Elicitor = backgroundElicitor{}
+
+func (backgroundElicitor) Elicit(context.Context, *api.ElicitParams) (*api.ElicitResult, error) {
+	return nil, api.ErrElicitationNotSupported
+}
+
+// startScheduler registers one report resource per configured schedule and
+// launches its run loop. Like the attachment template, the resources are
+// registered directly on the SDK server and bypass the toolset bookkeeping.
+func (s *Server) startScheduler(ctx context.Context, schedules []config.ScheduleConfig) {
+	s.scheduler = &scheduler{
+		server:  s,
+		reports: make(map[string]*scheduledReport, len(schedules)),
+		done:    make(chan struct{}),
+	}
+	for _, schedule := range schedules {
+		s.server.AddResource(&mcp.Resource{
+			URI:         scheduledReportURIPrefix + schedule.Name,
+			Name:        schedule.Name,
+			Description: fmt.Sprintf("Latest result of the scheduled %s run (every %s)", schedule.Tool, schedule.Every.Duration()),
+			MIMEType:    "text/plain",
+		}, s.scheduler.readReport)
+		go s.scheduler.run(ctx, schedule)
+	}
+}
+
+func (sc *scheduler) run(ctx context.Context, schedule config.ScheduleConfig) {
+	sc.runOnce(ctx, schedule)
+	ticker := time.NewTicker(schedule.Every.Duration())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sc.done:
+			return
+		case <-ticker.C:
+			sc.runOnce(ctx, schedule)
+		}
+	}
+}
+
+// runOnce executes the schedule's tool once, stores the outcome, and notifies
+// subscribed sessions that the report resource changed. A failed run is
+// stored as an error report rather than dropped, so consumers see that the
+// schedule is broken instead of reading an ever-staler success.
+func (sc *scheduler) runOnce(ctx context.Context, schedule config.ScheduleConfig) {
+	content, err := sc.execute(ctx, schedule)
+	report := &scheduledReport{ran: time.Now(), content: content}
+	if err != nil {
+		klogutil.FromContext(ctx).Error(err, "Scheduled tool run failed", "schedule", schedule.Name, "tool", schedule.Tool)
+		report.err = err.Error()
+	}
+	sc.mu.Lock()
+	sc.reports[schedule.Name] = report
+	sc.mu.Unlock()
+	_ = sc.server.server.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{
+		URI: scheduledReportURIPrefix + schedule.Name,
+	})
+	sc.server.notifyScheduleWebhooks(ctx, schedule, report.err)
+}
+
+// execute runs the schedule's tool against the default target with the
+// configured arguments. The tool is resolved from the live configuration on
+// every run, so a reload that drops the tool turns the schedule into an error
+// report rather than running a stale handler.
+func (sc *scheduler) execute(ctx context.Context, schedule config.ScheduleConfig) (string, error) {
+	cfg := sc.server.configuration.Load()
+	tool, ok := findScheduledTool(cfg, sc.server.p, schedule.Tool)
+	if !ok {
+		return "", fmt.Errorf("tool %s is not provided by any enabled toolset", schedule.Tool)
+	}
+	if !ptr.Deref(tool.Tool.Annotations.ReadOnlyHint, false) {
+		return "", fmt.Errorf("tool %s is not read-only; only read-only tools can be scheduled", schedule.Tool)
+	}
+	if timeout := cfg.ToolTimeout.Duration(); timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+		defer cancelTimeout()
+	}
+	k, err := sc.server.p.GetDerivedKubernetes(ctx, sc.server.p.GetDefaultTarget())
+	if err != nil {
+		return "", err
+	}
+	result, err := tool.Handler(api.ToolHandlerParams{
+		Context:           ctx,
+		BaseConfig:        cfg,
+		KubernetesClient:  k,
+		FilteringProvider: sc.server.p,
+		ToolCallRequest:   &ToolCallRequest{Name: schedule.Tool, arguments: schedule.Arguments},
+		ListOutput:        cfg.ListOutput(),
+		Elicitor:          backgroundElicitor{},
+		OperationState:    sc.server.operationState,
+	})
+	if err != nil {
+		return "", err
+	}
+	if result.Error != nil {
+		return "", result.Error
+	}
+	return result.Content, nil
+}
+
+// findScheduledTool resolves a tool by name from the enabled toolsets. The
+// raw toolset tools are used (no mutators), so schedules always run against
+// the default target and take their arguments from the configuration only.
+func findScheduledTool(cfg *Configuration, p internalk8s.Provider, name string) (api.ServerTool, bool) {
+	for _, toolset := range cfg.Toolsets() {
+		for _, tool := range toolset.GetTools(p) {
+			if tool.Tool.Name == name {
+				return tool, true
+			}
+		}
+	}
+	return api.ServerTool{}, false
+}
+
+// readReport serves resources/read for scheduled-report:// URIs.
+func (sc *scheduler) readReport(_ context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	name := strings.TrimPrefix(req.Params.URI, scheduledReportURIPrefix)
+	sc.mu.Lock()
+	report, ok := sc.reports[name]
+	sc.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("schedule %s has not produced a report yet", name)
+	}
+	text := fmt.Sprintf("Last run: %s\n\n", report.ran.Format(time.RFC3339))
+	if report.err != "" {
+		text += "The scheduled run failed: " + report.err
+	} else {
+		text += report.content
+	}
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{{
+			URI:      req.Params.URI,
+			MIMEType: "text/plain",
+			Text:     text,
+		}},
+	}, nil
+}
+
+func (sc *scheduler) Close() {
+	sc.stopOnce.Do(func() { close(sc.done) })
+}
@@ -0,0 +1,209 @@
+package mcp
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type ServiceAccountsSuite struct {
+	BaseMcpSuite
+}
+
+func (s *ServiceAccountsSuite) TestServiceAccountsListAndCreate() {
+	s.InitMcpClient()
+	s.Run("serviceaccounts_list (no service accounts)", func() {
+		// envtest runs no controllers, so not even the default service
+		// account exists until a test creates one.
+		toolResult, err := s.CallTool("serviceaccounts_list", map[string]interface{}{"namespace": "ns-1"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("No service accounts found in namespace ns-1", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("serviceaccounts_create with missing name returns error", func() {
+		toolResult, _ := s.CallTool("serviceaccounts_create", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to create service account: name parameter required", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("serviceaccounts_create creates the service account", func() {
+		toolResult, err := s.CallTool("serviceaccounts_create", map[string]interface{}{"name": "workload-sa"})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Service account workload-sa created in namespace default", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("creates the ServiceAccount", func() {
+			client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+			_, getErr := client.CoreV1().ServiceAccounts("default").Get(s.T().Context(), "workload-sa", metav1.GetOptions{})
+			s.NoError(getErr, "ServiceAccount not created")
+		})
+	})
+	s.Run("serviceaccounts_create with existing name is a no-op", func() {
+		toolResult, err := s.CallTool("serviceaccounts_create", map[string]interface{}{"name": "workload-sa"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "an existing service account is not a tool error")
+		s.Equal("Service account workload-sa already exists in namespace default", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("serviceaccounts_list includes the created service account", func() {
+		toolResult, err := s.CallTool("serviceaccounts_list", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Containsf(text, "Service accounts in namespace default", "unexpected result %v", text)
+		s.Containsf(text, "- workload-sa", "unexpected result %v", text)
+	})
+}
+
+func (s *ServiceAccountsSuite) TestServiceAccountsTokenCreate() {
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	_, err := client.CoreV1().ServiceAccounts("default").Create(s.T().Context(),
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "token-sa"}}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create service account")
+	s.Run("serviceaccounts_token_create with nonexistent service account returns error", func() {
+		toolResult, _ := s.CallTool("serviceaccounts_token_create", map[string]interface{}{"name": "not-there"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Containsf(toolResult.Content[0].(*mcp.TextContent).Text, "failed to create token for service account default/not-there",
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("serviceaccounts_token_create issues a bounded token", func() {
+		toolResult, err := s.CallTool("serviceaccounts_token_create", map[string]interface{}{
+			"name":              "token-sa",
+			"expirationSeconds": 600,
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		s.Run("reports the expiration", func() {
+			s.Regexpf(`^Token for service account default/token-sa \(expires [^)]+\):\n`, text, "unexpected result %v", text)
+		})
+		s.Run("honors the requested expiration bound", func() {
+			match := regexp.MustCompile(`expires ([^)]+)\)`).FindStringSubmatch(text)
+			s.Require().Len(match, 2, "expected an expiration timestamp in the result")
+			expiry, parseErr := time.Parse(time.RFC3339, match[1])
+			s.Require().NoError(parseErr, "failed to parse expiration timestamp")
+			s.WithinDurationf(time.Now().Add(600*time.Second), expiry, 2*time.Minute,
+				"expiration should be close to the requested 600 seconds, got %s", match[1])
+		})
+		s.Run("returns a JWT", func() {
+			token := text[strings.LastIndex(text, "\n")+1:]
+			s.Len(strings.Split(token, "."), 3, "expected a JWT with three segments")
+		})
+	})
+	s.Run("serviceaccounts_token_create with audiences scopes the token", func() {
+		toolResult, err := s.CallTool("serviceaccounts_token_create", map[string]interface{}{
+			"name":      "token-sa",
+			"audiences": []string{"https://audience.example.com"},
+		})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := toolResult.Content[0].(*mcp.TextContent).Text
+		token := text[strings.LastIndex(text, "\n")+1:]
+		segments := strings.Split(token, ".")
+		s.Require().Len(segments, 3, "expected a JWT with three segments")
+		payload, decodeErr := base64.RawURLEncoding.DecodeString(segments[1])
+		s.Require().NoError(decodeErr, "failed to decode JWT payload")
+		s.Containsf(string(payload), "https://audience.example.com", "token payload should carry the requested audience")
+	})
+}
+
+func (s *ServiceAccountsSuite) TestServiceAccountsLinkPullSecret() {
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	_, err := client.CoreV1().ServiceAccounts("default").Create(s.T().Context(),
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "puller-sa"}}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create service account")
+	_, err = client.CoreV1().Secrets("default").Create(s.T().Context(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-creds"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create pull secret")
+	_, err = client.CoreV1().Secrets("default").Create(s.T().Context(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "not-a-pull-secret"},
+		Data:       map[string][]byte{"token": []byte("abc")},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create opaque secret")
+	s.Run("serviceaccounts_link_pull_secret with a non-pull secret returns error", func() {
+		toolResult, _ := s.CallTool("serviceaccounts_link_pull_secret", map[string]interface{}{
+			"name": "puller-sa", "secret": "not-a-pull-secret",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("secret default/not-a-pull-secret has type Opaque, expected kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg",
+			toolResult.Content[0].(*mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("serviceaccounts_link_pull_secret links the secret", func() {
+		toolResult, err := s.CallTool("serviceaccounts_link_pull_secret", map[string]interface{}{
+			"name": "puller-sa", "secret": "registry-creds",
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Secret registry-creds linked to service account default/puller-sa as an image pull secret",
+				toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("adds the image pull secret reference", func() {
+			sa, getErr := client.CoreV1().ServiceAccounts("default").Get(s.T().Context(), "puller-sa", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get service account")
+			s.Require().Len(sa.ImagePullSecrets, 1)
+			s.Equal("registry-creds", sa.ImagePullSecrets[0].Name)
+		})
+	})
+	s.Run("serviceaccounts_link_pull_secret with unlink removes the secret", func() {
+		toolResult, err := s.CallTool("serviceaccounts_link_pull_secret", map[string]interface{}{
+			"name": "puller-sa", "secret": "registry-creds", "unlink": true,
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Secret registry-creds unlinked from service account default/puller-sa", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("removes the image pull secret reference", func() {
+			sa, getErr := client.CoreV1().ServiceAccounts("default").Get(s.T().Context(), "puller-sa", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get service account")
+			s.Empty(sa.ImagePullSecrets)
+		})
+	})
+	s.Run("serviceaccounts_link_pull_secret unlinking an unlinked secret returns error", func() {
+		toolResult, _ := s.CallTool("serviceaccounts_link_pull_secret", map[string]interface{}{
+			"name": "puller-sa", "secret": "registry-creds", "unlink": true,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("secret registry-creds is not linked to service account default/puller-sa",
+			toolResult.Content[0].(*mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+}
+
+func (s *ServiceAccountsSuite) TestServiceAccountsInReadOnlyMode() {
+	s.Run("read-only mode keeps only serviceaccounts_list", func() {
+		s.Cfg.ReadOnly = true
+		s.InitMcpClient()
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		names := make([]string, 0, len(tools.Tools))
+		for _, tool := range tools.Tools {
+			names = append(names, tool.Name)
+		}
+		s.Contains(names, "serviceaccounts_list", "the read-only list tool should remain exposed")
+		s.NotContains(names, "serviceaccounts_create", "write tools must not be exposed in read-only mode")
+		s.NotContains(names, "serviceaccounts_token_create", "token issuance must not be exposed in read-only mode")
+		s.NotContains(names, "serviceaccounts_link_pull_secret", "write tools must not be exposed in read-only mode")
+	})
+}
+
+func TestServiceAccounts(t *testing.T) {
+	suite.Run(t, new(ServiceAccountsSuite))
+}
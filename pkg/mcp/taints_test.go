@@ -0,0 +1,190 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+)
+
+type TaintsSuite struct {
+	BaseMcpSuite
+}
+
+func (s *TaintsSuite) createNode(name string) *kubernetes.Clientset {
+	client := kubernetes.NewForConfigOrDie(test.EnvTestRestConfig())
+	_, err := client.CoreV1().Nodes().Create(s.T().Context(),
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create node")
+	return client
+}
+
+func (s *TaintsSuite) TestNodesTaintAdd() {
+	s.InitMcpClient()
+	client := s.createNode("a-node-to-taint")
+	s.Run("nodes_taint_add with missing effect argument returns error", func() {
+		toolResult, _ := s.CallTool("nodes_taint_add", map[string]interface{}{"name": "a-node-to-taint", "key": "dedicated"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to add taint: effect parameter required", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("nodes_taint_add with nonexistent node returns error", func() {
+		toolResult, _ := s.CallTool("nodes_taint_add", map[string]interface{}{
+			"name": "not-there", "key": "dedicated", "effect": "NoSchedule",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Containsf(toolResult.Content[0].(*mcp.TextContent).Text, "failed to get node not-there",
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("nodes_taint_add adds the taint", func() {
+		toolResult, err := s.CallTool("nodes_taint_add", map[string]interface{}{
+			"name": "a-node-to-taint", "key": "dedicated", "value": "gpu", "effect": "NoSchedule",
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Taint dedicated=gpu:NoSchedule added to node a-node-to-taint", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("stores the taint on the node", func() {
+			node, getErr := client.CoreV1().Nodes().Get(s.T().Context(), "a-node-to-taint", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get node")
+			s.Require().Len(node.Spec.Taints, 1)
+			s.Equal(corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}, node.Spec.Taints[0])
+		})
+	})
+	s.Run("nodes_taint_add with an identical taint is a no-op", func() {
+		toolResult, err := s.CallTool("nodes_taint_add", map[string]interface{}{
+			"name": "a-node-to-taint", "key": "dedicated", "value": "gpu", "effect": "NoSchedule",
+		})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "an identical taint is not a tool error")
+		s.Equal("Node a-node-to-taint already has taint dedicated=gpu:NoSchedule", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("nodes_taint_add refuses to replace the value without overwrite", func() {
+		toolResult, _ := s.CallTool("nodes_taint_add", map[string]interface{}{
+			"name": "a-node-to-taint", "key": "dedicated", "value": "fpga", "effect": "NoSchedule",
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Equalf(`node a-node-to-taint already has a taint with key dedicated and effect NoSchedule (value "gpu"): pass overwrite to replace it`,
+				toolResult.Content[0].(*mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("leaves the taint untouched", func() {
+			node, getErr := client.CoreV1().Nodes().Get(s.T().Context(), "a-node-to-taint", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get node")
+			s.Require().Len(node.Spec.Taints, 1)
+			s.Equal("gpu", node.Spec.Taints[0].Value)
+		})
+	})
+	s.Run("nodes_taint_add with overwrite replaces the value", func() {
+		toolResult, err := s.CallTool("nodes_taint_add", map[string]interface{}{
+			"name": "a-node-to-taint", "key": "dedicated", "value": "fpga", "effect": "NoSchedule", "overwrite": true,
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Taint dedicated=fpga:NoSchedule added to node a-node-to-taint", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("stores the new value without duplicating the taint", func() {
+			node, getErr := client.CoreV1().Nodes().Get(s.T().Context(), "a-node-to-taint", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get node")
+			s.Require().Len(node.Spec.Taints, 1)
+			s.Equal("fpga", node.Spec.Taints[0].Value)
+		})
+	})
+	s.Run("nodes_taint_add with a different effect adds a second taint", func() {
+		toolResult, err := s.CallTool("nodes_taint_add", map[string]interface{}{
+			"name": "a-node-to-taint", "key": "dedicated", "value": "fpga", "effect": "NoExecute",
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Taint dedicated=fpga:NoExecute added to node a-node-to-taint", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("keeps both taints", func() {
+			node, getErr := client.CoreV1().Nodes().Get(s.T().Context(), "a-node-to-taint", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get node")
+			s.Len(node.Spec.Taints, 2)
+		})
+	})
+}
+
+func (s *TaintsSuite) TestNodesTaintRemove() {
+	s.InitMcpClient()
+	client := s.createNode("a-node-to-untaint")
+	node, err := client.CoreV1().Nodes().Get(s.T().Context(), "a-node-to-untaint", metav1.GetOptions{})
+	s.Require().NoError(err, "failed to get node")
+	node.Spec.Taints = []corev1.Taint{
+		{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute},
+		{Key: "maintenance", Effect: corev1.TaintEffectNoSchedule},
+	}
+	_, err = client.CoreV1().Nodes().Update(s.T().Context(), node, metav1.UpdateOptions{})
+	s.Require().NoError(err, "failed to taint node")
+	s.Run("nodes_taint_remove with missing key argument returns error", func() {
+		toolResult, _ := s.CallTool("nodes_taint_remove", map[string]interface{}{"name": "a-node-to-untaint"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to remove taint: key parameter required", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("nodes_taint_remove with a key the node does not have returns error", func() {
+		toolResult, _ := s.CallTool("nodes_taint_remove", map[string]interface{}{"name": "a-node-to-untaint", "key": "not-there"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("node a-node-to-untaint has no taint with key not-there", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("nodes_taint_remove narrowed to an effect removes only that taint", func() {
+		toolResult, err := s.CallTool("nodes_taint_remove", map[string]interface{}{
+			"name": "a-node-to-untaint", "key": "dedicated", "effect": "NoExecute",
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Removed taint(s) dedicated=gpu:NoExecute from node a-node-to-untaint", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("keeps the taints with other effects", func() {
+			node, getErr := client.CoreV1().Nodes().Get(s.T().Context(), "a-node-to-untaint", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get node")
+			s.Len(node.Spec.Taints, 2)
+		})
+	})
+	s.Run("nodes_taint_remove without effect removes all taints for the key", func() {
+		toolResult, err := s.CallTool("nodes_taint_remove", map[string]interface{}{"name": "a-node-to-untaint", "key": "dedicated"})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("Removed taint(s) dedicated=gpu:NoSchedule from node a-node-to-untaint", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("keeps the taints with other keys", func() {
+			node, getErr := client.CoreV1().Nodes().Get(s.T().Context(), "a-node-to-untaint", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get node")
+			s.Require().Len(node.Spec.Taints, 1)
+			s.Equal("maintenance", node.Spec.Taints[0].Key)
+		})
+	})
+}
+
+func (s *TaintsSuite) TestNodesTaintBlockedPods() {
+	s.InitMcpClient()
+	s.Run("nodes_taint_blocked_pods with no pending pods reports nothing blocked", func() {
+		toolResult, err := s.CallTool("nodes_taint_blocked_pods", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("No pending pods in the cluster are blocked by node taints", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("nodes_taint_blocked_pods scoped to a namespace reports the namespace", func() {
+		toolResult, err := s.CallTool("nodes_taint_blocked_pods", map[string]interface{}{"namespace": "ns-1"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("No pending pods in namespace 'ns-1' are blocked by node taints", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+}
+
+func TestTaints(t *testing.T) {
+	suite.Run(t, new(TaintsSuite))
+}
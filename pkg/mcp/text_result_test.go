@@ -6,6 +6,10 @@ import (
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/suite"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
 )
 
 type TextResultSuite struct {
@@ -31,6 +35,14 @@ func (s *TextResultSuite) TestNewTextResult() {
 		s.Require().True(ok, "expected TextContent")
 		s.Equal("connection refused", tc.Text)
 	})
+	s.Run("attaches error envelope as structured content", func() {
+		err := apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "nginx")
+		result := NewTextResult("", err)
+		s.True(result.IsError)
+		envelope, ok := result.StructuredContent.(*api.ToolCallError)
+		s.Require().True(ok, "expected api.ToolCallError envelope")
+		s.Equal(api.ToolCallErrorNotFound, envelope.Code)
+	})
 	s.Run("does not set structured content", func() {
 		result := NewTextResult("output", nil)
 		s.Nil(result.StructuredContent)
@@ -75,7 +87,7 @@ func (s *TextResultSuite) TestNewStructuredResult() {
 		s.Equal("text output", tc.Text)
 		s.Nil(result.StructuredContent)
 	})
-	s.Run("returns error result and ignores structured content", func() {
+	s.Run("returns error result and replaces structured content with the error envelope", func() {
 		err := errors.New("metrics unavailable")
 		structured := map[string]any{"should": "be ignored"}
 		result := NewStructuredResult("", structured, err)
@@ -84,7 +96,10 @@ func (s *TextResultSuite) TestNewStructuredResult() {
 		tc, ok := result.Content[0].(*mcp.TextContent)
 		s.Require().True(ok, "expected TextContent")
 		s.Equal("metrics unavailable", tc.Text)
-		s.Nil(result.StructuredContent)
+		envelope, ok := result.StructuredContent.(*api.ToolCallError)
+		s.Require().True(ok, "expected api.ToolCallError envelope")
+		s.Equal(api.ToolCallErrorInternal, envelope.Code)
+		s.Equal("metrics unavailable", envelope.Message)
 	})
 }
 
@@ -5,14 +5,21 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/config"
 	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 type ToolMutator func(tool api.ServerTool) api.ServerTool
 
+// openShiftMarkerGVKs identifies an OpenShift target by its project API.
+var openShiftMarkerGVKs = []schema.GroupVersionKind{
+	{Group: "project.openshift.io", Version: "v1", Kind: "Project"},
+}
+
 // ComposeMutators combines multiple mutators into a single mutator that applies them in order
 func ComposeMutators(mutators ...ToolMutator) ToolMutator {
 	return func(tool api.ServerTool) api.ServerTool {
@@ -65,6 +72,50 @@ func createTargetProperty(defaultCluster, targetName string) *jsonschema.Schema
 	return baseSchema
 }
 
+// TimeoutParameterName is the argument every tool accepts to bound its execution time
+const TimeoutParameterName = "timeoutSeconds"
+
+// WithTimeoutParameter adds the optional timeoutSeconds argument to every
+// tool's input schema. The dispatch layer wires the argument (or the
+// configured tool_timeout default) to the handler's context deadline, so a
+// hung API server cannot stall a session indefinitely.
+func WithTimeoutParameter() ToolMutator {
+	return func(tool api.ServerTool) api.ServerTool {
+		if tool.Tool.InputSchema == nil {
+			tool.Tool.InputSchema = &jsonschema.Schema{Type: "object"}
+		}
+
+		if tool.Tool.InputSchema.Properties == nil {
+			tool.Tool.InputSchema.Properties = make(map[string]*jsonschema.Schema)
+		}
+
+		tool.Tool.InputSchema.Properties[TimeoutParameterName] = &jsonschema.Schema{
+			Type:        "integer",
+			Description: "Optional timeout in seconds for this tool call. Overrides the server's default tool timeout",
+		}
+
+		return tool
+	}
+}
+
+// WithClusterFlavorCLI rewrites kubectl CLI hints in tool descriptions to
+// their oc equivalents when detectOpenShift reports an OpenShift target.
+// Detection runs lazily once per mutation pass, and a pass runs on every
+// applyToolsets — including the reloads WatchTargets fires when the target
+// cluster changes — so the descriptions follow the live target rather than
+// whatever was detected at registration time.
+func WithClusterFlavorCLI(detectOpenShift func() bool) ToolMutator {
+	var once sync.Once
+	var isOpenShift bool
+	return func(tool api.ServerTool) api.ServerTool {
+		once.Do(func() { isOpenShift = detectOpenShift() })
+		if isOpenShift {
+			tool.Tool.Description = strings.ReplaceAll(tool.Tool.Description, "kubectl ", "oc ")
+		}
+		return tool
+	}
+}
+
 // targetLister is a minimal interface for listing available targets.
 // This reduces coupling with the kubernetes package.
 type targetLister interface {
@@ -249,6 +249,40 @@ func TestTargetParameterToolMutator(t *testing.T) {
 	suite.Run(t, new(TargetParameterToolMutatorSuite))
 }
 
+type TimeoutParameterToolMutatorSuite struct {
+	suite.Suite
+}
+
+func (s *TimeoutParameterToolMutatorSuite) TestAddsTimeoutParameter() {
+	tm := WithTimeoutParameter()
+	tool := tm(createTestTool("any-tool"))
+	s.Require().NotNil(tool.Tool.InputSchema.Properties)
+	s.Run("adds timeoutSeconds parameter", func() {
+		s.NotNil(tool.Tool.InputSchema.Properties[TimeoutParameterName], "Expected timeoutSeconds property to be added")
+	})
+	s.Run("parameter is an integer", func() {
+		s.Equal("integer", tool.Tool.InputSchema.Properties[TimeoutParameterName].Type)
+	})
+	s.Run("adds correct description", func() {
+		desc := tool.Tool.InputSchema.Properties[TimeoutParameterName].Description
+		s.Contains(desc, "Optional timeout in seconds", "Expected description to mention the timeout")
+	})
+}
+
+func (s *TimeoutParameterToolMutatorSuite) TestNilInputSchema() {
+	tool := createTestTool("schemaless-tool")
+	tool.Tool.InputSchema = nil
+	mutated := WithTimeoutParameter()(tool)
+	s.Run("initializes the schema before adding the parameter", func() {
+		s.Require().NotNil(mutated.Tool.InputSchema)
+		s.NotNil(mutated.Tool.InputSchema.Properties[TimeoutParameterName])
+	})
+}
+
+func TestTimeoutParameterToolMutator(t *testing.T) {
+	suite.Run(t, new(TimeoutParameterToolMutatorSuite))
+}
+
 type TargetListToolMutatorSuite struct {
 	suite.Suite
 }
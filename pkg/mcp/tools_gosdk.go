@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/confirmation"
@@ -67,6 +69,19 @@ func ServerToolToGoSdkTool(s *Server, tool api.ServerTool) (*mcp.Tool, mcp.ToolH
 		// Snapshot the live configuration once so a concurrent reload
 		// can't split BaseConfig and ListOutput across two configs.
 		cfg := s.configuration.Load()
+		// Bound the handler's execution: an explicit timeoutSeconds argument
+		// wins over the configured tool_timeout default. The deadline
+		// propagates through params.Context to every Kubernetes call the
+		// handler makes.
+		timeout := cfg.ToolTimeout.Duration()
+		if seconds, ok := toolCallRequest.GetArguments()[TimeoutParameterName].(float64); ok && seconds > 0 {
+			timeout = time.Duration(seconds * float64(time.Second))
+		}
+		if timeout > 0 {
+			var cancelTimeout context.CancelFunc
+			ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+			defer cancelTimeout()
+		}
 		// Check confirmation rules before executing the tool
 		if confirmErr := confirmation.CheckToolRules(
 			ctx, cfg, &sessionElicitor{},
@@ -90,14 +105,30 @@ func ServerToolToGoSdkTool(s *Server, tool api.ServerTool) (*mcp.Tool, mcp.ToolH
 			ToolCallRequest:   toolCallRequest,
 			ListOutput:        cfg.ListOutput(),
 			Elicitor:          &sessionElicitor{},
+			OperationState:    s.operationState,
 		})
 		if err != nil {
 			return nil, err
 		}
 		if result.Error != nil {
-			mcplog.HandleK8sError(ctx, result.Error, tool.Tool.Name)
+			notifyToolError(ctx, cfg, tool.Tool.Name, result.Error)
+		}
+		s.notifyToolCallWebhooks(ctx, tool.Tool.Name, result.Error)
+		toolResult := NewStructuredResult(result.Content, result.StructuredContent, result.Error)
+		if result.Error == nil && len(result.Blob) > 0 {
+			// Binary payloads ride along as an embedded resource so the
+			// base64 blob reaches the client losslessly next to the text
+			// summary.
+			toolResult.Content = append(toolResult.Content, &mcp.EmbeddedResource{
+				Resource: &mcp.ResourceContents{
+					URI:      "mcp-blob://" + tool.Tool.Name,
+					MIMEType: result.BlobMIMEType,
+					Blob:     result.Blob,
+				},
+			})
 		}
-		return NewStructuredResult(result.Content, result.StructuredContent, result.Error), nil
+		toolResult, content := s.maybeDeltaResult(request, cfg, tool, toolCallRequest, toolResult, result.Content)
+		return s.maybeAttachResult(request, cfg, tool, toolResult, content), nil
 	}
 	return goSdkTool, goSdkHandler, nil
 }
@@ -142,3 +173,29 @@ func (ToolCallRequest *ToolCallRequest) GetString(key, defaultValue string) stri
 	}
 	return defaultValue
 }
+
+// notifyToolError emits the MCP logging notification for a failing tool call,
+// honoring any log_notifications rule configured for the tool's toolset: when
+// the rule lists error classes, only errors matching one of them notify, and
+// when it sets a level, that level replaces the classifier's default. Toolsets
+// without a rule keep the plain HandleK8sError behavior.
+func notifyToolError(ctx context.Context, cfg *Configuration, toolName string, err error) {
+	rule, ok := cfg.LogNotifications[cfg.ToolsetForTool(toolName)]
+	if !ok {
+		mcplog.HandleK8sError(ctx, err, toolName)
+		return
+	}
+	level, message, ok := mcplog.ClassifyK8sError(err, toolName)
+	if !ok {
+		return
+	}
+	if len(rule.Classes) > 0 && !slices.Contains(rule.Classes, string(api.NewToolCallError(err).Code)) {
+		return
+	}
+	if rule.Level != "" {
+		if override, ok := mcplog.ParseLevel(rule.Level); ok {
+			level = override
+		}
+	}
+	mcplog.SendMCPLog(ctx, level, message)
+}
@@ -0,0 +1,194 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	apiextensionsv1spec "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	openshiftToolset "github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift"
+)
+
+var userCRDs = []*apiextensionsv1spec.CustomResourceDefinition{
+	test.CRD("user.openshift.io", "v1", "users", "User", "user", false),
+	test.CRD("user.openshift.io", "v1", "groups", "Group", "group", false),
+	test.CRD("user.openshift.io", "v1", "identities", "Identity", "identity", false),
+}
+
+// UsersNotOpenShiftSuite runs before UsersSuite installs the user.openshift.io
+// CRDs, so the envtest cluster still looks like a plain Kubernetes cluster.
+type UsersNotOpenShiftSuite struct {
+	BaseMcpSuite
+}
+
+func (s *UsersNotOpenShiftSuite) TestUserToolsNotExposed() {
+	s.Run("user tools are filtered out on clusters without the user.openshift.io API", func() {
+		s.Cfg.Toolsets = append(s.Cfg.Toolsets, (&openshiftToolset.Toolset{}).GetName())
+		s.InitMcpClient()
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		for _, tool := range tools.Tools {
+			for _, name := range []string{"users_list", "groups_list", "groups_add_member", "groups_remove_member", "identities_list"} {
+				s.NotEqualf(name, tool.Name, "tool %s should not be exposed on a non-OpenShift cluster", name)
+			}
+		}
+	})
+}
+
+type UsersSuite struct {
+	BaseMcpSuite
+}
+
+func (s *UsersSuite) SetupSuite() {
+	_, err := envtest.InstallCRDs(test.EnvTestRestConfig(), envtest.CRDInstallOptions{CRDs: userCRDs})
+	s.Require().NoError(err)
+}
+
+func (s *UsersSuite) TearDownSuite() {
+	s.Require().NoError(envtest.UninstallCRDs(test.EnvTestRestConfig(), envtest.CRDInstallOptions{CRDs: userCRDs}))
+}
+
+func (s *UsersSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.Cfg.Toolsets = append(s.Cfg.Toolsets, (&openshiftToolset.Toolset{}).GetName())
+	s.InitMcpClient()
+}
+
+func (s *UsersSuite) userResource(resource string) dynamic.ResourceInterface {
+	return dynamic.NewForConfigOrDie(test.EnvTestRestConfig()).
+		Resource(schema.GroupVersionResource{Group: "user.openshift.io", Version: "v1", Resource: resource})
+}
+
+func (s *UsersSuite) TestUsersList() {
+	s.Run("users_list with no users", func() {
+		toolResult, err := s.CallTool("users_list", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("No users found", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("users_list with a user", func() {
+		_, err := s.userResource("users").Create(s.T().Context(), &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "user.openshift.io/v1",
+			"kind":       "User",
+			"metadata":   map[string]any{"name": "alice"},
+			"fullName":   "Alice Example",
+			"identities": []any{"htpasswd:alice"},
+		}}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create user")
+		toolResult, err := s.CallTool("users_list", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("Users (1):\n- alice (full name: Alice Example; identities: htpasswd:alice)",
+			toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+}
+
+func (s *UsersSuite) TestGroupMembership() {
+	_, err := s.userResource("groups").Create(s.T().Context(), &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "user.openshift.io/v1",
+		"kind":       "Group",
+		"metadata":   map[string]any{"name": "developers"},
+	}}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create group")
+	s.T().Cleanup(func() {
+		_ = s.userResource("groups").Delete(s.T().Context(), "developers", metav1.DeleteOptions{})
+	})
+	s.Run("groups_add_member with missing user argument returns error", func() {
+		toolResult, _ := s.CallTool("groups_add_member", map[string]interface{}{"group": "developers"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to add group member: user parameter required", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("groups_add_member with nonexistent group returns error", func() {
+		toolResult, _ := s.CallTool("groups_add_member", map[string]interface{}{"group": "not-there", "user": "bob"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Containsf(toolResult.Content[0].(*mcp.TextContent).Text, "failed to get group not-there",
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("groups_add_member adds the user", func() {
+		toolResult, err := s.CallTool("groups_add_member", map[string]interface{}{"group": "developers", "user": "bob"})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("User bob added to group developers", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("stores the membership", func() {
+			group, getErr := s.userResource("groups").Get(s.T().Context(), "developers", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get group")
+			members, _, _ := unstructured.NestedStringSlice(group.Object, "users")
+			s.Equal([]string{"bob"}, members)
+		})
+	})
+	s.Run("groups_add_member with an existing member is a no-op", func() {
+		toolResult, err := s.CallTool("groups_add_member", map[string]interface{}{"group": "developers", "user": "bob"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "an existing member is not a tool error")
+		s.Equal("User bob is already a member of group developers", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("groups_list reports the membership", func() {
+		toolResult, err := s.CallTool("groups_list", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Contains(toolResult.Content[0].(*mcp.TextContent).Text, "- developers (1 member(s): bob)")
+	})
+	s.Run("groups_remove_member removes the user", func() {
+		toolResult, err := s.CallTool("groups_remove_member", map[string]interface{}{"group": "developers", "user": "bob"})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+			s.Equal("User bob removed from group developers", toolResult.Content[0].(*mcp.TextContent).Text)
+		})
+		s.Run("clears the membership", func() {
+			group, getErr := s.userResource("groups").Get(s.T().Context(), "developers", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get group")
+			members, _, _ := unstructured.NestedStringSlice(group.Object, "users")
+			s.Empty(members)
+		})
+	})
+	s.Run("groups_remove_member with a non-member returns error", func() {
+		toolResult, _ := s.CallTool("groups_remove_member", map[string]interface{}{"group": "developers", "user": "bob"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("user bob is not a member of group developers", toolResult.Content[0].(*mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+}
+
+func (s *UsersSuite) TestIdentitiesList() {
+	s.Run("identities_list with no identities", func() {
+		toolResult, err := s.CallTool("identities_list", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("No identities found", toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+	s.Run("identities_list reports the mapped user", func() {
+		_, err := s.userResource("identities").Create(s.T().Context(), &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion":       "user.openshift.io/v1",
+			"kind":             "Identity",
+			"metadata":         map[string]any{"name": "htpasswd:carol"},
+			"providerName":     "htpasswd",
+			"providerUserName": "carol",
+			"user":             map[string]any{"name": "carol"},
+		}}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create identity")
+		toolResult, err := s.CallTool("identities_list", map[string]interface{}{})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed")
+		s.Equal("Identities (1):\n- htpasswd:carol (provider: htpasswd, provider user: carol, user: carol)",
+			toolResult.Content[0].(*mcp.TextContent).Text)
+	})
+}
+
+func TestUsersNotOpenShift(t *testing.T) {
+	suite.Run(t, new(UsersNotOpenShiftSuite))
+}
+
+func TestUsers(t *testing.T) {
+	suite.Run(t, new(UsersSuite))
+}
@@ -46,6 +46,13 @@ func classifyK8sError(err error, operation string) (Level, string, bool) {
 	return 0, "", false
 }
 
+// ClassifyK8sError exposes the level/message mapping used by HandleK8sError
+// so that callers applying operator-configured notification rules can filter
+// or override the level before sending.
+func ClassifyK8sError(err error, operation string) (Level, string, bool) {
+	return classifyK8sError(err, operation)
+}
+
 // HandleK8sError sends appropriate MCP log messages based on Kubernetes API error types.
 // operation should describe the operation (e.g., "pod access", "deployment deletion").
 func HandleK8sError(ctx context.Context, err error, operation string) {
@@ -59,6 +59,17 @@ func (l Level) String() string {
 	return "debug"
 }
 
+// ParseLevel maps an MCP protocol level string back to its Level value.
+// The second return value is false when s is not a known level.
+func ParseLevel(s string) (Level, bool) {
+	for l, str := range levelStrings {
+		if s == str {
+			return Level(l), true
+		}
+	}
+	return 0, false
+}
+
 var (
 	// mcpLogger is a dedicated named logger for MCP client-facing logs
 	// This provides complete separation from server logs
@@ -2,6 +2,7 @@ package output
 
 import (
 	"bytes"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -186,7 +187,7 @@ func MarshalYaml(v any) (string, error) {
 		for i := range t.Items {
 			t.Items[i].SetManagedFields(nil)
 		}
-		v = t.Items
+		return marshalYamlList(t.Items)
 	//case unstructured.Unstructured:
 	//	t.SetManagedFields(nil)
 	case *unstructured.Unstructured:
@@ -199,6 +200,42 @@ func MarshalYaml(v any) (string, error) {
 	return string(ret), nil
 }
 
+// marshalYamlList encodes list items one at a time into a shared buffer
+// instead of marshaling the whole slice in a single call, so peak memory for
+// large lists stays at one item's intermediate encoding plus the output. The
+// produced YAML is byte-identical to marshaling the slice at once.
+func marshalYamlList(items []unstructured.Unstructured) (string, error) {
+	if items == nil {
+		return "null\n", nil
+	}
+	if len(items) == 0 {
+		return "[]\n", nil
+	}
+	var buf bytes.Buffer
+	for i := range items {
+		itemYaml, err := yml.Marshal(&items[i])
+		if err != nil {
+			return "", err
+		}
+		// Re-indent the item under a sequence entry: "- " on the first line,
+		// two spaces on the rest (matching the yaml.v2 sequence layout).
+		for lineNumber, line := range strings.SplitAfter(string(itemYaml), "\n") {
+			if line == "" {
+				continue
+			}
+			if lineNumber == 0 {
+				buf.WriteString("- ")
+			} else if line != "\n" {
+				// Blank lines (inside block scalars) stay unindented, as the
+				// yaml encoder never emits trailing whitespace.
+				buf.WriteString("  ")
+			}
+			buf.WriteString(line)
+		}
+	}
+	return buf.String(), nil
+}
+
 func init() {
 	Names = make([]string, 0)
 	for _, output := range Outputs {
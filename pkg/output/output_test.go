@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	yml "sigs.k8s.io/yaml"
 )
 
 var podListJSON = `{
@@ -190,6 +191,39 @@ func (s *OutputSuite) TestTableToStructured() {
 	})
 }
 
+func (s *OutputSuite) TestMarshalYaml() {
+	s.Run("list output", func() {
+		s.Run("matches single-pass marshaling byte for byte", func() {
+			list := s.podList()
+			incremental, err := MarshalYaml(list)
+			s.Require().NoError(err)
+			singlePass, err := yml.Marshal(list.Items)
+			s.Require().NoError(err)
+			s.Equal(string(singlePass), incremental, "incremental list encoding should produce identical YAML")
+		})
+		s.Run("preserves multi-line string values", func() {
+			var configMapList unstructured.UnstructuredList
+			s.Require().NoError(json.Unmarshal([]byte(`{
+				"apiVersion": "v1", "kind": "ConfigMapList", "items": [{
+					"apiVersion": "v1", "kind": "ConfigMap",
+					"metadata": { "name": "scripts", "namespace": "default" },
+					"data": { "run.sh": "line1\nline2\n\nline4\n" }
+				}]
+			}`), &configMapList))
+			incremental, err := MarshalYaml(&configMapList)
+			s.Require().NoError(err)
+			singlePass, err := yml.Marshal(configMapList.Items)
+			s.Require().NoError(err)
+			s.Equal(string(singlePass), incremental, "block scalars with blank lines should survive re-indentation")
+		})
+		s.Run("empty list renders as empty sequence", func() {
+			out, err := MarshalYaml(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{}})
+			s.NoError(err)
+			s.Equal("[]\n", out)
+		})
+	})
+}
+
 func TestOutput(t *testing.T) {
 	suite.Run(t, new(OutputSuite))
 }
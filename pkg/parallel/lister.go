@@ -0,0 +1,66 @@
+// Package parallel provides a shared concurrent lister for fanning a
+// per-namespace operation out across many namespaces with bounded
+// parallelism. On clusters with hundreds of namespaces, sequential
+// per-namespace API calls dominate tool latency; this package replaces them
+// with a bounded worker pool and partial-result aggregation.
+package parallel
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultNamespaceWorkers bounds the number of concurrent per-namespace API
+// calls. The value is deliberately modest so a single tool call cannot flood
+// the API server's priority-and-fairness budget.
+const DefaultNamespaceWorkers = 8
+
+// NamespaceError records a namespace whose operation failed, so callers can
+// surface partial results (e.g. namespaces the identity cannot list due to
+// RBAC) instead of failing the whole fan-out.
+type NamespaceError struct {
+	Namespace string
+	Err       error
+}
+
+// ForEachNamespace runs fn for every namespace with at most workers
+// concurrent invocations (DefaultNamespaceWorkers when workers is not
+// positive). A failing namespace does not abort the fan-out; it is reported
+// in the returned NamespaceError slice alongside the successful results.
+// Both slices preserve the input namespace order.
+func ForEachNamespace[T any](ctx context.Context, namespaces []string, workers int, fn func(ctx context.Context, namespace string) (T, error)) ([]T, []NamespaceError) {
+	if workers <= 0 {
+		workers = DefaultNamespaceWorkers
+	}
+	results := make([]*T, len(namespaces))
+	errs := make([]error, len(namespaces))
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(workers)
+	for i, namespace := range namespaces {
+		group.Go(func() error {
+			result, err := fn(groupCtx, namespace)
+			if err != nil {
+				errs[i] = err
+				return nil
+			}
+			results[i] = &result
+			return nil
+		})
+	}
+	// The workers never return errors through the group (failures are
+	// collected per namespace), so Wait only synchronizes completion.
+	_ = group.Wait()
+
+	collected := make([]T, 0, len(namespaces))
+	var failed []NamespaceError
+	for i := range namespaces {
+		if errs[i] != nil {
+			failed = append(failed, NamespaceError{Namespace: namespaces[i], Err: errs[i]})
+			continue
+		}
+		collected = append(collected, *results[i])
+	}
+	return collected, failed
+}
@@ -0,0 +1,78 @@
+package parallel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ListerSuite struct {
+	suite.Suite
+}
+
+func (s *ListerSuite) TestForEachNamespace() {
+	s.Run("successful fan-out", func() {
+		s.Run("returns results in input namespace order", func() {
+			results, failed := ForEachNamespace(context.Background(), []string{"a", "b", "c"}, 2,
+				func(_ context.Context, namespace string) (string, error) {
+					return namespace + "-result", nil
+				})
+			s.Empty(failed, "no namespace should fail")
+			s.Equal([]string{"a-result", "b-result", "c-result"}, results, "results should preserve the input order")
+		})
+		s.Run("bounds concurrency to the worker count", func() {
+			var active, peak int64
+			var mu sync.Mutex
+			namespaces := make([]string, 32)
+			for i := range namespaces {
+				namespaces[i] = fmt.Sprintf("ns-%d", i)
+			}
+			_, failed := ForEachNamespace(context.Background(), namespaces, 4,
+				func(_ context.Context, _ string) (struct{}, error) {
+					current := atomic.AddInt64(&active, 1)
+					mu.Lock()
+					if current > peak {
+						peak = current
+					}
+					mu.Unlock()
+					atomic.AddInt64(&active, -1)
+					return struct{}{}, nil
+				})
+			s.Empty(failed, "no namespace should fail")
+			s.LessOrEqual(peak, int64(4), "no more than 4 workers should run concurrently")
+		})
+	})
+	s.Run("partial failures", func() {
+		s.Run("collects failing namespaces without aborting the fan-out", func() {
+			results, failed := ForEachNamespace(context.Background(), []string{"ok-1", "denied", "ok-2"}, 0,
+				func(_ context.Context, namespace string) (string, error) {
+					if namespace == "denied" {
+						return "", fmt.Errorf("forbidden")
+					}
+					return namespace, nil
+				})
+			s.Equal([]string{"ok-1", "ok-2"}, results, "successful namespaces should still be collected")
+			s.Require().Len(failed, 1, "exactly one namespace should fail")
+			s.Equal("denied", failed[0].Namespace, "the failing namespace should be reported")
+			s.EqualError(failed[0].Err, "forbidden", "the namespace error should be preserved")
+		})
+	})
+	s.Run("edge cases", func() {
+		s.Run("handles an empty namespace list", func() {
+			results, failed := ForEachNamespace(context.Background(), nil, 0,
+				func(_ context.Context, namespace string) (string, error) {
+					return namespace, nil
+				})
+			s.Empty(results, "no results expected for an empty namespace list")
+			s.Empty(failed, "no failures expected for an empty namespace list")
+		})
+	})
+}
+
+func TestLister(t *testing.T) {
+	suite.Run(t, new(ListerSuite))
+}
@@ -0,0 +1,96 @@
+package quay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// DefaultUrl is the API endpoint used when no url is configured.
+const DefaultUrl = "https://quay.io"
+
+// Config holds the Quay toolset configuration
+type Config struct {
+	// Url is the base URL of the Quay registry API (default: https://quay.io).
+	Url string `toml:"url,omitempty"`
+	// Organization is the Quay organization (or user namespace) the tools
+	// operate on.
+	Organization string `toml:"organization"`
+	// ApiTokenFile points at a file containing a Quay OAuth access token.
+	// Listing tags of public repositories works without it; pruning tags
+	// always requires a token with repository write permission.
+	ApiTokenFile         string `toml:"api_token_file,omitempty"`
+	Insecure             bool   `toml:"insecure,omitempty"`
+	CertificateAuthority string `toml:"certificate_authority,omitempty"`
+}
+
+var _ api.ExtendedConfig = (*Config)(nil)
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return errors.New("quay config is nil")
+	}
+	if c.Organization == "" {
+		return errors.New("organization is required")
+	}
+	if c.Url != "" {
+		if u, err := url.Parse(c.Url); err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New("url must be a valid URL")
+		}
+	}
+	// Validate that certificate_authority is a valid file
+	if caValue := strings.TrimSpace(c.CertificateAuthority); caValue != "" {
+		if _, err := os.Stat(caValue); err != nil {
+			return fmt.Errorf("certificate_authority must be a valid file path: %w", err)
+		}
+	}
+	if tokenFile := strings.TrimSpace(c.ApiTokenFile); tokenFile != "" {
+		if _, err := os.Stat(tokenFile); err != nil {
+			return fmt.Errorf("api_token_file must be a valid file path: %w", err)
+		}
+	}
+	return nil
+}
+
+func quayToolsetParser(ctx context.Context, primitive toml.Primitive, md toml.MetaData) (api.ExtendedConfig, error) {
+	var cfg Config
+	if err := md.PrimitiveDecode(primitive, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Url == "" {
+		cfg.Url = DefaultUrl
+	}
+
+	// Resolve file paths relative to the config directory when they are not absolute
+	configDir := config.ConfigDirPathFromContext(ctx)
+	if cfg.CertificateAuthority != "" && configDir != "" && !filepath.IsAbs(cfg.CertificateAuthority) {
+		cfg.CertificateAuthority = filepath.Join(configDir, cfg.CertificateAuthority)
+	}
+	if cfg.ApiTokenFile != "" && configDir != "" && !filepath.IsAbs(cfg.ApiTokenFile) {
+		cfg.ApiTokenFile = filepath.Join(configDir, cfg.ApiTokenFile)
+	}
+
+	// Validate TLS settings when require_tls is enabled
+	if config.RequireTLSFromContext(ctx) {
+		if err := config.ValidateURLRequiresTLS(cfg.Url, "Quay URL"); err != nil {
+			return nil, err
+		}
+		if cfg.Insecure {
+			return nil, errors.New("require_tls is enabled but Quay insecure=true disables certificate verification")
+		}
+	}
+
+	return &cfg, nil
+}
+
+func init() {
+	config.RegisterToolsetConfig("quay", quayToolsetParser)
+}
@@ -0,0 +1,92 @@
+package quay
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigSuite struct {
+	suite.Suite
+	tempDir   string
+	tokenFile string
+}
+
+func (s *ConfigSuite) SetupTest() {
+	// Create a test API token file
+	s.tempDir = s.T().TempDir()
+	s.tokenFile = filepath.Join(s.tempDir, "token")
+	s.Require().NoError(os.WriteFile(s.tokenFile, []byte("test-token"), 0600), "Failed to write token file")
+}
+
+func (s *ConfigSuite) TestConfigParser_DefaultsUrl() {
+	cfg := test.Must(config.ReadToml([]byte(`
+		[toolset_configs.quay]
+		organization = "my-org"
+	`)))
+
+	quayCfg, ok := cfg.GetToolsetConfig("quay")
+	s.Require().True(ok, "Quay config should be present")
+	qcfg, ok := quayCfg.(*Config)
+	s.Require().True(ok, "Quay config should be of type *Config")
+	s.Equal(DefaultUrl, qcfg.Url, "Url should default to quay.io when not provided")
+}
+
+func (s *ConfigSuite) TestConfigParser_ResolvesRelativeTokenFile() {
+	cfg := test.Must(config.ReadToml([]byte(`
+		[toolset_configs.quay]
+		organization = "my-org"
+		api_token_file = "token"
+	`), config.WithDirPath(s.tempDir)))
+
+	quayCfg, ok := cfg.GetToolsetConfig("quay")
+	s.Require().True(ok, "Quay config should be present")
+	qcfg, ok := quayCfg.(*Config)
+	s.Require().True(ok, "Quay config should be of type *Config")
+	s.Equal(s.tokenFile, qcfg.ApiTokenFile, "Relative api_token_file should be resolved to absolute path")
+}
+
+func (s *ConfigSuite) TestValidate() {
+	s.Run("nil config returns error", func() {
+		var cfg *Config
+		err := cfg.Validate()
+		s.Error(err, "Expected error for nil config")
+		s.ErrorContains(err, "quay config is nil")
+	})
+	s.Run("missing organization returns error", func() {
+		cfg := &Config{Url: DefaultUrl}
+		err := cfg.Validate()
+		s.Error(err, "Expected error for missing organization")
+		s.ErrorContains(err, "organization is required")
+	})
+	s.Run("invalid URL returns error", func() {
+		cfg := &Config{Url: "://bad-url", Organization: "my-org"}
+		err := cfg.Validate()
+		s.Error(err, "Expected error for invalid URL")
+		s.ErrorContains(err, "url must be a valid URL")
+	})
+	s.Run("organization without URL passes", func() {
+		cfg := &Config{Organization: "my-org"}
+		err := cfg.Validate()
+		s.NoError(err, "Organization-only config should pass validation")
+	})
+	s.Run("invalid api_token_file returns error", func() {
+		cfg := &Config{Organization: "my-org", ApiTokenFile: filepath.Join(s.tempDir, "non-existent")}
+		err := cfg.Validate()
+		s.Error(err, "Expected error for non-existent token file")
+		s.ErrorContains(err, "api_token_file must be a valid file path")
+	})
+	s.Run("valid api_token_file passes", func() {
+		cfg := &Config{Organization: "my-org", ApiTokenFile: s.tokenFile}
+		err := cfg.Validate()
+		s.NoError(err, "Valid api_token_file should pass validation")
+	})
+}
+
+func TestConfig(t *testing.T) {
+	suite.Run(t, new(ConfigSuite))
+}
@@ -0,0 +1,220 @@
+package quay
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+	"github.com/containers/kubernetes-mcp-server/pkg/tlsutil"
+)
+
+// Quay is a client for the Quay registry API.
+type Quay struct {
+	quayURL              string
+	organization         string
+	apiTokenFile         string
+	insecure             bool
+	certificateAuthority string
+	tlsMinVersion        string
+	tlsCipherSuites      []string
+	requireTLS           func() bool
+}
+
+// Tag is the subset of a Quay repository tag the tools care about.
+type Tag struct {
+	Name           string `json:"name"`
+	ManifestDigest string `json:"manifest_digest"`
+	LastModified   string `json:"last_modified"`
+	StartTs        int64  `json:"start_ts"`
+	Size           int64  `json:"size"`
+	Expiration     string `json:"expiration,omitempty"`
+}
+
+// NewQuay creates a new Quay client from the toolset configuration.
+func NewQuay(configProvider api.BaseConfig) *Quay {
+	quay := &Quay{
+		tlsMinVersion:   configProvider.GetTLSMinVersionConfig(),
+		tlsCipherSuites: configProvider.GetTLSCipherSuitesConfig(),
+		requireTLS:      configProvider.IsRequireTLS,
+	}
+	if cfg, ok := configProvider.GetToolsetConfig("quay"); ok {
+		if qc, ok := cfg.(*Config); ok && qc != nil {
+			quay.quayURL = qc.Url
+			quay.organization = qc.Organization
+			quay.apiTokenFile = qc.ApiTokenFile
+			quay.insecure = qc.Insecure
+			quay.certificateAuthority = qc.CertificateAuthority
+		}
+	}
+	return quay
+}
+
+// Organization returns the configured Quay organization.
+func (q *Quay) Organization() string {
+	return q.organization
+}
+
+// ListTags returns the active tags of repository (without the organization
+// prefix), optionally restricted to a single tag name.
+func (q *Quay) ListTags(ctx context.Context, repository, specificTag string) ([]Tag, error) {
+	query := url.Values{"onlyActiveTags": []string{"true"}, "limit": []string{"100"}}
+	if specificTag != "" {
+		query.Set("specificTag", specificTag)
+	}
+	body, err := q.executeRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/repository/%s/%s/tag/", q.organization, repository), query)
+	if err != nil {
+		return nil, err
+	}
+	var response struct {
+		Tags []Tag `json:"tags"`
+	}
+	if err := json.Unmarshal([]byte(body), &response); err != nil {
+		return nil, fmt.Errorf("failed to parse Quay tag list: %w", err)
+	}
+	return response.Tags, nil
+}
+
+// ManifestSecurity returns the raw security scan report of a manifest.
+func (q *Quay) ManifestSecurity(ctx context.Context, repository, manifestDigest string) (string, error) {
+	query := url.Values{"vulnerabilities": []string{"true"}}
+	return q.executeRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/repository/%s/%s/manifest/%s/security", q.organization, repository, manifestDigest), query)
+}
+
+// DeleteTag deletes a tag from repository.
+func (q *Quay) DeleteTag(ctx context.Context, repository, tag string) error {
+	_, err := q.executeRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/repository/%s/%s/tag/%s", q.organization, repository, tag), nil)
+	return err
+}
+
+func (q *Quay) validateAndGetURL(endpoint string, query url.Values) (string, error) {
+	if q == nil || q.quayURL == "" || q.organization == "" {
+		return "", fmt.Errorf("quay is not configured; set organization in the [toolset_configs.quay] section")
+	}
+	resultURL, err := url.JoinPath(strings.TrimSpace(q.quayURL), endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to join Quay URL with endpoint path: %w", err)
+	}
+	u, err := url.Parse(resultURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid Quay URL: %w", err)
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func (q *Quay) createHTTPClient(ctx context.Context) (*http.Client, error) {
+	logger := klogutil.FromContext(ctx)
+	var tlsOpts []tlsutil.TLSConfigOption
+
+	if q.insecure {
+		tlsOpts = append(tlsOpts, tlsutil.WithInsecureSkipVerify(true))
+	}
+
+	if caValue := strings.TrimSpace(q.certificateAuthority); caValue != "" {
+		caPEM, err := os.ReadFile(caValue)
+		if err != nil {
+			logger.Error(err, "failed to read CA certificate from file, proceeding without custom CA", "ca_file", caValue)
+		} else {
+			// Start with the host system pool when possible so we don't drop system roots
+			var certPool *x509.CertPool
+			if systemPool, err := x509.SystemCertPool(); err == nil && systemPool != nil {
+				certPool = systemPool
+			} else {
+				certPool = x509.NewCertPool()
+			}
+			if ok := certPool.AppendCertsFromPEM(caPEM); ok {
+				tlsOpts = append(tlsOpts, tlsutil.WithRootCAs(certPool))
+			} else {
+				logger.V(0).Info("failed to append provided certificate authority; proceeding without custom CA")
+			}
+		}
+	}
+
+	tlsConfig, err := tlsutil.BuildTLSConfig(q.tlsMinVersion, q.tlsCipherSuites, tlsOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build TLS config: %w", err)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	if q.requireTLS == nil {
+		return client, nil
+	}
+	return config.NewTLSEnforcingClient(client, q.requireTLS), nil
+}
+
+// authorizationHeader returns the Authorization header from api_token_file,
+// or empty when no token is configured (anonymous access to public
+// repositories).
+func (q *Quay) authorizationHeader() (string, error) {
+	tokenFile := strings.TrimSpace(q.apiTokenFile)
+	if tokenFile == "" {
+		return "", nil
+	}
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read Quay API token file: %w", err)
+	}
+	return "Bearer " + strings.TrimSpace(string(token)), nil
+}
+
+// maxResponseBodySize is the maximum number of bytes read from a Quay API
+// response. Responses exceeding this limit are truncated to prevent unbounded
+// memory consumption from a misbehaving or compromised upstream server.
+const maxResponseBodySize = 512 << 10 // 512 KiB
+
+func (q *Quay) executeRequest(ctx context.Context, method, endpoint string, query url.Values) (string, error) {
+	apiCallURL, err := q.validateAndGetURL(endpoint, query)
+	if err != nil {
+		return "", err
+	}
+
+	klogutil.FromContext(ctx).V(0).Info("quay API call", "http.request.method", method, "url.full", apiCallURL)
+	req, err := http.NewRequestWithContext(ctx, method, apiCallURL, nil)
+	if err != nil {
+		return "", err
+	}
+	authHeader, err := q.authorizationHeader()
+	if err != nil {
+		return "", err
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+	req.Header.Set("Accept", "application/json")
+	client, err := q.createHTTPClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+	if int64(len(respBody)) > maxResponseBodySize {
+		return "", fmt.Errorf("quay API response exceeded maximum allowed size of %d bytes", maxResponseBodySize)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if len(respBody) > 0 {
+			return "", fmt.Errorf("quay API error: %s", strings.TrimSpace(string(respBody)))
+		}
+		return "", fmt.Errorf("quay API error: status %d", resp.StatusCode)
+	}
+	return string(respBody), nil
+}
@@ -0,0 +1,147 @@
+package statestore
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// configMapStore persists entries in a single ConfigMap's binaryData so
+// operation state survives a server restart and is shared between HA
+// replicas. Store keys are base64url-encoded to satisfy the ConfigMap key
+// character set; values are stored verbatim.
+type configMapStore struct {
+	params FactoryParams
+}
+
+var _ Store = (*configMapStore)(nil)
+
+func newConfigMapStore(params FactoryParams) (Store, error) {
+	if params.CoreV1Client == nil {
+		return nil, fmt.Errorf("configmap state store requires a Kubernetes client")
+	}
+	return &configMapStore{params: params}, nil
+}
+
+func (s *configMapStore) Put(ctx context.Context, key string, value []byte) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		client, err := s.params.CoreV1Client(ctx)
+		if err != nil {
+			return err
+		}
+		configMaps := client.ConfigMaps(s.params.Config.ConfigMapNamespace)
+		configMap, err := configMaps.Get(ctx, s.params.Config.ConfigMapName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      s.params.Config.ConfigMapName,
+					Namespace: s.params.Config.ConfigMapNamespace,
+				},
+				BinaryData: map[string][]byte{encodeKey(key): value},
+			}
+			_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if configMap.BinaryData == nil {
+			configMap.BinaryData = map[string][]byte{}
+		}
+		configMap.BinaryData[encodeKey(key)] = value
+		_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (s *configMapStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	configMap, err := s.getConfigMap(ctx)
+	if apierrors.IsNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	value, ok := configMap.BinaryData[encodeKey(key)]
+	return value, ok, nil
+}
+
+func (s *configMapStore) Delete(ctx context.Context, key string) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		client, err := s.params.CoreV1Client(ctx)
+		if err != nil {
+			return err
+		}
+		configMaps := client.ConfigMaps(s.params.Config.ConfigMapNamespace)
+		configMap, err := configMaps.Get(ctx, s.params.Config.ConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if _, ok := configMap.BinaryData[encodeKey(key)]; !ok {
+			return nil
+		}
+		delete(configMap.BinaryData, encodeKey(key))
+		_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *configMapStore) Keys(ctx context.Context, prefix string) ([]string, error) {
+	configMap, err := s.getConfigMap(ctx)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(configMap.BinaryData))
+	for encoded := range configMap.BinaryData {
+		key, decodeErr := decodeKey(encoded)
+		if decodeErr != nil {
+			// Foreign entries in a shared ConfigMap are not ours to report.
+			continue
+		}
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func (s *configMapStore) Close() error {
+	return nil
+}
+
+func (s *configMapStore) getConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	client, err := s.params.CoreV1Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.ConfigMaps(s.params.Config.ConfigMapNamespace).Get(ctx, s.params.Config.ConfigMapName, metav1.GetOptions{})
+}
+
+// encodeKey maps an arbitrary store key onto the ConfigMap key character set
+// ([-._a-zA-Z0-9]) via base64url.
+func encodeKey(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodeKey(encoded string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
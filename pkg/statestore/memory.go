@@ -0,0 +1,62 @@
+package statestore
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// memoryStore is the default in-process backend. State is lost on restart,
+// matching the behavior the server had before persistent backends existed.
+type memoryStore struct {
+	mu      sync.RWMutex
+	entries map[string][]byte
+}
+
+var _ Store = (*memoryStore)(nil)
+
+func newMemoryStore(_ FactoryParams) (Store, error) {
+	return &memoryStore{entries: map[string][]byte{}}, nil
+}
+
+func (s *memoryStore) Put(_ context.Context, key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = slices.Clone(value)
+	return nil
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return slices.Clone(value), true, nil
+}
+
+func (s *memoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+func (s *memoryStore) Keys(_ context.Context, prefix string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]string, 0, len(s.entries))
+	for key := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
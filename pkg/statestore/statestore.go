@@ -0,0 +1,94 @@
+// Package statestore provides a pluggable key-value store for long-running
+// operation state (plan identifiers, watch cursors, collected must-gather
+// chunks). The default memory backend keeps state in-process; backends that
+// persist outside the process (e.g. the configmap backend) keep in-progress
+// workflows resumable across a server restart or an HA replica switch.
+// Additional backends (e.g. Redis) can be plugged in via RegisterBackend.
+package statestore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// Store is the interface every backend implements; it lives in the api
+// package so tool handlers can consume it without importing this one.
+type Store = api.OperationStateStore
+
+// FactoryParams carries the dependencies a backend factory may need.
+type FactoryParams struct {
+	// Config is the state_store section of the static configuration.
+	Config config.StateStoreConfig
+	// CoreV1Client returns a CoreV1 client for cluster-backed stores. It is
+	// invoked per operation rather than once at construction so backends
+	// keep working across credential rotation and stay usable even when no
+	// cluster credentials are available at server startup.
+	CoreV1Client func(ctx context.Context) (corev1client.CoreV1Interface, error)
+}
+
+// Factory creates a Store from the given parameters.
+type Factory func(params FactoryParams) (Store, error)
+
+var backendRegistry = &backendFactoryRegistry{factories: map[string]Factory{}}
+
+func init() {
+	RegisterBackend(config.StateStoreBackendMemory, newMemoryStore)
+	RegisterBackend(config.StateStoreBackendConfigMap, newConfigMapStore)
+}
+
+// RegisterBackend registers a backend factory under the given name.
+// Registering the same name twice panics.
+func RegisterBackend(backend string, factory Factory) {
+	backendRegistry.register(backend, factory)
+}
+
+// GetRegisteredBackends returns a sorted list of all registered backend
+// names. Useful for config validation and error messages.
+func GetRegisteredBackends() []string {
+	return backendRegistry.backends()
+}
+
+// New creates a Store for the backend selected in params.Config. An empty
+// backend selects the memory backend.
+func New(params FactoryParams) (Store, error) {
+	backend := params.Config.Backend
+	if backend == "" {
+		backend = config.StateStoreBackendMemory
+	}
+	factory, ok := backendRegistry.get(backend)
+	if !ok {
+		return nil, fmt.Errorf("invalid state_store backend %q, must be one of: %v", backend, GetRegisteredBackends())
+	}
+	return factory(params)
+}
+
+type backendFactoryRegistry struct {
+	factories map[string]Factory
+}
+
+func (r *backendFactoryRegistry) register(backend string, factory Factory) {
+	if _, exists := r.factories[backend]; exists {
+		panic("state store backend already registered: " + backend)
+	}
+	r.factories[backend] = factory
+}
+
+func (r *backendFactoryRegistry) get(backend string) (Factory, bool) {
+	factory, ok := r.factories[backend]
+	return factory, ok
+}
+
+func (r *backendFactoryRegistry) backends() []string {
+	backends := make([]string, 0, len(r.factories))
+	for backend := range r.factories {
+		backends = append(backends, backend)
+	}
+	slices.Sort(backends)
+	return backends
+}
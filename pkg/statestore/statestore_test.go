@@ -0,0 +1,152 @@
+package statestore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+type StateStoreSuite struct {
+	suite.Suite
+}
+
+// newConfigMapStoreForTest returns a configmap-backed store wired to a fake
+// clientset so the ConfigMap round-trips can be exercised without a cluster.
+func (s *StateStoreSuite) newConfigMapStoreForTest() Store {
+	clientset := fake.NewSimpleClientset()
+	store, err := New(FactoryParams{
+		Config: config.StateStoreConfig{
+			Backend:            config.StateStoreBackendConfigMap,
+			ConfigMapName:      "operation-state",
+			ConfigMapNamespace: "default",
+		},
+		CoreV1Client: func(_ context.Context) (corev1client.CoreV1Interface, error) {
+			return clientset.CoreV1(), nil
+		},
+	})
+	s.Require().NoError(err, "Expected to create configmap store")
+	return store
+}
+
+func (s *StateStoreSuite) TestNew() {
+	s.Run("empty backend selects memory", func() {
+		store, err := New(FactoryParams{})
+		s.Require().NoError(err)
+		s.IsType(&memoryStore{}, store, "empty backend should select the memory store")
+	})
+	s.Run("invalid backend is rejected with the registered backends", func() {
+		_, err := New(FactoryParams{Config: config.StateStoreConfig{Backend: "i-do-not-exist"}})
+		s.Require().Error(err)
+		s.Contains(err.Error(), "invalid state_store backend", "error should name the invalid backend")
+		s.Contains(err.Error(), config.StateStoreBackendConfigMap, "error should list registered backends")
+	})
+	s.Run("configmap backend requires a client", func() {
+		_, err := New(FactoryParams{Config: config.StateStoreConfig{Backend: config.StateStoreBackendConfigMap}})
+		s.Error(err, "configmap backend without a client should fail")
+	})
+}
+
+func (s *StateStoreSuite) TestStoreBehavior() {
+	backends := map[string]func() Store{
+		"memory": func() Store {
+			store, err := New(FactoryParams{})
+			s.Require().NoError(err)
+			return store
+		},
+		"configmap": s.newConfigMapStoreForTest,
+	}
+	for name, newStore := range backends {
+		s.Run(name+" backend", func() {
+			s.Run("round-trips a value", func() {
+				store := newStore()
+				s.Require().NoError(store.Put(s.T().Context(), "plan/1", []byte("state")))
+				value, found, err := store.Get(s.T().Context(), "plan/1")
+				s.Require().NoError(err)
+				s.Require().True(found, "stored key should be found")
+				s.Equal("state", string(value))
+			})
+			s.Run("overwrites on repeated put", func() {
+				store := newStore()
+				s.Require().NoError(store.Put(s.T().Context(), "cursor", []byte("old")))
+				s.Require().NoError(store.Put(s.T().Context(), "cursor", []byte("new")))
+				value, _, err := store.Get(s.T().Context(), "cursor")
+				s.Require().NoError(err)
+				s.Equal("new", string(value))
+			})
+			s.Run("reports absent keys as not found", func() {
+				store := newStore()
+				_, found, err := store.Get(s.T().Context(), "absent")
+				s.Require().NoError(err)
+				s.False(found, "absent key should not be found")
+			})
+			s.Run("delete removes the key", func() {
+				store := newStore()
+				s.Require().NoError(store.Put(s.T().Context(), "chunk/0", []byte("data")))
+				s.Require().NoError(store.Delete(s.T().Context(), "chunk/0"))
+				_, found, err := store.Get(s.T().Context(), "chunk/0")
+				s.Require().NoError(err)
+				s.False(found, "deleted key should not be found")
+			})
+			s.Run("delete of an absent key is not an error", func() {
+				store := newStore()
+				s.NoError(store.Delete(s.T().Context(), "absent"))
+			})
+			s.Run("keys filters by prefix and sorts", func() {
+				store := newStore()
+				s.Require().NoError(store.Put(s.T().Context(), "chunk/1", []byte("b")))
+				s.Require().NoError(store.Put(s.T().Context(), "chunk/0", []byte("a")))
+				s.Require().NoError(store.Put(s.T().Context(), "plan/1", []byte("c")))
+				keys, err := store.Keys(s.T().Context(), "chunk/")
+				s.Require().NoError(err)
+				s.Equal([]string{"chunk/0", "chunk/1"}, keys)
+			})
+			s.Run("keys on an empty store is empty", func() {
+				store := newStore()
+				keys, err := store.Keys(s.T().Context(), "")
+				s.Require().NoError(err)
+				s.Empty(keys)
+			})
+			s.Run("close succeeds", func() {
+				store := newStore()
+				s.NoError(store.Close())
+			})
+		})
+	}
+}
+
+func (s *StateStoreSuite) TestConfigMapPersistence() {
+	s.Run("state written by one store is visible to another sharing the ConfigMap", func() {
+		clientset := fake.NewSimpleClientset()
+		newStore := func() Store {
+			store, err := New(FactoryParams{
+				Config: config.StateStoreConfig{
+					Backend:            config.StateStoreBackendConfigMap,
+					ConfigMapName:      "operation-state",
+					ConfigMapNamespace: "default",
+				},
+				CoreV1Client: func(_ context.Context) (corev1client.CoreV1Interface, error) {
+					return clientset.CoreV1(), nil
+				},
+			})
+			s.Require().NoError(err)
+			return store
+		}
+		first := newStore()
+		s.Require().NoError(first.Put(s.T().Context(), "mustgather/chunks", []byte("3")))
+		s.Require().NoError(first.Close())
+		second := newStore()
+		value, found, err := second.Get(s.T().Context(), "mustgather/chunks")
+		s.Require().NoError(err)
+		s.Require().True(found, "replica should see state persisted by the first store")
+		s.Equal("3", string(value))
+	})
+}
+
+func TestStateStore(t *testing.T) {
+	suite.Run(t, new(StateStoreSuite))
+}
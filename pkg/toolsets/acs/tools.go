@@ -0,0 +1,181 @@
+package acs
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	acsclient "github.com/containers/kubernetes-mcp-server/pkg/acs"
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// Central API endpoints used by the tools.
+const (
+	alertsEndpoint      = "/v1/alerts"
+	deploymentsEndpoint = "/v1/deploymentswithprocessinfo"
+	imagesEndpoint      = "/v1/images"
+)
+
+func initViolationsList() []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "acs_violations_list",
+			Description: "List the active security policy violations reported by ACS Central, optionally scoped to a namespace and deployment. " +
+				"Each violation references the policy that fired, its severity, and the affected deployment",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Only show violations for workloads in this namespace (Optional)",
+					},
+					"deployment": {
+						Type:        "string",
+						Description: "Only show violations for this deployment (Optional)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ACS: Violations List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler: violationsList,
+	}}
+}
+
+func violationsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	query := scopedQuery(p.OptionalString("namespace", ""), p.OptionalString("deployment", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ACS violations: %w", err)), nil
+	}
+	acs := acsclient.NewACS(params, params.RESTConfig())
+	content, err := acs.ExecuteRequest(params.Context, alertsEndpoint, query)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ACS violations: %w", err)), nil
+	}
+	return api.NewToolCallResult(content, nil), nil
+}
+
+func initDeploymentsRisk() []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "acs_deployments_risk",
+			Description: "List the deployments known to ACS Central with their risk assessment, optionally scoped to a namespace. " +
+				"The priority field ranks deployments by risk: priority 1 is the riskiest deployment in the cluster",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Only show deployments in this namespace (Optional)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ACS: Deployments Risk",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler: deploymentsRisk,
+	}}
+}
+
+func deploymentsRisk(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	query := scopedQuery(p.OptionalString("namespace", ""), "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ACS deployment risk: %w", err)), nil
+	}
+	acs := acsclient.NewACS(params, params.RESTConfig())
+	content, err := acs.ExecuteRequest(params.Context, deploymentsEndpoint, query)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ACS deployment risk: %w", err)), nil
+	}
+	return api.NewToolCallResult(content, nil), nil
+}
+
+func initVulnerabilitiesList() []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "acs_vulnerabilities_list",
+			Description: "List the images scanned by ACS Central with their CVE counts, optionally scoped to the workloads of a namespace or deployment " +
+				"and optionally restricted to fixable CVEs",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Only show images used by workloads in this namespace (Optional)",
+					},
+					"deployment": {
+						Type:        "string",
+						Description: "Only show images used by this deployment (Optional)",
+					},
+					"fixableOnly": {
+						Type:        "boolean",
+						Description: "Only show images with fixable CVEs (Optional, default: false)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ACS: Vulnerabilities List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler: vulnerabilitiesList,
+	}}
+}
+
+func vulnerabilitiesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	deployment := p.OptionalString("deployment", "")
+	fixableOnly := p.OptionalBool("fixableOnly", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ACS vulnerabilities: %w", err)), nil
+	}
+	query := scopedQuery(namespace, deployment)
+	if fixableOnly {
+		appendSearchTerm(query, "Fixable:true")
+	}
+	acs := acsclient.NewACS(params, params.RESTConfig())
+	content, err := acs.ExecuteRequest(params.Context, imagesEndpoint, query)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ACS vulnerabilities: %w", err)), nil
+	}
+	return api.NewToolCallResult(content, nil), nil
+}
+
+// scopedQuery builds the Central search query parameter from the optional
+// namespace and deployment scope, using the ACS search syntax
+// (e.g. "Namespace:foo+Deployment:bar").
+func scopedQuery(namespace, deployment string) url.Values {
+	query := url.Values{}
+	if namespace != "" {
+		appendSearchTerm(query, "Namespace:"+namespace)
+	}
+	if deployment != "" {
+		appendSearchTerm(query, "Deployment:"+deployment)
+	}
+	return query
+}
+
+func appendSearchTerm(query url.Values, term string) {
+	if existing := query.Get("query"); existing != "" {
+		term = existing + "+" + term
+	}
+	query.Set("query", term)
+}
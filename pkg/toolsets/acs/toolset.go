@@ -0,0 +1,52 @@
+package acs
+
+import (
+	"slices"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "acs"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "Red Hat Advanced Cluster Security (StackRox) tools for policy violations, deployment risk, and image vulnerabilities"
+}
+
+func (t *Toolset) GetTools(_ api.FilteringProvider) []api.ServerTool {
+	tools := slices.Concat(
+		initViolationsList(),
+		initDeploymentsRisk(),
+		initVulnerabilitiesList(),
+	)
+	// ACS calls a single configured Central endpoint; findings are not scoped
+	// via the provider-level context parameter injected for core Kubernetes tools.
+	for i := range tools {
+		tools[i].ClusterAware = ptr.To(false)
+	}
+	return tools
+}
+
+func (t *Toolset) GetPrompts() []api.ServerPrompt {
+	return nil
+}
+
+func (t *Toolset) GetResources() []api.ServerResource {
+	return nil
+}
+
+func (t *Toolset) GetResourceTemplates() []api.ServerResourceTemplate {
+	return nil
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}
@@ -0,0 +1,43 @@
+package bench
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// Config holds the bench toolset configuration. Enabling the toolset in the
+// toolsets list is not enough to generate load: bench_run also requires
+// enabled = true here, so load tests are always a double opt-in.
+type Config struct {
+	// Enabled must be set to true for bench_run to start a load test. Listing
+	// the toolset without it exposes the tools but makes every run refuse.
+	Enabled bool `toml:"enabled,omitempty"`
+	// AllowedNamespaces restricts where the load generator Job may run. Empty
+	// (the default) allows every namespace.
+	AllowedNamespaces []string `toml:"allowed_namespaces,omitempty"`
+}
+
+var _ api.ExtendedConfig = (*Config)(nil)
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return errors.New("bench config is nil")
+	}
+	return nil
+}
+
+func benchToolsetParser(_ context.Context, primitive toml.Primitive, md toml.MetaData) (api.ExtendedConfig, error) {
+	var cfg Config
+	if err := md.PrimitiveDecode(primitive, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func init() {
+	config.RegisterToolsetConfig("bench", benchToolsetParser)
+}
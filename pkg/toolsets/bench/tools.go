@@ -0,0 +1,342 @@
+package bench
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/version"
+)
+
+const (
+	// defaultLoadImage is the load generator image when none is provided. It
+	// must provide sh and curl.
+	defaultLoadImage = "curlimages/curl:latest"
+	// maxBenchSeconds bounds how long a load test runs.
+	maxBenchSeconds = 300
+	// maxBenchConcurrency bounds how many parallel workers generate load.
+	maxBenchConcurrency = 50
+	// benchComponent labels every object the bench tools create, so cleanup
+	// can verify it only ever deletes its own Jobs.
+	benchComponent = "bench"
+)
+
+// loadTestConfig enforces the double opt-in: the bench toolset config must
+// exist with enabled = true, and the load generator Job must run in an
+// allowed namespace. bench_run calls it before creating any traffic;
+// bench_results and bench_cleanup stay available so finished runs can always
+// be inspected and deleted.
+func loadTestConfig(params api.ToolHandlerParams, namespace string) (*Config, error) {
+	extended, ok := params.GetToolsetConfig("bench")
+	cfg, valid := extended.(*Config)
+	if !ok || !valid || !cfg.Enabled {
+		return nil, errors.New("load tests are disabled: set enabled = true under [toolset_configs.bench] to opt in")
+	}
+	if namespace != "" && len(cfg.AllowedNamespaces) > 0 && !slices.Contains(cfg.AllowedNamespaces, namespace) {
+		return nil, fmt.Errorf("namespace %s is not listed in allowed_namespaces for load tests", namespace)
+	}
+	return cfg, nil
+}
+
+func initBenchRun() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "bench_run",
+			Description: "Start a short-lived in-cluster load generator Job against a URL (a Route host or a Service DNS name) and report the Job to query with bench_results. " +
+				"Only target services you own: this generates real traffic. " +
+				"Refuses to run unless load tests are explicitly enabled in the configuration",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"url": {
+						Type:        "string",
+						Description: "URL to load test (e.g. https://frontend-demo.apps.example.com/ or http://frontend.demo-shop.svc:8080/healthz)",
+					},
+					"seconds": {
+						Type:        "integer",
+						Description: fmt.Sprintf("Duration of the load test (Optional, defaults to 30, maximum %d)", maxBenchSeconds),
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(float64(maxBenchSeconds)),
+					},
+					"concurrency": {
+						Type:        "integer",
+						Description: fmt.Sprintf("Number of parallel workers (Optional, defaults to 5, maximum %d)", maxBenchConcurrency),
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(float64(maxBenchConcurrency)),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to run the load generator Job in (Optional, defaults to the configured namespace)",
+					},
+					"image": {
+						Type:        "string",
+						Description: "Load generator image providing sh and curl (Optional, defaults to " + defaultLoadImage + ")",
+					},
+				},
+				Required: []string{"url"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Bench: Run",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: benchRun},
+	}
+}
+
+func initBenchResults() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "bench_results",
+			Description: "Fetch the latency and error summary of a load test started with bench_run (request count, error count, avg/p50/p95/max latency)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the load test Job reported by bench_run",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the load test Job (Optional, defaults to the configured namespace)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Bench: Results",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: benchResults},
+	}
+}
+
+func initBenchCleanup() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "bench_cleanup",
+			Description: "Delete a load test Job created by bench_run along with its pods. Refuses to delete Jobs it did not create",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the load test Job reported by bench_run",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the load test Job (Optional, defaults to the configured namespace)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Bench: Cleanup",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: benchCleanup},
+	}
+}
+
+// loadScript renders the shell script the load generator runs: concurrency
+// workers hammer the URL with curl until the deadline, then the results are
+// merged into BENCH_* marker lines that bench_results parses from the pod
+// log. Latencies come from curl's time_total; only the summary depends on
+// POSIX sh, wc, sort, sed, awk, and tail, all present in busybox.
+func loadScript(target string, seconds, concurrency int64) string {
+	return fmt.Sprintf(`end=$(( $(date +%%s) + %d ))
+i=1
+while [ $i -le %d ]; do
+  ( while [ $(date +%%s) -lt $end ]; do
+      curl -k -o /dev/null -s --max-time 10 -w "%%{http_code} %%{time_total}\n" '%s' >> /tmp/results.$i
+    done ) &
+  i=$((i+1))
+done
+wait
+cat /tmp/results.* > /tmp/all 2>/dev/null || touch /tmp/all
+total=$(wc -l < /tmp/all)
+errors=$(awk '$1<200||$1>=400' /tmp/all | wc -l)
+awk '$1>=200&&$1<400{print $2}' /tmp/all | sort -n > /tmp/lat
+ok=$(wc -l < /tmp/lat)
+if [ "$ok" -gt 0 ]; then
+  avg=$(awk '{s+=$1} END {printf "%%.4f", s/NR}' /tmp/lat)
+  p50=$(sed -n "$(( (ok+1)/2 ))p" /tmp/lat)
+  p95=$(sed -n "$(( (ok*95+99)/100 ))p" /tmp/lat)
+  max=$(tail -1 /tmp/lat)
+else
+  avg=0; p50=0; p95=0; max=0
+fi
+echo "BENCH_TOTAL=$total"
+echo "BENCH_ERRORS=$errors"
+echo "BENCH_AVG_SECONDS=$avg"
+echo "BENCH_P50_SECONDS=$p50"
+echo "BENCH_P95_SECONDS=$p95"
+echo "BENCH_MAX_SECONDS=$max"`, seconds, concurrency, target)
+}
+
+func benchRun(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	target := p.RequiredString("url")
+	seconds := p.OptionalInt64("seconds", 30)
+	concurrency := p.OptionalInt64("concurrency", 5)
+	namespace := p.OptionalString("namespace", "")
+	image := p.OptionalString("image", defaultLoadImage)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to start load test: %w", err)), nil
+	}
+	parsed, err := url.Parse(target)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to start load test: url must be a valid http(s) URL, got %q", target)), nil
+	}
+	if strings.Contains(target, "'") {
+		return api.NewToolCallResult("", errors.New("failed to start load test: url must not contain single quotes")), nil
+	}
+	if seconds < 1 || seconds > maxBenchSeconds {
+		return api.NewToolCallResult("", fmt.Errorf("failed to start load test: seconds must be between 1 and %d", maxBenchSeconds)), nil
+	}
+	if concurrency < 1 || concurrency > maxBenchConcurrency {
+		return api.NewToolCallResult("", fmt.Errorf("failed to start load test: concurrency must be between 1 and %d", maxBenchConcurrency)), nil
+	}
+	namespace = params.NamespaceOrDefault(namespace)
+	if _, err := loadTestConfig(params, namespace); err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	name := benchComponent + "-" + rand.String(5)
+	labels := map[string]string{
+		kubernetes.AppKubernetesName:      name,
+		kubernetes.AppKubernetesComponent: benchComponent,
+		kubernetes.AppKubernetesManagedBy: version.BinaryName,
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To(int32(0)),
+			// Leave room for image pull and the summary, then hard-stop.
+			ActiveDeadlineSeconds:   ptr.To(seconds + 120),
+			TTLSecondsAfterFinished: ptr.To(int32(3600)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:    "load",
+						Image:   image,
+						Command: []string{"sh", "-c", loadScript(target, seconds, concurrency)},
+					}},
+				},
+			},
+		},
+	}
+	if _, err = params.BatchV1().Jobs(namespace).Create(params.Context, job, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create load test job: %w", err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Load test job %s/%s started against %s (%d worker(s) for %d second(s)).\nFetch the summary with bench_results (name=%s) once it completes, and delete it with bench_cleanup when done",
+		namespace, name, target, concurrency, seconds, name), nil), nil
+}
+
+func benchResults(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to fetch load test results: %w", err)), nil
+	}
+	namespace = params.NamespaceOrDefault(namespace)
+	job, err := params.BatchV1().Jobs(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get load test job %s: %w", name, err)), nil
+	}
+	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
+		return api.NewToolCallResult(fmt.Sprintf(
+			"Load test %s/%s is still running (%d active pod(s)); try again once it completes", namespace, name, job.Status.Active), nil), nil
+	}
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{LabelSelector: "job-name=" + name})
+	if err != nil || len(pods.Items) == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("failed to find pods of load test job %s (already cleaned up?): %w", name, err)), nil
+	}
+	logs, err := params.CoreV1().Pods(namespace).GetLogs(pods.Items[0].Name, &corev1.PodLogOptions{}).Do(params.Context).Raw()
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to read load test logs from pod %s: %w", pods.Items[0].Name, err)), nil
+	}
+	summary := parseBenchSummary(string(logs))
+	if summary == "" {
+		return api.NewToolCallResult(fmt.Sprintf(
+			"Load test %s/%s finished but produced no summary (the generator may have failed before completing); raw log:\n%s",
+			namespace, name, string(logs)), nil), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Load test %s/%s: %s", namespace, name, summary), nil), nil
+}
+
+// parseBenchSummary turns the BENCH_* marker lines from the load generator
+// log into a one-line human-readable summary. Returns "" when the markers
+// are missing.
+func parseBenchSummary(logs string) string {
+	values := map[string]string{}
+	for _, line := range strings.Split(logs, "\n") {
+		if key, value, found := strings.Cut(strings.TrimSpace(line), "="); found && strings.HasPrefix(key, "BENCH_") {
+			values[key] = value
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	total, _ := strconv.ParseInt(values["BENCH_TOTAL"], 10, 64)
+	errorCount, _ := strconv.ParseInt(values["BENCH_ERRORS"], 10, 64)
+	errorRate := 0.0
+	if total > 0 {
+		errorRate = float64(errorCount) / float64(total) * 100
+	}
+	millis := func(key string) string {
+		seconds, err := strconv.ParseFloat(values[key], 64)
+		if err != nil {
+			return "n/a"
+		}
+		return fmt.Sprintf("%.0fms", seconds*1000)
+	}
+	return fmt.Sprintf("%d request(s), %d error(s) (%.1f%%), latency avg %s, p50 %s, p95 %s, max %s",
+		total, errorCount, errorRate,
+		millis("BENCH_AVG_SECONDS"), millis("BENCH_P50_SECONDS"), millis("BENCH_P95_SECONDS"), millis("BENCH_MAX_SECONDS"))
+}
+
+func benchCleanup(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to clean up load test: %w", err)), nil
+	}
+	namespace = params.NamespaceOrDefault(namespace)
+	job, err := params.BatchV1().Jobs(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get load test job %s: %w", name, err)), nil
+	}
+	if job.Labels[kubernetes.AppKubernetesManagedBy] != version.BinaryName || job.Labels[kubernetes.AppKubernetesComponent] != benchComponent {
+		return api.NewToolCallResult("", fmt.Errorf("job %s/%s was not created by bench_run; refusing to delete it", namespace, name)), nil
+	}
+	propagation := metav1.DeletePropagationBackground
+	if err := params.BatchV1().Jobs(namespace).Delete(params.Context, name, metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to delete load test job %s: %w", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Load test job %s/%s and its pods deleted", namespace, name), nil), nil
+}
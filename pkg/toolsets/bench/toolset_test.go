@@ -0,0 +1,213 @@
+package bench_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/mcp/mcptest"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/bench"
+)
+
+func TestMain(m *testing.M) {
+	mcptest.Main(m)
+}
+
+type BenchSuite struct {
+	mcptest.Suite
+	toolsetName string
+}
+
+func (s *BenchSuite) SetupTest() {
+	s.Suite.SetupTest()
+	s.toolsetName = (&bench.Toolset{}).GetName()
+}
+
+// configureBench replaces s.Cfg with one parsed from the given
+// [toolset_configs.bench] section. toolset_configs requires the two-phase
+// parsing performed by config.ReadToml, so the runtime fields the suite
+// already set are restored afterwards.
+func (s *BenchSuite) configureBench(toolsetConfig string) {
+	kubeConfig := s.Cfg.KubeConfig
+	listOutput := s.Cfg.ListOutput
+	readOnly := s.Cfg.ReadOnly
+	cfg, err := config.ReadToml([]byte(fmt.Sprintf(`
+		toolsets = ["%s"]
+		[toolset_configs.bench]
+		%s
+	`, s.toolsetName, toolsetConfig)))
+	s.Require().NoError(err, "failed to parse bench toolset config")
+	s.Cfg = cfg
+	s.Cfg.KubeConfig = kubeConfig
+	s.Cfg.ListOutput = listOutput
+	s.Cfg.ReadOnly = readOnly
+}
+
+func (s *BenchSuite) text(toolResult *mcp.CallToolResult) string {
+	s.T().Helper()
+	s.Require().NotEmpty(toolResult.Content, "expected tool result content")
+	return toolResult.Content[0].(*mcp.TextContent).Text
+}
+
+// startLoadTest runs bench_run against the given URL and returns the name of
+// the created Job, parsed from the tool result.
+func (s *BenchSuite) startLoadTest(args map[string]interface{}) string {
+	s.T().Helper()
+	toolResult, err := s.CallTool("bench_run", args)
+	s.Require().NoError(err, "call tool failed")
+	s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	match := regexp.MustCompile(`Load test job \S+/(\S+) started`).FindStringSubmatch(s.text(toolResult))
+	s.Require().Len(match, 2, "expected the result to report the created job")
+	return match[1]
+}
+
+func (s *BenchSuite) TestRunDisabledWithoutOptIn() {
+	s.Cfg.Toolsets = append(s.Cfg.Toolsets, s.toolsetName)
+	s.InitMcpClient()
+	s.Run("tools are exposed even without the opt-in", func() {
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		names := make([]string, 0, len(tools.Tools))
+		for _, tool := range tools.Tools {
+			names = append(names, tool.Name)
+		}
+		s.Contains(names, "bench_run", "expected the bench tools to be listed")
+	})
+	s.Run("bench_run refuses without enabled = true", func() {
+		toolResult, err := s.CallTool("bench_run", map[string]interface{}{
+			"url": "http://frontend.default.svc:8080/",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("load tests are disabled: set enabled = true under [toolset_configs.bench] to opt in", s.text(toolResult))
+	})
+}
+
+func (s *BenchSuite) TestBenchRun() {
+	s.configureBench(`
+		enabled = true
+		allowed_namespaces = ["bench-ns"]
+	`)
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(mcptest.RestConfig())
+	_, _ = client.CoreV1().Namespaces().Create(s.T().Context(),
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "bench-ns"}}, metav1.CreateOptions{})
+	s.Run("bench_run (namespace not allowed)", func() {
+		toolResult, err := s.CallTool("bench_run", map[string]interface{}{
+			"url": "http://frontend.default.svc:8080/",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("namespace default is not listed in allowed_namespaces for load tests", s.text(toolResult))
+	})
+	s.Run("bench_run (invalid url)", func() {
+		toolResult, err := s.CallTool("bench_run", map[string]interface{}{
+			"url":       "not-a-url",
+			"namespace": "bench-ns",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal(`failed to start load test: url must be a valid http(s) URL, got "not-a-url"`, s.text(toolResult))
+	})
+	s.Run("bench_run (seconds above the cap)", func() {
+		toolResult, err := s.CallTool("bench_run", map[string]interface{}{
+			"url":       "http://frontend.bench-ns.svc:8080/",
+			"namespace": "bench-ns",
+			"seconds":   301,
+		})
+		s.True(err != nil || toolResult.IsError, "a load test longer than the cap should be refused")
+	})
+	s.Run("bench_run (concurrency above the cap)", func() {
+		toolResult, err := s.CallTool("bench_run", map[string]interface{}{
+			"url":         "http://frontend.bench-ns.svc:8080/",
+			"namespace":   "bench-ns",
+			"concurrency": 51,
+		})
+		s.True(err != nil || toolResult.IsError, "more workers than the cap should be refused")
+	})
+	s.Run("bench_run (valid)", func() {
+		name := s.startLoadTest(map[string]interface{}{
+			"url":       "http://frontend.bench-ns.svc:8080/",
+			"namespace": "bench-ns",
+			"seconds":   60,
+		})
+		job, err := client.BatchV1().Jobs("bench-ns").Get(s.T().Context(), name, metav1.GetOptions{})
+		s.Require().NoError(err, "load test job not created")
+		s.Run("labels the job as bench-managed", func() {
+			s.Equal("bench", job.Labels["app.kubernetes.io/component"], "job should carry the bench component label")
+		})
+		s.Run("bounds the job runtime", func() {
+			s.Require().NotNil(job.Spec.ActiveDeadlineSeconds, "job should carry an active deadline")
+			s.EqualValues(180, *job.Spec.ActiveDeadlineSeconds, "deadline should be the requested duration plus the grace period")
+		})
+	})
+}
+
+func (s *BenchSuite) TestBenchResults() {
+	s.configureBench(`enabled = true`)
+	s.InitMcpClient()
+	s.Run("bench_results (nonexistent job)", func() {
+		toolResult, err := s.CallTool("bench_results", map[string]interface{}{"name": "not-there"})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Contains(s.text(toolResult), "failed to get load test job not-there")
+	})
+	s.Run("bench_results (job still running)", func() {
+		// envtest runs no controllers, so the Job never completes and the
+		// tool reports it as still running.
+		name := s.startLoadTest(map[string]interface{}{"url": "http://frontend.default.svc:8080/"})
+		toolResult, err := s.CallTool("bench_results", map[string]interface{}{"name": name})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal(fmt.Sprintf("Load test default/%s is still running (0 active pod(s)); try again once it completes", name), s.text(toolResult))
+	})
+}
+
+func (s *BenchSuite) TestBenchCleanup() {
+	s.configureBench(`enabled = true`)
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(mcptest.RestConfig())
+	s.Run("bench_cleanup (job not created by bench_run)", func() {
+		_, err := client.BatchV1().Jobs("default").Create(s.T().Context(), &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-foreign-job"},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyNever,
+						Containers:    []corev1.Container{{Name: "app", Image: "busybox"}},
+					},
+				},
+			},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create foreign job")
+		toolResult, err := s.CallTool("bench_cleanup", map[string]interface{}{"name": "a-foreign-job"})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("job default/a-foreign-job was not created by bench_run; refusing to delete it", s.text(toolResult))
+	})
+	s.Run("bench_cleanup (job created by bench_run)", func() {
+		name := s.startLoadTest(map[string]interface{}{"url": "http://frontend.default.svc:8080/"})
+		toolResult, err := s.CallTool("bench_cleanup", map[string]interface{}{"name": name})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Run("reports the deletion", func() {
+			s.Equal(fmt.Sprintf("Load test job default/%s and its pods deleted", name), s.text(toolResult))
+		})
+		s.Run("deletes the job", func() {
+			_, getErr := client.BatchV1().Jobs("default").Get(s.T().Context(), name, metav1.GetOptions{})
+			s.Error(getErr, "job should be deleted")
+		})
+	})
+}
+
+func TestBench(t *testing.T) {
+	suite.Run(t, new(BenchSuite))
+}
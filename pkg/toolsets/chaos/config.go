@@ -0,0 +1,46 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// Config holds the chaos toolset configuration. Enabling the toolset in the
+// toolsets list is not enough to run experiments: every tool also requires
+// enabled = true here, so failure injection is always a double opt-in.
+type Config struct {
+	// Enabled must be set to true for any chaos tool to run. Listing the
+	// toolset without it exposes the tools but makes every call refuse.
+	Enabled bool `toml:"enabled,omitempty"`
+	// AllowedNamespaces restricts namespaced experiments to the listed
+	// namespaces. Empty (the default) allows every namespace.
+	AllowedNamespaces []string `toml:"allowed_namespaces,omitempty"`
+	// LatencyImage is the image used for the latency debug sidecar. It must
+	// provide sh and tc. Defaults to the RHEL support-tools image.
+	LatencyImage string `toml:"latency_image,omitempty"`
+}
+
+var _ api.ExtendedConfig = (*Config)(nil)
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return errors.New("chaos config is nil")
+	}
+	return nil
+}
+
+func chaosToolsetParser(_ context.Context, primitive toml.Primitive, md toml.MetaData) (api.ExtendedConfig, error) {
+	var cfg Config
+	if err := md.PrimitiveDecode(primitive, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func init() {
+	config.RegisterToolsetConfig("chaos", chaosToolsetParser)
+}
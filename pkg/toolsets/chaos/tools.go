@@ -0,0 +1,301 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
+)
+
+const (
+	// defaultLatencyImage is the debug sidecar image used when the chaos
+	// toolset config does not override it. It must provide sh and tc.
+	defaultLatencyImage = "registry.redhat.io/rhel9/support-tools:latest"
+	// maxCordonMinutes bounds how long a node can stay cordoned by an
+	// experiment: a typo like 500 should not take a node out for a shift.
+	maxCordonMinutes = 120
+	// maxLatencySeconds bounds how long injected latency lasts.
+	maxLatencySeconds = 600
+)
+
+// experimentConfig enforces the double opt-in: the chaos toolset config must
+// exist with enabled = true, and namespaced experiments must target an
+// allowed namespace. Every tool handler calls it before touching the cluster.
+func experimentConfig(params api.ToolHandlerParams, namespace string) (*Config, error) {
+	extended, ok := params.GetToolsetConfig("chaos")
+	cfg, valid := extended.(*Config)
+	if !ok || !valid || !cfg.Enabled {
+		return nil, errors.New("chaos experiments are disabled: set enabled = true under [toolset_configs.chaos] to opt in")
+	}
+	if namespace != "" && len(cfg.AllowedNamespaces) > 0 && !slices.Contains(cfg.AllowedNamespaces, namespace) {
+		return nil, fmt.Errorf("namespace %s is not listed in allowed_namespaces for chaos experiments", namespace)
+	}
+	return cfg, nil
+}
+
+func initPodDelete() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "chaos_pod_delete",
+			Description: "Delete randomly chosen pods matching a label selector to verify the workload recovers (pods are rescheduled, traffic keeps flowing). " +
+				"Refuses to run unless chaos experiments are explicitly enabled in the configuration",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the target workload",
+					},
+					"labelSelector": {
+						Type:        "string",
+						Description: "Label selector the victim pods must match (e.g. app=frontend)",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+					"count": {
+						Type:        "integer",
+						Description: "Number of pods to delete (Optional, defaults to 1, capped at the number of matching pods)",
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+				Required: []string{"namespace", "labelSelector"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Chaos: Pod Delete",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: chaosPodDelete},
+	}
+}
+
+func initNodeCordon() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "chaos_node_cordon",
+			Description: "Cordon a node for a bounded number of minutes to verify workloads reschedule; the node is uncordoned automatically when the time is up. " +
+				"Refuses to run unless chaos experiments are explicitly enabled in the configuration",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the node to cordon",
+					},
+					"minutes": {
+						Type:        "integer",
+						Description: fmt.Sprintf("How long the node stays cordoned before the automatic uncordon (Optional, defaults to 5, maximum %d)", maxCordonMinutes),
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(float64(maxCordonMinutes)),
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Chaos: Node Cordon",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: chaosNodeCordon},
+	}
+}
+
+func initPodLatency() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "chaos_pod_latency",
+			Description: "Add network latency to a pod for a bounded number of seconds via a managed debug sidecar (an ephemeral container running tc netem); the latency clears itself when the time is up. " +
+				"Refuses to run unless chaos experiments are explicitly enabled in the configuration",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the target pod",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the target pod",
+					},
+					"delayMs": {
+						Type:        "integer",
+						Description: "Latency to add in milliseconds (Optional, defaults to 100)",
+						Minimum:     ptr.To(float64(1)),
+					},
+					"seconds": {
+						Type:        "integer",
+						Description: fmt.Sprintf("How long the latency lasts (Optional, defaults to 60, maximum %d)", maxLatencySeconds),
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(float64(maxLatencySeconds)),
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Chaos: Pod Latency",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: chaosPodLatency},
+	}
+}
+
+func chaosPodDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	labelSelector := p.RequiredString("labelSelector")
+	count := p.OptionalInt64("count", 1)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to run pod delete experiment: %w", err)), nil
+	}
+	if _, err := experimentConfig(params, namespace); err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	if count < 1 {
+		return api.NewToolCallResult("", errors.New("failed to run pod delete experiment: count must be at least 1")), nil
+	}
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods matching %s: %w", labelSelector, err)), nil
+	}
+	if len(pods.Items) == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("no pods in namespace %s match selector %s", namespace, labelSelector)), nil
+	}
+	if count > int64(len(pods.Items)) {
+		count = int64(len(pods.Items))
+	}
+	rand.Shuffle(len(pods.Items), func(i, j int) {
+		pods.Items[i], pods.Items[j] = pods.Items[j], pods.Items[i]
+	})
+	var deleted []string
+	for _, pod := range pods.Items[:count] {
+		if err := params.CoreV1().Pods(namespace).Delete(params.Context, pod.Name, metav1.DeleteOptions{}); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to delete pod %s: %w", pod.Name, err)), nil
+		}
+		deleted = append(deleted, pod.Name)
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Deleted %d of %d pod(s) matching %s in namespace %s: %s\nVerify the workload recovers (pods_list with the same selector)",
+		len(deleted), len(pods.Items), labelSelector, namespace, strings.Join(deleted, ", ")), nil), nil
+}
+
+func chaosNodeCordon(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	minutes := p.OptionalInt64("minutes", 5)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to run node cordon experiment: %w", err)), nil
+	}
+	if _, err := experimentConfig(params, ""); err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	if minutes < 1 || minutes > maxCordonMinutes {
+		return api.NewToolCallResult("", fmt.Errorf("failed to run node cordon experiment: minutes must be between 1 and %d", maxCordonMinutes)), nil
+	}
+	node, err := params.CoreV1().Nodes().Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get node %s: %w", name, err)), nil
+	}
+	if node.Spec.Unschedulable {
+		return api.NewToolCallResult("", fmt.Errorf("node %s is already cordoned", name)), nil
+	}
+	node.Spec.Unschedulable = true
+	if _, err = params.CoreV1().Nodes().Update(params.Context, node, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to cordon node %s: %w", name, err)), nil
+	}
+	// The automatic uncordon runs on its own deadline, detached from the
+	// tool call's context, using the same derived client that cordoned the
+	// node. A failure is logged but cannot be reported to the caller; the
+	// message below tells them what to check.
+	duration := time.Duration(minutes) * time.Minute
+	until := time.Now().Add(duration)
+	nodes := params.CoreV1().Nodes()
+	logger := klogutil.FromContext(context.WithoutCancel(params.Context))
+	time.AfterFunc(duration, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		current, err := nodes.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			logger.Error(err, "chaos experiment failed to get node for automatic uncordon", "node", name)
+			return
+		}
+		if !current.Spec.Unschedulable {
+			return
+		}
+		current.Spec.Unschedulable = false
+		if _, err = nodes.Update(ctx, current, metav1.UpdateOptions{}); err != nil {
+			logger.Error(err, "chaos experiment failed to uncordon node automatically", "node", name)
+			return
+		}
+		logger.V(1).Info("chaos experiment uncordoned node automatically", "node", name)
+	})
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Node %s cordoned for %d minute(s); it will be uncordoned automatically at %s. If the server stops before then, uncordon it manually",
+		name, minutes, until.Format(time.RFC3339)), nil), nil
+}
+
+func chaosPodLatency(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	name := p.RequiredString("name")
+	delayMs := p.OptionalInt64("delayMs", 100)
+	seconds := p.OptionalInt64("seconds", 60)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to run pod latency experiment: %w", err)), nil
+	}
+	cfg, err := experimentConfig(params, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	if delayMs < 1 {
+		return api.NewToolCallResult("", errors.New("failed to run pod latency experiment: delayMs must be at least 1")), nil
+	}
+	if seconds < 1 || seconds > maxLatencySeconds {
+		return api.NewToolCallResult("", fmt.Errorf("failed to run pod latency experiment: seconds must be between 1 and %d", maxLatencySeconds)), nil
+	}
+	pod, err := params.CoreV1().Pods(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pod %s: %w", name, err)), nil
+	}
+	image := cfg.LatencyImage
+	if image == "" {
+		image = defaultLatencyImage
+	}
+	// The sidecar shares the pod's network namespace, so tc on eth0 delays
+	// all of the pod's traffic. The trailing del runs even if the sleep is
+	// interrupted, so the latency never outlives the experiment.
+	script := fmt.Sprintf("tc qdisc add dev eth0 root netem delay %dms && sleep %d; tc qdisc del dev eth0 root netem", delayMs, seconds)
+	containerName := fmt.Sprintf("chaos-latency-%05x", rand.Intn(1<<20))
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:    containerName,
+			Image:   image,
+			Command: []string{"sh", "-c", script},
+			SecurityContext: &corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+			},
+		},
+	})
+	if _, err = params.CoreV1().Pods(namespace).UpdateEphemeralContainers(params.Context, name, pod, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to inject latency sidecar into pod %s: %w", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Injected %dms of latency into pod %s/%s for %d second(s) via debug sidecar %s (image %s). The latency clears itself when the time is up; the ephemeral container stays visible in the pod status until the pod is recreated",
+		delayMs, namespace, name, seconds, containerName, image), nil), nil
+}
@@ -0,0 +1,178 @@
+package chaos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/mcp/mcptest"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/chaos"
+)
+
+func TestMain(m *testing.M) {
+	mcptest.Main(m)
+}
+
+type ChaosSuite struct {
+	mcptest.Suite
+	toolsetName string
+}
+
+func (s *ChaosSuite) SetupTest() {
+	s.Suite.SetupTest()
+	s.toolsetName = (&chaos.Toolset{}).GetName()
+}
+
+// configureChaos replaces s.Cfg with one parsed from the given
+// [toolset_configs.chaos] section. toolset_configs requires the two-phase
+// parsing performed by config.ReadToml, so the runtime fields the suite
+// already set are restored afterwards.
+func (s *ChaosSuite) configureChaos(toolsetConfig string) {
+	kubeConfig := s.Cfg.KubeConfig
+	listOutput := s.Cfg.ListOutput
+	readOnly := s.Cfg.ReadOnly
+	cfg, err := config.ReadToml([]byte(fmt.Sprintf(`
+		toolsets = ["%s"]
+		[toolset_configs.chaos]
+		%s
+	`, s.toolsetName, toolsetConfig)))
+	s.Require().NoError(err, "failed to parse chaos toolset config")
+	s.Cfg = cfg
+	s.Cfg.KubeConfig = kubeConfig
+	s.Cfg.ListOutput = listOutput
+	s.Cfg.ReadOnly = readOnly
+}
+
+func (s *ChaosSuite) text(toolResult *mcp.CallToolResult) string {
+	s.T().Helper()
+	s.Require().NotEmpty(toolResult.Content, "expected tool result content")
+	return toolResult.Content[0].(*mcp.TextContent).Text
+}
+
+func (s *ChaosSuite) TestExperimentsDisabledWithoutOptIn() {
+	s.Cfg.Toolsets = append(s.Cfg.Toolsets, s.toolsetName)
+	s.InitMcpClient()
+	s.Run("tools are exposed even without the opt-in", func() {
+		tools, err := s.ListTools()
+		s.Require().NoError(err)
+		names := make([]string, 0, len(tools.Tools))
+		for _, tool := range tools.Tools {
+			names = append(names, tool.Name)
+		}
+		s.Contains(names, "chaos_pod_delete", "expected the chaos tools to be listed")
+	})
+	s.Run("chaos_pod_delete refuses without enabled = true", func() {
+		toolResult, err := s.CallTool("chaos_pod_delete", map[string]interface{}{
+			"namespace":     "default",
+			"labelSelector": "app=victim",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("chaos experiments are disabled: set enabled = true under [toolset_configs.chaos] to opt in", s.text(toolResult))
+	})
+}
+
+func (s *ChaosSuite) TestPodDelete() {
+	s.configureChaos(`
+		enabled = true
+		allowed_namespaces = ["chaos-ns"]
+	`)
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(mcptest.RestConfig())
+	_, _ = client.CoreV1().Namespaces().Create(s.T().Context(),
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "chaos-ns"}}, metav1.CreateOptions{})
+	_, err := client.CoreV1().Pods("chaos-ns").Create(s.T().Context(), &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "victim-pod",
+			Labels: map[string]string{"app": "victim"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app", Image: "busybox"}},
+		},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create victim pod")
+	s.Run("chaos_pod_delete (namespace not allowed)", func() {
+		toolResult, err := s.CallTool("chaos_pod_delete", map[string]interface{}{
+			"namespace":     "default",
+			"labelSelector": "app=victim",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("namespace default is not listed in allowed_namespaces for chaos experiments", s.text(toolResult))
+	})
+	s.Run("chaos_pod_delete (no matching pods)", func() {
+		toolResult, err := s.CallTool("chaos_pod_delete", map[string]interface{}{
+			"namespace":     "chaos-ns",
+			"labelSelector": "app=missing",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("no pods in namespace chaos-ns match selector app=missing", s.text(toolResult))
+	})
+	s.Run("chaos_pod_delete (valid)", func() {
+		toolResult, err := s.CallTool("chaos_pod_delete", map[string]interface{}{
+			"namespace":     "chaos-ns",
+			"labelSelector": "app=victim",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := s.text(toolResult)
+		s.Run("reports the deleted pod", func() {
+			s.Containsf(text, "Deleted 1 of 1 pod(s) matching app=victim in namespace chaos-ns: victim-pod", "unexpected result %v", text)
+		})
+		s.Run("suggests verifying recovery", func() {
+			s.Containsf(text, "Verify the workload recovers", "unexpected result %v", text)
+		})
+	})
+}
+
+func (s *ChaosSuite) TestNodeCordon() {
+	s.configureChaos(`enabled = true`)
+	s.InitMcpClient()
+	s.Run("chaos_node_cordon (nonexistent node)", func() {
+		toolResult, err := s.CallTool("chaos_node_cordon", map[string]interface{}{
+			"name": "not-there",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Contains(s.text(toolResult), "failed to get node not-there")
+	})
+	s.Run("chaos_node_cordon (valid node)", func() {
+		client := kubernetes.NewForConfigOrDie(mcptest.RestConfig())
+		_, err := client.CoreV1().Nodes().Create(s.T().Context(),
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "chaos-node"}}, metav1.CreateOptions{})
+		s.Require().NoError(err, "failed to create node")
+		toolResult, err := s.CallTool("chaos_node_cordon", map[string]interface{}{
+			"name":    "chaos-node",
+			"minutes": 1,
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Run("reports the cordon and the automatic uncordon", func() {
+			s.Contains(s.text(toolResult), "Node chaos-node cordoned for 1 minute(s)")
+		})
+		s.Run("marks the node unschedulable", func() {
+			node, getErr := client.CoreV1().Nodes().Get(s.T().Context(), "chaos-node", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get node")
+			s.True(node.Spec.Unschedulable, "node should be cordoned")
+		})
+		s.Run("a second cordon of the same node refuses", func() {
+			toolResult, err := s.CallTool("chaos_node_cordon", map[string]interface{}{
+				"name": "chaos-node",
+			})
+			s.Require().NoError(err, "call tool failed")
+			s.Require().True(toolResult.IsError, "call tool should fail")
+			s.Equal("node chaos-node is already cordoned", s.text(toolResult))
+		})
+	})
+}
+
+func TestChaos(t *testing.T) {
+	suite.Run(t, new(ChaosSuite))
+}
@@ -0,0 +1,150 @@
+package apiservices
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var apiServicesGVR = schema.GroupVersionResource{Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices"}
+
+// InitAPIServices returns the aggregated API diagnostic tools.
+func InitAPIServices() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "apiservices_list",
+				Description: "List the cluster's APIServices and their availability, highlighting aggregated APIs whose Available condition is False with the reported reason. " +
+					"Unavailable aggregated APIs are a common cause of discovery and apply failures",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"onlyUnavailable": {
+							Type:        "boolean",
+							Description: "Only list APIServices whose Available condition is not True (Optional)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "APIServices: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: apiServicesList,
+		},
+		{
+			Tool: api.Tool{
+				Name: "discovery_refresh",
+				Description: "Invalidate this server's cached API discovery information and re-sync it from the cluster. " +
+					"Use after installing CRDs or when aggregated APIs recover, so newly served resources become visible without restarting the server",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Discovery: Refresh",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: discoveryRefresh,
+		},
+	}
+}
+
+func apiServicesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	onlyUnavailable := p.OptionalBool("onlyUnavailable", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list APIServices: %w", err)), nil
+	}
+	list, err := params.DynamicClient().Resource(apiServicesGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list APIServices: %w", err)), nil
+	}
+
+	var unavailable, available []string
+	for i := range list.Items {
+		item := &list.Items[i]
+		status, reason, message := availableCondition(item)
+		service, _, _ := unstructured.NestedMap(item.Object, "spec", "service")
+		serving := "served locally by kube-apiserver"
+		if len(service) > 0 {
+			serving = fmt.Sprintf("aggregated, backed by Service %v/%v", service["namespace"], service["name"])
+		}
+		if status == "True" {
+			available = append(available, fmt.Sprintf("- %s (%s)", item.GetName(), serving))
+			continue
+		}
+		detail := reason
+		if message != "" {
+			detail += ": " + message
+		}
+		if detail == "" {
+			detail = "no Available condition reported"
+		}
+		unavailable = append(unavailable, fmt.Sprintf("- %s (%s): Available=%s — %s", item.GetName(), serving, orUnknown(status), detail))
+	}
+	sort.Strings(unavailable)
+	sort.Strings(available)
+
+	var sections []string
+	if len(unavailable) > 0 {
+		sections = append(sections, fmt.Sprintf("UNAVAILABLE APIServices (%d):\n%s", len(unavailable), strings.Join(unavailable, "\n")))
+	} else {
+		sections = append(sections, "All APIServices report Available=True")
+	}
+	if !onlyUnavailable && len(available) > 0 {
+		sections = append(sections, fmt.Sprintf("Available APIServices (%d):\n%s", len(available), strings.Join(available, "\n")))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// availableCondition extracts the status, reason, and message of the Available
+// condition from an APIService object.
+func availableCondition(item *unstructured.Unstructured) (status, reason, message string) {
+	conditions, _, _ := unstructured.NestedSlice(item.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != "Available" {
+			continue
+		}
+		status, _ = condition["status"].(string)
+		reason, _ = condition["reason"].(string)
+		message, _ = condition["message"].(string)
+		return
+	}
+	return
+}
+
+func orUnknown(status string) string {
+	if status == "" {
+		return "Unknown"
+	}
+	return status
+}
+
+func discoveryRefresh(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	params.DiscoveryClient().Invalidate()
+	params.RESTMapper().Reset()
+	// Re-fetch the group list so the cache is repopulated now and the call
+	// surfaces connectivity problems instead of deferring them to the next
+	// tool that happens to hit discovery.
+	groups, err := params.DiscoveryClient().ServerGroups()
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("discovery cache invalidated, but re-sync failed: %w", err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Discovery cache refreshed: %d API group(s) discovered", len(groups.Groups)), nil), nil
+}
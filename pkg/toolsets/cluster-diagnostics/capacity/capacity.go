@@ -0,0 +1,266 @@
+package capacity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// InitCapacity returns the cluster capacity planning tools.
+func InitCapacity() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "capacity_check",
+				Description: "Simulate scheduling a proposed pod spec at a given replica count against node allocatable capacity, taints, and node selectors, reporting whether the replicas fit and on which nodes. " +
+					"An approximation of the scheduler: affinity rules, topology spread, and volume constraints are not evaluated",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"podSpec": {
+							Type:        "string",
+							Description: "Pod manifest (YAML or JSON) describing the workload to place",
+						},
+						"replicas": {
+							Type:        "integer",
+							Description: "Number of replicas to place (Optional, default: 1)",
+							Minimum:     ptr.To(float64(1)),
+						},
+					},
+					Required: []string{"podSpec"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Capacity: Check",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: capacityCheck,
+		},
+	}
+}
+
+// nodeCapacity tracks a node's remaining schedulable resources during the
+// simulation.
+type nodeCapacity struct {
+	name     string
+	freeCPU  int64 // millicores
+	freeMem  int64 // bytes
+	freePods int64
+	placed   int
+	excluded string // non-empty when the node can never host the pod
+}
+
+func capacityCheck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	podSpec := p.RequiredString("podSpec")
+	replicas := p.OptionalInt64("replicas", 1)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check capacity: %w", err)), nil
+	}
+	var pod corev1.Pod
+	if err := yaml.Unmarshal([]byte(podSpec), &pod); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to parse podSpec: %w", err)), nil
+	}
+	requestCPU, requestMem := podRequests(&pod.Spec)
+
+	nodes, err := params.CoreV1().Nodes().List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list nodes: %w", err)), nil
+	}
+	pods, err := params.CoreV1().Pods("").List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods to compute node usage: %w", err)), nil
+	}
+
+	// Sum the requests of non-terminal pods per node.
+	type used struct{ cpu, mem, count int64 }
+	usedByNode := make(map[string]*used)
+	for i := range pods.Items {
+		existing := &pods.Items[i]
+		if existing.Spec.NodeName == "" || existing.Status.Phase == corev1.PodSucceeded || existing.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		u := usedByNode[existing.Spec.NodeName]
+		if u == nil {
+			u = &used{}
+			usedByNode[existing.Spec.NodeName] = u
+		}
+		cpu, mem := podRequests(&existing.Spec)
+		u.cpu += cpu
+		u.mem += mem
+		u.count++
+	}
+
+	capacities := make([]*nodeCapacity, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		capacity := &nodeCapacity{name: node.Name}
+		capacity.excluded = nodeExclusionReason(node, &pod)
+		u := usedByNode[node.Name]
+		if u == nil {
+			u = &used{}
+		}
+		capacity.freeCPU = node.Status.Allocatable.Cpu().MilliValue() - u.cpu
+		capacity.freeMem = node.Status.Allocatable.Memory().Value() - u.mem
+		capacity.freePods = node.Status.Allocatable.Pods().Value() - u.count
+		capacities = append(capacities, capacity)
+	}
+	// Place each replica on the eligible node with the most free CPU, so the
+	// result resembles the scheduler's spreading rather than stacking one
+	// node to the brim.
+	remaining := replicas
+	for remaining > 0 {
+		sort.Slice(capacities, func(i, j int) bool { return capacities[i].freeCPU > capacities[j].freeCPU })
+		placed := false
+		for _, capacity := range capacities {
+			if capacity.excluded != "" {
+				continue
+			}
+			if capacity.freeCPU < requestCPU || capacity.freeMem < requestMem || capacity.freePods < 1 {
+				continue
+			}
+			capacity.freeCPU -= requestCPU
+			capacity.freeMem -= requestMem
+			capacity.freePods--
+			capacity.placed++
+			remaining--
+			placed = true
+			break
+		}
+		if !placed {
+			break
+		}
+	}
+
+	sort.Slice(capacities, func(i, j int) bool { return capacities[i].name < capacities[j].name })
+	var lines []string
+	for _, capacity := range capacities {
+		switch {
+		case capacity.excluded != "":
+			lines = append(lines, fmt.Sprintf("- %s: ineligible — %s", capacity.name, capacity.excluded))
+		case capacity.placed > 0:
+			lines = append(lines, fmt.Sprintf("- %s: fits %d replica(s) (leaving %dm CPU, %s memory free)",
+				capacity.name, capacity.placed, capacity.freeCPU, formatBytes(capacity.freeMem)))
+		default:
+			lines = append(lines, fmt.Sprintf("- %s: no capacity (%dm CPU, %s memory free, needs %dm CPU, %s memory per replica)",
+				capacity.name, capacity.freeCPU, formatBytes(capacity.freeMem), requestCPU, formatBytes(requestMem)))
+		}
+	}
+
+	verdict := fmt.Sprintf("FITS: all %d replica(s) can be scheduled", replicas)
+	if remaining > 0 {
+		verdict = fmt.Sprintf("DOES NOT FIT: %d of %d replica(s) cannot be scheduled", remaining, replicas)
+	}
+	header := fmt.Sprintf("%s (each replica requests %dm CPU, %s memory)", verdict, requestCPU, formatBytes(requestMem))
+	if requestCPU == 0 && requestMem == 0 {
+		header += "\nNote: the pod spec declares no resource requests; every node with a free pod slot fits."
+	}
+	return api.NewToolCallResult(header+"\n\nPer-node placement:\n"+strings.Join(lines, "\n"), nil), nil
+}
+
+// nodeExclusionReason reports why a node can never host the pod (taints,
+// selectors, readiness), or the empty string when the node is eligible.
+func nodeExclusionReason(node *corev1.Node, pod *corev1.Pod) string {
+	if node.Spec.Unschedulable {
+		return "node is cordoned (unschedulable)"
+	}
+	for _, nodeCondition := range node.Status.Conditions {
+		if nodeCondition.Type == corev1.NodeReady && nodeCondition.Status != corev1.ConditionTrue {
+			return "node is not Ready"
+		}
+	}
+	if len(pod.Spec.NodeSelector) > 0 {
+		if !labels.SelectorFromSet(pod.Spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+			return "node labels do not match the pod's nodeSelector"
+		}
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect != corev1.TaintEffectNoSchedule && taint.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+		if !tolerates(pod.Spec.Tolerations, taint) {
+			return fmt.Sprintf("taint %s is not tolerated", formatTaint(taint))
+		}
+	}
+	return ""
+}
+
+// tolerates reports whether any toleration matches the taint, following the
+// Kubernetes toleration matching rules: an empty effect matches all effects,
+// the Exists operator matches any value, and an empty key with Exists matches
+// all taints.
+func tolerates(tolerations []corev1.Toleration, taint *corev1.Taint) bool {
+	for i := range tolerations {
+		toleration := &tolerations[i]
+		if toleration.Effect != "" && toleration.Effect != taint.Effect {
+			continue
+		}
+		if toleration.Key != "" && toleration.Key != taint.Key {
+			continue
+		}
+		switch toleration.Operator {
+		case corev1.TolerationOpExists:
+			return true
+		case corev1.TolerationOpEqual, "":
+			if toleration.Key == "" {
+				continue
+			}
+			if toleration.Value == taint.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func formatTaint(taint *corev1.Taint) string {
+	if taint.Value != "" {
+		return fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect)
+	}
+	return fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+}
+
+// podRequests sums the CPU (millicores) and memory (bytes) requests of a pod
+// spec, taking the max of init and regular containers as the scheduler does.
+func podRequests(spec *corev1.PodSpec) (cpu, mem int64) {
+	for i := range spec.Containers {
+		requests := spec.Containers[i].Resources.Requests
+		cpu += requests.Cpu().MilliValue()
+		mem += requests.Memory().Value()
+	}
+	for i := range spec.InitContainers {
+		requests := spec.InitContainers[i].Resources.Requests
+		if initCPU := requests.Cpu().MilliValue(); initCPU > cpu {
+			cpu = initCPU
+		}
+		if initMem := requests.Memory().Value(); initMem > mem {
+			mem = initMem
+		}
+	}
+	return cpu, mem
+}
+
+func formatBytes(b int64) string {
+	switch {
+	case b >= 1<<30:
+		return fmt.Sprintf("%.1fGi", float64(b)/(1<<30))
+	case b >= 1<<20:
+		return fmt.Sprintf("%.1fMi", float64(b)/(1<<20))
+	case b >= 1<<10:
+		return fmt.Sprintf("%.1fKi", float64(b)/(1<<10))
+	}
+	return fmt.Sprintf("%dB", b)
+}
@@ -0,0 +1,346 @@
+package compare
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var routeGVR = schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+
+// InitCompare returns the cross-namespace workload comparison tool.
+func InitCompare() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "workload_diff",
+				Description: "Compare a Deployment between two namespaces — container images, environment variables, resource requests/limits, replicas, " +
+					"the keys and values of referenced ConfigMaps, the key names of referenced Secrets (values are never compared), and (on OpenShift) the Route of the same name — " +
+					"to answer 'why does prod behave differently than staging'",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the Deployment to compare",
+						},
+						"sourceNamespace": {
+							Type:        "string",
+							Description: "Namespace holding the reference workload (e.g. staging)",
+						},
+						"targetNamespace": {
+							Type:        "string",
+							Description: "Namespace to compare against (e.g. prod)",
+						},
+						"targetName": {
+							Type:        "string",
+							Description: "Name of the Deployment in the target namespace (Optional, same as name if not provided)",
+						},
+					},
+					Required: []string{"name", "sourceNamespace", "targetNamespace"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Workload: Diff",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: workloadDiff,
+		},
+	}
+}
+
+func workloadDiff(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	sourceNamespace := p.RequiredString("sourceNamespace")
+	targetNamespace := p.RequiredString("targetNamespace")
+	targetName := p.OptionalString("targetName", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diff workload: %w", err)), nil
+	}
+	if targetName == "" {
+		targetName = name
+	}
+
+	source, err := params.AppsV1().Deployments(sourceNamespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get deployment %s/%s: %w", sourceNamespace, name, err)), nil
+	}
+	target, err := params.AppsV1().Deployments(targetNamespace).Get(params.Context, targetName, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get deployment %s/%s: %w", targetNamespace, targetName, err)), nil
+	}
+
+	var sections []string
+	appendSection := func(title string, lines []string) {
+		if len(lines) > 0 {
+			sections = append(sections, fmt.Sprintf("%s:\n%s", title, strings.Join(lines, "\n")))
+		}
+	}
+	appendSection("Deployment spec", diffDeployments(source, target))
+	appendSection("ConfigMaps", diffConfigMaps(params, source, target))
+	appendSection("Secrets (key names only)", diffSecretKeys(params, source, target))
+	appendSection("Route", diffRoutes(params, sourceNamespace, name, targetNamespace, targetName))
+
+	header := fmt.Sprintf("Comparing %s/%s (source) with %s/%s (target)", sourceNamespace, name, targetNamespace, targetName)
+	if len(sections) == 0 {
+		return api.NewToolCallResult(header+": no differences found", nil), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("%s:\n\n%s", header, strings.Join(sections, "\n\n")), nil), nil
+}
+
+// diffMaps renders "key: source value != target value" lines for two flat maps,
+// marking keys that only exist on one side.
+func diffMaps(source, target map[string]string) []string {
+	keys := map[string]bool{}
+	for key := range source {
+		keys[key] = true
+	}
+	for key := range target {
+		keys[key] = true
+	}
+	var lines []string
+	for key := range keys {
+		sourceValue, inSource := source[key]
+		targetValue, inTarget := target[key]
+		switch {
+		case !inTarget:
+			lines = append(lines, fmt.Sprintf("- %s: only in source (%s)", key, sourceValue))
+		case !inSource:
+			lines = append(lines, fmt.Sprintf("- %s: only in target (%s)", key, targetValue))
+		case sourceValue != targetValue:
+			lines = append(lines, fmt.Sprintf("- %s: source=%s target=%s", key, sourceValue, targetValue))
+		}
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func diffDeployments(source, target *appsv1.Deployment) []string {
+	var lines []string
+	sourceReplicas, targetReplicas := ptr.Deref(source.Spec.Replicas, 1), ptr.Deref(target.Spec.Replicas, 1)
+	if sourceReplicas != targetReplicas {
+		lines = append(lines, fmt.Sprintf("- replicas: source=%d target=%d", sourceReplicas, targetReplicas))
+	}
+	for _, line := range diffMaps(containerImages(source), containerImages(target)) {
+		lines = append(lines, strings.Replace(line, "- ", "- image ", 1))
+	}
+	for _, line := range diffMaps(containerEnv(source), containerEnv(target)) {
+		lines = append(lines, strings.Replace(line, "- ", "- env ", 1))
+	}
+	for _, line := range diffMaps(containerResources(source), containerResources(target)) {
+		lines = append(lines, strings.Replace(line, "- ", "- resources ", 1))
+	}
+	return lines
+}
+
+func containerImages(deployment *appsv1.Deployment) map[string]string {
+	images := map[string]string{}
+	for i := range deployment.Spec.Template.Spec.Containers {
+		container := &deployment.Spec.Template.Spec.Containers[i]
+		images[container.Name] = container.Image
+	}
+	return images
+}
+
+func containerEnv(deployment *appsv1.Deployment) map[string]string {
+	env := map[string]string{}
+	for i := range deployment.Spec.Template.Spec.Containers {
+		container := &deployment.Spec.Template.Spec.Containers[i]
+		for j := range container.Env {
+			variable := &container.Env[j]
+			value := variable.Value
+			if valueFrom := variable.ValueFrom; valueFrom != nil {
+				switch {
+				case valueFrom.ConfigMapKeyRef != nil:
+					value = fmt.Sprintf("from configmap %s key %s", valueFrom.ConfigMapKeyRef.Name, valueFrom.ConfigMapKeyRef.Key)
+				case valueFrom.SecretKeyRef != nil:
+					value = fmt.Sprintf("from secret %s key %s", valueFrom.SecretKeyRef.Name, valueFrom.SecretKeyRef.Key)
+				case valueFrom.FieldRef != nil:
+					value = "from field " + valueFrom.FieldRef.FieldPath
+				default:
+					value = "from resource field"
+				}
+			}
+			env[container.Name+"."+variable.Name] = value
+		}
+	}
+	return env
+}
+
+func containerResources(deployment *appsv1.Deployment) map[string]string {
+	resources := map[string]string{}
+	for i := range deployment.Spec.Template.Spec.Containers {
+		container := &deployment.Spec.Template.Spec.Containers[i]
+		for resourceName, quantity := range container.Resources.Requests {
+			resources[fmt.Sprintf("%s.requests.%s", container.Name, resourceName)] = quantity.String()
+		}
+		for resourceName, quantity := range container.Resources.Limits {
+			resources[fmt.Sprintf("%s.limits.%s", container.Name, resourceName)] = quantity.String()
+		}
+	}
+	return resources
+}
+
+// referencedConfigMapsAndSecrets collects the names of ConfigMaps and Secrets
+// the Deployment's pod template references via volumes, envFrom, and env.
+func referencedConfigMapsAndSecrets(deployment *appsv1.Deployment) (configMaps, secrets map[string]bool) {
+	configMaps, secrets = map[string]bool{}, map[string]bool{}
+	spec := &deployment.Spec.Template.Spec
+	for i := range spec.Volumes {
+		if spec.Volumes[i].ConfigMap != nil {
+			configMaps[spec.Volumes[i].ConfigMap.Name] = true
+		}
+		if spec.Volumes[i].Secret != nil {
+			secrets[spec.Volumes[i].Secret.SecretName] = true
+		}
+	}
+	for i := range spec.Containers {
+		container := &spec.Containers[i]
+		for j := range container.EnvFrom {
+			if container.EnvFrom[j].ConfigMapRef != nil {
+				configMaps[container.EnvFrom[j].ConfigMapRef.Name] = true
+			}
+			if container.EnvFrom[j].SecretRef != nil {
+				secrets[container.EnvFrom[j].SecretRef.Name] = true
+			}
+		}
+		for j := range container.Env {
+			if valueFrom := container.Env[j].ValueFrom; valueFrom != nil {
+				if valueFrom.ConfigMapKeyRef != nil {
+					configMaps[valueFrom.ConfigMapKeyRef.Name] = true
+				}
+				if valueFrom.SecretKeyRef != nil {
+					secrets[valueFrom.SecretKeyRef.Name] = true
+				}
+			}
+		}
+	}
+	return configMaps, secrets
+}
+
+func diffConfigMaps(params api.ToolHandlerParams, source, target *appsv1.Deployment) []string {
+	sourceRefs, _ := referencedConfigMapsAndSecrets(source)
+	targetRefs, _ := referencedConfigMapsAndSecrets(target)
+	var lines []string
+	for _, name := range unionNames(sourceRefs, targetRefs) {
+		sourceData := configMapData(params, source.Namespace, name, sourceRefs[name])
+		targetData := configMapData(params, target.Namespace, name, targetRefs[name])
+		for _, line := range diffMaps(sourceData, targetData) {
+			lines = append(lines, strings.Replace(line, "- ", "- "+name+"/", 1))
+		}
+	}
+	return lines
+}
+
+// configMapData returns the data of the named ConfigMap, or nil when the
+// workload on that side does not reference it or it does not exist.
+func configMapData(params api.ToolHandlerParams, namespace, name string, referenced bool) map[string]string {
+	if !referenced {
+		return nil
+	}
+	configMap, err := params.CoreV1().ConfigMaps(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+	return configMap.Data
+}
+
+func diffSecretKeys(params api.ToolHandlerParams, source, target *appsv1.Deployment) []string {
+	_, sourceRefs := referencedConfigMapsAndSecrets(source)
+	_, targetRefs := referencedConfigMapsAndSecrets(target)
+	var lines []string
+	for _, name := range unionNames(sourceRefs, targetRefs) {
+		sourceKeys := secretKeys(params, source.Namespace, name, sourceRefs[name])
+		targetKeys := secretKeys(params, target.Namespace, name, targetRefs[name])
+		for _, line := range diffMaps(sourceKeys, targetKeys) {
+			lines = append(lines, strings.Replace(line, "- ", "- "+name+"/", 1))
+		}
+	}
+	return lines
+}
+
+// secretKeys returns the key names of the named Secret with a constant value,
+// so diffMaps only reports presence differences and never secret values.
+func secretKeys(params api.ToolHandlerParams, namespace, name string, referenced bool) map[string]string {
+	if !referenced {
+		return nil
+	}
+	secret, err := params.CoreV1().Secrets(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+	keys := map[string]string{}
+	for key := range secret.Data {
+		keys[key] = "present"
+	}
+	return keys
+}
+
+func diffRoutes(params api.ToolHandlerParams, sourceNamespace, sourceName, targetNamespace, targetName string) []string {
+	sourceRoute, sourceErr := params.DynamicClient().Resource(routeGVR).Namespace(sourceNamespace).Get(params.Context, sourceName, metav1.GetOptions{})
+	targetRoute, targetErr := params.DynamicClient().Resource(routeGVR).Namespace(targetNamespace).Get(params.Context, targetName, metav1.GetOptions{})
+	// Routes only exist on OpenShift; skip the section entirely when neither side has one.
+	if sourceErr != nil && targetErr != nil {
+		return nil
+	}
+	if sourceErr != nil {
+		if errors.IsNotFound(sourceErr) {
+			return []string{"- only the target namespace has a Route"}
+		}
+		return nil
+	}
+	if targetErr != nil {
+		if errors.IsNotFound(targetErr) {
+			return []string{"- only the source namespace has a Route"}
+		}
+		return nil
+	}
+	return diffMaps(routeFields(sourceRoute), routeFields(targetRoute))
+}
+
+func routeFields(route *unstructured.Unstructured) map[string]string {
+	fields := map[string]string{}
+	if host, _, _ := unstructured.NestedString(route.Object, "spec", "host"); host != "" {
+		fields["host"] = host
+	}
+	if termination, _, _ := unstructured.NestedString(route.Object, "spec", "tls", "termination"); termination != "" {
+		fields["tls.termination"] = termination
+	}
+	if targetPort, _, _ := unstructured.NestedFieldNoCopy(route.Object, "spec", "port", "targetPort"); targetPort != nil {
+		fields["port.targetPort"] = fmt.Sprintf("%v", targetPort)
+	}
+	if toName, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name"); toName != "" {
+		fields["to.name"] = toName
+	}
+	return fields
+}
+
+func unionNames(source, target map[string]bool) []string {
+	names := map[string]bool{}
+	for name := range source {
+		names[name] = true
+	}
+	for name := range target {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
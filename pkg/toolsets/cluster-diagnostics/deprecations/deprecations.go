@@ -0,0 +1,216 @@
+package deprecations
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// deprecatedAPI is one entry of the built-in deprecation table: a
+// group/version/resource that Kubernetes has deprecated, the minor release
+// that removes it, and what to migrate to. The table only needs the versions
+// a cluster could still be serving — entries for releases older than the
+// running cluster are skipped automatically because their group/version is no
+// longer discoverable.
+type deprecatedAPI struct {
+	gvr         schema.GroupVersionResource
+	kind        string
+	removedIn   int // Kubernetes minor release (1.x) that removes the API
+	replacement string
+}
+
+// deprecationTable lists the deprecated/removed APIs tracked by
+// deprecated_apis_scan, following the upstream deprecated API migration
+// guide: https://kubernetes.io/docs/reference/using-api/deprecation-guide/
+var deprecationTable = []deprecatedAPI{
+	{schema.GroupVersionResource{Group: "extensions", Version: "v1beta1", Resource: "ingresses"}, "Ingress", 22, "networking.k8s.io/v1 Ingress"},
+	{schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1beta1", Resource: "ingresses"}, "Ingress", 22, "networking.k8s.io/v1 Ingress"},
+	{schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1beta1", Resource: "ingressclasses"}, "IngressClass", 22, "networking.k8s.io/v1 IngressClass"},
+	{schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1beta1", Resource: "customresourcedefinitions"}, "CustomResourceDefinition", 22, "apiextensions.k8s.io/v1 CustomResourceDefinition"},
+	{schema.GroupVersionResource{Group: "admissionregistration.k8s.io", Version: "v1beta1", Resource: "mutatingwebhookconfigurations"}, "MutatingWebhookConfiguration", 22, "admissionregistration.k8s.io/v1 MutatingWebhookConfiguration"},
+	{schema.GroupVersionResource{Group: "admissionregistration.k8s.io", Version: "v1beta1", Resource: "validatingwebhookconfigurations"}, "ValidatingWebhookConfiguration", 22, "admissionregistration.k8s.io/v1 ValidatingWebhookConfiguration"},
+	{schema.GroupVersionResource{Group: "certificates.k8s.io", Version: "v1beta1", Resource: "certificatesigningrequests"}, "CertificateSigningRequest", 22, "certificates.k8s.io/v1 CertificateSigningRequest"},
+	{schema.GroupVersionResource{Group: "coordination.k8s.io", Version: "v1beta1", Resource: "leases"}, "Lease", 22, "coordination.k8s.io/v1 Lease"},
+	{schema.GroupVersionResource{Group: "scheduling.k8s.io", Version: "v1beta1", Resource: "priorityclasses"}, "PriorityClass", 22, "scheduling.k8s.io/v1 PriorityClass"},
+	{schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1beta1", Resource: "csidrivers"}, "CSIDriver", 22, "storage.k8s.io/v1 CSIDriver"},
+	{schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1beta1", Resource: "csinodes"}, "CSINode", 22, "storage.k8s.io/v1 CSINode"},
+	{schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1beta1", Resource: "storageclasses"}, "StorageClass", 22, "storage.k8s.io/v1 StorageClass"},
+	{schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1beta1", Resource: "volumeattachments"}, "VolumeAttachment", 22, "storage.k8s.io/v1 VolumeAttachment"},
+	{schema.GroupVersionResource{Group: "batch", Version: "v1beta1", Resource: "cronjobs"}, "CronJob", 25, "batch/v1 CronJob"},
+	{schema.GroupVersionResource{Group: "discovery.k8s.io", Version: "v1beta1", Resource: "endpointslices"}, "EndpointSlice", 25, "discovery.k8s.io/v1 EndpointSlice"},
+	{schema.GroupVersionResource{Group: "events.k8s.io", Version: "v1beta1", Resource: "events"}, "Event", 25, "events.k8s.io/v1 Event"},
+	{schema.GroupVersionResource{Group: "policy", Version: "v1beta1", Resource: "poddisruptionbudgets"}, "PodDisruptionBudget", 25, "policy/v1 PodDisruptionBudget"},
+	{schema.GroupVersionResource{Group: "policy", Version: "v1beta1", Resource: "podsecuritypolicies"}, "PodSecurityPolicy", 25, "Pod Security Admission (pod-security.kubernetes.io namespace labels)"},
+	{schema.GroupVersionResource{Group: "autoscaling", Version: "v2beta1", Resource: "horizontalpodautoscalers"}, "HorizontalPodAutoscaler", 25, "autoscaling/v2 HorizontalPodAutoscaler"},
+	{schema.GroupVersionResource{Group: "autoscaling", Version: "v2beta2", Resource: "horizontalpodautoscalers"}, "HorizontalPodAutoscaler", 26, "autoscaling/v2 HorizontalPodAutoscaler"},
+	{schema.GroupVersionResource{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta1", Resource: "flowschemas"}, "FlowSchema", 26, "flowcontrol.apiserver.k8s.io/v1 FlowSchema"},
+	{schema.GroupVersionResource{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta1", Resource: "prioritylevelconfigurations"}, "PriorityLevelConfiguration", 26, "flowcontrol.apiserver.k8s.io/v1 PriorityLevelConfiguration"},
+	{schema.GroupVersionResource{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2", Resource: "flowschemas"}, "FlowSchema", 29, "flowcontrol.apiserver.k8s.io/v1 FlowSchema"},
+	{schema.GroupVersionResource{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta2", Resource: "prioritylevelconfigurations"}, "PriorityLevelConfiguration", 29, "flowcontrol.apiserver.k8s.io/v1 PriorityLevelConfiguration"},
+	{schema.GroupVersionResource{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Resource: "flowschemas"}, "FlowSchema", 32, "flowcontrol.apiserver.k8s.io/v1 FlowSchema"},
+	{schema.GroupVersionResource{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Resource: "prioritylevelconfigurations"}, "PriorityLevelConfiguration", 32, "flowcontrol.apiserver.k8s.io/v1 PriorityLevelConfiguration"},
+}
+
+// maxNamesPerAPI caps how many object names are listed per deprecated API so
+// a cluster full of stale objects doesn't flood the result.
+const maxNamesPerAPI = 10
+
+// InitDeprecations returns the deprecated API scanner tool.
+func InitDeprecations() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "deprecated_apis_scan",
+				Description: "Scan the cluster for objects served through deprecated API versions and list the migrations required before the next Kubernetes/OpenShift upgrade. " +
+					"Compares the API versions the cluster still serves against the built-in Kubernetes deprecation table, flags APIs that disappear in the next minor release, and names the affected objects. " +
+					"Standard pre-upgrade homework",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Optional Namespace to restrict the scan of namespaced objects to. If not provided, scans all namespaces",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Deprecated APIs: Scan",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: deprecatedAPIsScan,
+		},
+	}
+}
+
+func deprecatedAPIsScan(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to scan for deprecated APIs: %w", err)), nil
+	}
+
+	serverVersion, err := params.DiscoveryClient().ServerVersion()
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to scan for deprecated APIs: unable to determine server version: %w", err)), nil
+	}
+	minor, err := parseMinor(serverVersion.Minor)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to scan for deprecated APIs: unable to parse server minor version %q: %w", serverVersion.Minor, err)), nil
+	}
+
+	served, err := servedGroupVersions(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to scan for deprecated APIs: %w", err)), nil
+	}
+
+	var nextRelease, later []string
+	for _, entry := range deprecationTable {
+		if !served[entry.gvr.GroupVersion().String()] {
+			continue
+		}
+		client := params.DynamicClient().Resource(entry.gvr)
+		var list *unstructured.UnstructuredList
+		if namespace != "" {
+			list, err = client.Namespace(namespace).List(params.Context, metav1.ListOptions{})
+		} else {
+			list, err = client.List(params.Context, metav1.ListOptions{})
+		}
+		if err != nil {
+			// The group/version is discoverable but not listable (RBAC,
+			// aggregated API hiccup, cluster-scoped resource with a namespace
+			// restriction, ...); report it instead of aborting the scan.
+			later = append(later, fmt.Sprintf("- %s %s (removed in %s): could not list objects: %v",
+				entry.gvr.GroupVersion(), entry.kind, releaseName(entry.removedIn), err))
+			continue
+		}
+		if len(list.Items) == 0 {
+			continue
+		}
+		line := fmt.Sprintf("- %s %s: %d object(s)%s — migrate to %s",
+			entry.gvr.GroupVersion(), entry.kind, len(list.Items), names(list), entry.replacement)
+		if entry.removedIn <= minor+1 {
+			nextRelease = append(nextRelease, line)
+		} else {
+			later = append(later, fmt.Sprintf("%s (removed in %s)", line, releaseName(entry.removedIn)))
+		}
+	}
+	sort.Strings(nextRelease)
+	sort.Strings(later)
+
+	sections := []string{fmt.Sprintf("Cluster is running Kubernetes %s (%s); next release is %s",
+		serverVersion.GitVersion, releaseName(minor), releaseName(minor+1))}
+	if len(nextRelease) > 0 {
+		sections = append(sections, fmt.Sprintf("MUST migrate before upgrading — API gone in %s or earlier (%d):\n%s",
+			releaseName(minor+1), len(nextRelease), strings.Join(nextRelease, "\n")))
+	}
+	if len(later) > 0 {
+		sections = append(sections, fmt.Sprintf("Deprecated, removed in a later release (%d):\n%s", len(later), strings.Join(later, "\n")))
+	}
+	if len(nextRelease) == 0 && len(later) == 0 {
+		sections = append(sections, "No objects found on deprecated API versions")
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// servedGroupVersions returns the set of group/version strings the cluster
+// currently serves, according to discovery.
+func servedGroupVersions(params api.ToolHandlerParams) (map[string]bool, error) {
+	groups, err := params.DiscoveryClient().ServerGroups()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list API groups: %w", err)
+	}
+	served := make(map[string]bool)
+	for _, group := range groups.Groups {
+		for _, version := range group.Versions {
+			served[version.GroupVersion] = true
+		}
+	}
+	return served, nil
+}
+
+// parseMinor parses the discovery minor version, tolerating the "+" suffix
+// some distributions (GKE, OpenShift) append (e.g. "31+").
+func parseMinor(minor string) (int, error) {
+	return strconv.Atoi(strings.TrimSuffix(minor, "+"))
+}
+
+// releaseName renders a Kubernetes minor release with its OpenShift
+// equivalent (OpenShift 4.y ships Kubernetes 1.(y+13)).
+func releaseName(minor int) string {
+	if minor >= 14 {
+		return fmt.Sprintf("Kubernetes 1.%d (OpenShift 4.%d)", minor, minor-13)
+	}
+	return fmt.Sprintf("Kubernetes 1.%d", minor)
+}
+
+// names renders up to maxNamesPerAPI object names from the list, prefixed
+// with their namespace when set.
+func names(list *unstructured.UnstructuredList) string {
+	var rendered []string
+	for i := range list.Items {
+		if len(rendered) == maxNamesPerAPI {
+			rendered = append(rendered, fmt.Sprintf("… and %d more", len(list.Items)-maxNamesPerAPI))
+			break
+		}
+		item := &list.Items[i]
+		if item.GetNamespace() != "" {
+			rendered = append(rendered, item.GetNamespace()+"/"+item.GetName())
+			continue
+		}
+		rendered = append(rendered, item.GetName())
+	}
+	return " (" + strings.Join(rendered, ", ") + ")"
+}
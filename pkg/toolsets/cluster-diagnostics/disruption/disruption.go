@@ -0,0 +1,157 @@
+package disruption
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// InitDisruption returns the pod disruption diagnostic tools.
+func InitDisruption() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "pods_disruption_check",
+				Description: "Evaluate PodDisruptionBudgets and report which pods could currently be evicted safely and which evictions would be blocked by a PDB. " +
+					"Useful for maintenance planning before draining a node",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to evaluate (Optional, all namespaces if not provided)",
+						},
+						"node": {
+							Type:        "string",
+							Description: "Only consider pods scheduled on this node, e.g. the node about to be drained (Optional)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Pods: Disruption Check",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: podsDisruptionCheck,
+		},
+	}
+}
+
+func podsDisruptionCheck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	node := p.OptionalString("node", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check pod disruption budgets: %w", err)), nil
+	}
+
+	budgets, err := params.PolicyV1().PodDisruptionBudgets(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list PodDisruptionBudgets: %w", err)), nil
+	}
+
+	podListOptions := metav1.ListOptions{}
+	if node != "" {
+		podListOptions.FieldSelector = "spec.nodeName=" + node
+	}
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, podListOptions)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods: %w", err)), nil
+	}
+
+	var budgetLines, blocked, guarded, free []string
+	for i := range budgets.Items {
+		pdb := &budgets.Items[i]
+		budgetLines = append(budgetLines, fmt.Sprintf("- %s/%s: %s, %d disruption(s) currently allowed (%d healthy of %d expected)",
+			pdb.Namespace, pdb.Name, pdbRequirement(pdb), pdb.Status.DisruptionsAllowed, pdb.Status.CurrentHealthy, pdb.Status.ExpectedPods))
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning && pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+		pdb := matchingBudget(budgets.Items, pod)
+		switch {
+		case pdb == nil:
+			free = append(free, fmt.Sprintf("- %s/%s (no PDB)", pod.Namespace, pod.Name))
+		case pdb.Status.DisruptionsAllowed > 0:
+			guarded = append(guarded, fmt.Sprintf("- %s/%s (PDB %s allows %d disruption(s) — shared across all its pods)",
+				pod.Namespace, pod.Name, pdb.Name, pdb.Status.DisruptionsAllowed))
+		default:
+			blocked = append(blocked, fmt.Sprintf("- %s/%s (PDB %s allows no disruptions)", pod.Namespace, pod.Name, pdb.Name))
+		}
+	}
+	sort.Strings(blocked)
+	sort.Strings(guarded)
+	sort.Strings(free)
+
+	scope := "the cluster"
+	if namespace != "" {
+		scope = "namespace '" + namespace + "'"
+	}
+	if node != "" {
+		scope += " on node '" + node + "'"
+	}
+	var sections []string
+	if len(budgetLines) == 0 {
+		sections = append(sections, fmt.Sprintf("No PodDisruptionBudgets found in %s", scope))
+	} else {
+		sections = append(sections, fmt.Sprintf("PodDisruptionBudgets (%d):\n%s", len(budgetLines), strings.Join(budgetLines, "\n")))
+	}
+	if len(blocked) > 0 {
+		sections = append(sections, fmt.Sprintf("Evictions currently BLOCKED by a PDB (%d pod(s)):\n%s", len(blocked), strings.Join(blocked, "\n")))
+	}
+	if len(guarded) > 0 {
+		sections = append(sections, fmt.Sprintf("Evictable within a PDB budget (%d pod(s)):\n%s", len(guarded), strings.Join(guarded, "\n")))
+	}
+	if len(free) > 0 {
+		sections = append(sections, fmt.Sprintf("Evictable without PDB constraints (%d pod(s)):\n%s", len(free), strings.Join(free, "\n")))
+	}
+	if len(blocked) == 0 {
+		sections = append(sections, "No evictions are currently blocked: a drain of the evaluated pods should proceed without PDB violations")
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// pdbRequirement renders the minAvailable/maxUnavailable constraint of a PDB.
+func pdbRequirement(pdb *policyv1.PodDisruptionBudget) string {
+	if pdb.Spec.MinAvailable != nil {
+		return "minAvailable=" + pdb.Spec.MinAvailable.String()
+	}
+	if pdb.Spec.MaxUnavailable != nil {
+		return "maxUnavailable=" + pdb.Spec.MaxUnavailable.String()
+	}
+	return "no constraint"
+}
+
+// matchingBudget returns the first PDB in the pod's namespace whose selector
+// matches the pod's labels (the API server rejects overlapping PDBs for
+// eviction anyway, so the first match is authoritative in practice).
+func matchingBudget(budgets []policyv1.PodDisruptionBudget, pod *corev1.Pod) *policyv1.PodDisruptionBudget {
+	for i := range budgets {
+		pdb := &budgets[i]
+		if pdb.Namespace != pod.Namespace {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			return pdb
+		}
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+package idle
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+const (
+	// restoreReplicasAnnotation records the replica count a Deployment had
+	// before idle_workloads_scale_down set it to zero, so it can be restored.
+	restoreReplicasAnnotation = "kubernetes-mcp-server.io/restore-replicas"
+
+	// idleCPUThresholdDefaultMilli is the default CPU usage (millicores,
+	// summed across a Deployment's pods) below which a workload counts as idle.
+	idleCPUThresholdDefaultMilli = 5
+)
+
+// InitIdle returns the idle workload detection and scale-to-zero tools.
+func InitIdle() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "idle_workloads_report",
+				Description: "Find Deployments whose pods use almost no CPU according to the metrics API, candidates for scaling to zero in dev clusters. " +
+					"Usage is a point-in-time sample: re-run over time (or check traffic) before scaling anything down",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to analyze (Optional, all namespaces if not provided)",
+						},
+						"cpuThresholdMilli": {
+							Type:        "integer",
+							Description: "CPU usage in millicores (summed across the Deployment's pods) below which a workload counts as idle (Optional, default: 5)",
+							Minimum:     ptr.To(float64(1)),
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Idle Workloads: Report",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: idleWorkloadsReport,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "idle_workloads_scale_down",
+				Description: "Scale a Deployment to zero replicas, recording the previous replica count in an annotation so idle_workloads_restore can bring it back",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the Deployment to scale to zero",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the Deployment (Optional, current namespace if not provided)",
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Idle Workloads: Scale Down",
+					DestructiveHint: ptr.To(true), // stops all pods of the workload
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: idleWorkloadsScaleDown,
+		},
+		{
+			Tool: api.Tool{
+				Name: "idle_workloads_restore",
+				Description: "Restore Deployments previously scaled to zero by idle_workloads_scale_down to their recorded replica counts. " +
+					"Without a name, lists and restores every annotated Deployment in scope",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the Deployment to restore (Optional, all scaled-down Deployments in scope if not provided)",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the Deployment(s) (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Idle Workloads: Restore",
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: idleWorkloadsRestore,
+		},
+	}
+}
+
+func idleWorkloadsReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	threshold := p.OptionalInt64("cpuThresholdMilli", idleCPUThresholdDefaultMilli)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to report idle workloads: %w", err)), nil
+	}
+
+	deployments, err := params.AppsV1().Deployments(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list deployments: %w", err)), nil
+	}
+	metrics, err := params.MetricsV1beta1Client().PodMetricses(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to read pod metrics (is the metrics server installed?): %w", err)), nil
+	}
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods: %w", err)), nil
+	}
+
+	cpuByPod := make(map[string]int64, len(metrics.Items))
+	for i := range metrics.Items {
+		m := &metrics.Items[i]
+		var cpu int64
+		for j := range m.Containers {
+			cpu += m.Containers[j].Usage.Cpu().MilliValue()
+		}
+		cpuByPod[m.Namespace+"/"+m.Name] = cpu
+	}
+
+	var idle, active []string
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if deployment.Status.Replicas == 0 {
+			continue
+		}
+		selector, selErr := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+		if selErr != nil {
+			continue
+		}
+		var cpu int64
+		measured := 0
+		for j := range pods.Items {
+			pod := &pods.Items[j]
+			if pod.Namespace != deployment.Namespace || pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+			if !selector.Matches(labels.Set(pod.Labels)) {
+				continue
+			}
+			if podCPU, ok := cpuByPod[pod.Namespace+"/"+pod.Name]; ok {
+				cpu += podCPU
+				measured++
+			}
+		}
+		if measured == 0 {
+			continue
+		}
+		line := fmt.Sprintf("- %s/%s: %dm CPU across %d pod(s), %d replica(s)",
+			deployment.Namespace, deployment.Name, cpu, measured, deployment.Status.Replicas)
+		if cpu < threshold {
+			idle = append(idle, line)
+		} else {
+			active = append(active, line)
+		}
+	}
+	sort.Strings(idle)
+
+	scope := "the cluster"
+	if namespace != "" {
+		scope = "namespace '" + namespace + "'"
+	}
+	if len(idle) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No idle deployments in %s (%d measured, threshold %dm CPU)", scope, len(active), threshold), nil), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Idle deployments in %s using less than %dm CPU (%d of %d measured):\n%s\n\nNote: this is a point-in-time sample; confirm the workload receives no traffic before scaling it down with idle_workloads_scale_down.",
+		scope, threshold, len(idle), len(idle)+len(active), strings.Join(idle, "\n")), nil), nil
+}
+
+func idleWorkloadsScaleDown(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to scale down workload: %w", err)), nil
+	}
+	deployment, err := params.AppsV1().Deployments(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get deployment %s/%s: %w", namespace, name, err)), nil
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	if replicas == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("Deployment %s/%s is already scaled to zero", namespace, name), nil), nil
+	}
+	if deployment.Annotations == nil {
+		deployment.Annotations = map[string]string{}
+	}
+	deployment.Annotations[restoreReplicasAnnotation] = strconv.Itoa(int(replicas))
+	deployment.Spec.Replicas = ptr.To(int32(0))
+	if _, err = params.AppsV1().Deployments(namespace).Update(params.Context, deployment, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update deployment %s/%s: %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Deployment %s/%s scaled from %d to 0 replica(s); restore it with idle_workloads_restore", namespace, name, replicas), nil), nil
+}
+
+func idleWorkloadsRestore(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.OptionalString("name", "")
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to restore workloads: %w", err)), nil
+	}
+	if name != "" && namespace == "" {
+		namespace = params.NamespaceOrDefault("")
+	}
+	deployments, err := params.AppsV1().Deployments(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list deployments: %w", err)), nil
+	}
+
+	var restored, failed []string
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if name != "" && deployment.Name != name {
+			continue
+		}
+		recorded, ok := deployment.Annotations[restoreReplicasAnnotation]
+		if !ok {
+			continue
+		}
+		replicas, parseErr := strconv.Atoi(recorded)
+		if parseErr != nil || replicas < 1 {
+			failed = append(failed, fmt.Sprintf("- %s/%s: invalid recorded replica count %q", deployment.Namespace, deployment.Name, recorded))
+			continue
+		}
+		deployment.Spec.Replicas = ptr.To(int32(replicas))
+		delete(deployment.Annotations, restoreReplicasAnnotation)
+		if _, updateErr := params.AppsV1().Deployments(deployment.Namespace).Update(params.Context, deployment, metav1.UpdateOptions{}); updateErr != nil {
+			failed = append(failed, fmt.Sprintf("- %s/%s: %v", deployment.Namespace, deployment.Name, updateErr))
+			continue
+		}
+		restored = append(restored, fmt.Sprintf("- %s/%s restored to %d replica(s)", deployment.Namespace, deployment.Name, replicas))
+	}
+
+	if len(restored) == 0 && len(failed) == 0 {
+		if name != "" {
+			return api.NewToolCallResult("", fmt.Errorf("deployment %s/%s was not scaled down by idle_workloads_scale_down (no %s annotation)", namespace, name, restoreReplicasAnnotation)), nil
+		}
+		return api.NewToolCallResult("No deployments with a recorded replica count to restore", nil), nil
+	}
+	sort.Strings(restored)
+	sort.Strings(failed)
+	result := ""
+	if len(restored) > 0 {
+		result = fmt.Sprintf("Restored %d deployment(s):\n%s", len(restored), strings.Join(restored, "\n"))
+	}
+	if len(failed) > 0 {
+		if result != "" {
+			result += "\n\n"
+		}
+		result += fmt.Sprintf("Failed to restore %d deployment(s):\n%s", len(failed), strings.Join(failed, "\n"))
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
@@ -0,0 +1,244 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var pdbsGVR = schema.GroupVersionResource{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}
+
+// workloadGVRs maps the lintable workload kinds to their resources.
+var workloadGVRs = map[string]schema.GroupVersionResource{
+	"Deployment":  {Group: "apps", Version: "v1", Resource: "deployments"},
+	"StatefulSet": {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	"DaemonSet":   {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	"Pod":         {Group: "", Version: "v1", Resource: "pods"},
+}
+
+// finding is one best-practice violation; severity is "error" for settings
+// that are unsafe and "warning" for settings that degrade reliability.
+type finding struct {
+	severity string
+	message  string
+}
+
+func InitLint() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "manifest_lint",
+				Description: "Lint a workload against built-in best-practice rules (missing liveness/readiness probes, latest or untagged images, " +
+					"missing resource requests and limits, privileged containers, multi-replica workloads without a PodDisruptionBudget) " +
+					"and return the findings with severities. Lints either a provided YAML manifest or a live workload of the cluster",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"manifest": {
+							Type:        "string",
+							Description: "YAML manifest of the workload to lint (Optional, provide either manifest or kind+namespace+name)",
+						},
+						"kind": {
+							Type:        "string",
+							Description: "Kind of the live workload to lint (Optional, used with namespace and name)",
+							Enum:        []any{"Deployment", "StatefulSet", "DaemonSet", "Pod"},
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the live workload to lint (Optional, used with kind and name)",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the live workload to lint (Optional, used with kind and namespace)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Manifest: Lint",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: manifestLint,
+		},
+	}
+}
+
+func manifestLint(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	manifest := p.OptionalString("manifest", "")
+	kind := p.OptionalString("kind", "")
+	namespace := p.OptionalString("namespace", "")
+	name := p.OptionalString("name", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to lint the manifest: %w", err)), nil
+	}
+
+	var workload *unstructured.Unstructured
+	live := false
+	switch {
+	case manifest != "":
+		var parsed map[string]any
+		if err := yaml.Unmarshal([]byte(manifest), &parsed); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse the manifest as YAML: %w", err)), nil
+		}
+		workload = &unstructured.Unstructured{Object: parsed}
+		kind = workload.GetKind()
+	case kind != "" && namespace != "" && name != "":
+		gvr, ok := workloadGVRs[kind]
+		if !ok {
+			return api.NewToolCallResult("", fmt.Errorf("kind %s cannot be linted; supported kinds are Deployment, StatefulSet, DaemonSet, and Pod", kind)), nil
+		}
+		var err error
+		workload, err = params.DynamicClient().Resource(gvr).Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get %s %s/%s: %w", kind, namespace, name, err)), nil
+		}
+		live = true
+	default:
+		return api.NewToolCallResult("", fmt.Errorf("provide either a manifest or all of kind, namespace, and name")), nil
+	}
+
+	findings := lintPodSpec(workload, kind)
+	if live && kind != "Pod" && kind != "DaemonSet" {
+		findings = append(findings, lintDisruptionBudget(params, workload)...)
+	}
+
+	identifier := kind
+	if workload.GetName() != "" {
+		identifier += " " + workload.GetName()
+	}
+	if len(findings) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("%s passes all the lint rules", identifier), nil), nil
+	}
+	var errors, warnings []string
+	for _, f := range findings {
+		line := fmt.Sprintf("- [%s] %s", f.severity, f.message)
+		if f.severity == "error" {
+			errors = append(errors, line)
+		} else {
+			warnings = append(warnings, line)
+		}
+	}
+	sort.Strings(errors)
+	sort.Strings(warnings)
+	return api.NewToolCallResult(fmt.Sprintf("Lint findings for %s (%d error(s), %d warning(s)):\n%s",
+		identifier, len(errors), len(warnings), strings.Join(append(errors, warnings...), "\n")), nil), nil
+}
+
+// podSpec locates the pod spec within the workload object.
+func podSpec(workload *unstructured.Unstructured, kind string) map[string]any {
+	path := []string{"spec", "template", "spec"}
+	switch kind {
+	case "Pod":
+		path = []string{"spec"}
+	case "CronJob":
+		path = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	}
+	spec, _, _ := unstructured.NestedMap(workload.Object, path...)
+	return spec
+}
+
+func lintPodSpec(workload *unstructured.Unstructured, kind string) []finding {
+	spec := podSpec(workload, kind)
+	if spec == nil {
+		return []finding{{severity: "error", message: "the object has no pod spec; only workload kinds can be linted"}}
+	}
+	var findings []finding
+	containers, _, _ := unstructured.NestedSlice(spec, "containers")
+	for _, raw := range containers {
+		container, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		containerName, _ := container["name"].(string)
+
+		if _, found, _ := unstructured.NestedMap(container, "livenessProbe"); !found {
+			findings = append(findings, finding{"warning", fmt.Sprintf("container %s has no liveness probe; a wedged process will never be restarted", containerName)})
+		}
+		if _, found, _ := unstructured.NestedMap(container, "readinessProbe"); !found {
+			findings = append(findings, finding{"warning", fmt.Sprintf("container %s has no readiness probe; traffic reaches it before it can serve", containerName)})
+		}
+
+		image, _ := container["image"].(string)
+		tag := imageTag(image)
+		if tag == "" {
+			findings = append(findings, finding{"warning", fmt.Sprintf("container %s uses untagged image %s, which implicitly means latest; pin a tag or digest", containerName, image)})
+		} else if tag == "latest" {
+			findings = append(findings, finding{"warning", fmt.Sprintf("container %s uses the latest tag of %s; pin a tag or digest so rollbacks and node restarts are reproducible", containerName, image)})
+		}
+
+		if _, found, _ := unstructured.NestedMap(container, "resources", "requests"); !found {
+			findings = append(findings, finding{"warning", fmt.Sprintf("container %s has no resource requests; the scheduler cannot place it correctly", containerName)})
+		}
+		if _, found, _ := unstructured.NestedMap(container, "resources", "limits"); !found {
+			findings = append(findings, finding{"warning", fmt.Sprintf("container %s has no resource limits; it can starve its node", containerName)})
+		}
+
+		if privileged, _, _ := unstructured.NestedBool(container, "securityContext", "privileged"); privileged {
+			findings = append(findings, finding{"error", fmt.Sprintf("container %s runs privileged, which grants full access to the node", containerName)})
+		}
+	}
+	return findings
+}
+
+// imageTag returns the tag of an image reference, "" when no tag is set, and
+// "latest" semantics are left to the caller. Digest-pinned images report
+// their digest as the tag, which never equals latest.
+func imageTag(image string) string {
+	if _, digest, found := strings.Cut(image, "@"); found {
+		return digest
+	}
+	// Only a colon after the last slash separates a tag (avoids the registry port).
+	lastSegment := image[strings.LastIndex(image, "/")+1:]
+	if _, tag, found := strings.Cut(lastSegment, ":"); found {
+		return tag
+	}
+	return ""
+}
+
+// lintDisruptionBudget checks that a multi-replica workload is covered by a
+// PodDisruptionBudget so that voluntary disruptions (drains, upgrades) do not
+// take down all the replicas at once.
+func lintDisruptionBudget(params api.ToolHandlerParams, workload *unstructured.Unstructured) []finding {
+	replicas, found, _ := unstructured.NestedInt64(workload.Object, "spec", "replicas")
+	if !found || replicas < 2 {
+		return nil
+	}
+	selector, _, _ := unstructured.NestedStringMap(workload.Object, "spec", "selector", "matchLabels")
+	if len(selector) == 0 {
+		return nil
+	}
+	pdbs, err := params.DynamicClient().Resource(pdbsGVR).Namespace(workload.GetNamespace()).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return []finding{{"warning", fmt.Sprintf("the PodDisruptionBudgets could not be listed (%v); the PDB coverage rule was skipped", err)}}
+	}
+	for i := range pdbs.Items {
+		pdbSelector, _, _ := unstructured.NestedStringMap(pdbs.Items[i].Object, "spec", "selector", "matchLabels")
+		if len(pdbSelector) == 0 {
+			continue
+		}
+		matches := true
+		for key, value := range pdbSelector {
+			if selector[key] != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return nil
+		}
+	}
+	return []finding{{"warning", fmt.Sprintf("the workload runs %d replicas but no PodDisruptionBudget covers it; a node drain can evict all replicas at once", replicas)}}
+}
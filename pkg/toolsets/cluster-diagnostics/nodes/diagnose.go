@@ -0,0 +1,239 @@
+package nodes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	nodesGVR  = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}
+	podsGVR   = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
+	eventsGVR = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "events"}
+)
+
+// defaultNodeConditions are the conditions every kubelet reports; anything
+// else on a node comes from an additional problem reporter such as the Node
+// Problem Detector.
+var defaultNodeConditions = map[string]bool{
+	"Ready":              true,
+	"MemoryPressure":     true,
+	"DiskPressure":       true,
+	"PIDPressure":        true,
+	"NetworkUnavailable": true,
+}
+
+func InitDiagnose() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "node_diagnose",
+				Description: "Diagnose a node by aggregating its conditions (including kubelet pressure and Node Problem Detector conditions), taints, " +
+					"recent Node events, memory overcommit, and the pods on the node that were OOM-killed or run without memory limits, " +
+					"to answer why the node is NotReady or unhealthy",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"node": {
+							Type:        "string",
+							Description: "Name of the node to diagnose",
+						},
+					},
+					Required: []string{"node"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Nodes: Diagnose",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: nodeDiagnose,
+		},
+	}
+}
+
+func nodeDiagnose(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	nodeName := p.RequiredString("node")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diagnose the node: %w", err)), nil
+	}
+
+	node, err := params.DynamicClient().Resource(nodesGVR).Get(params.Context, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get node %s: %w", nodeName, err)), nil
+	}
+
+	sections := []string{
+		conditionsSection(node),
+		taintsSection(node),
+	}
+	if section := nodeEventsSection(params, nodeName); section != "" {
+		sections = append(sections, section)
+	}
+	if section := podsSection(params, node); section != "" {
+		sections = append(sections, section)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Diagnosis of node %s:\n\n%s", nodeName, strings.Join(sections, "\n\n")), nil), nil
+}
+
+// conditionsSection reports the node conditions, flagging unhealthy values
+// and conditions contributed by the Node Problem Detector.
+func conditionsSection(node *unstructured.Unstructured) string {
+	var lines []string
+	conditions, _, _ := unstructured.NestedSlice(node.Object, "status", "conditions")
+	for _, raw := range conditions {
+		condition, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		conditionType, _ := condition["type"].(string)
+		status, _ := condition["status"].(string)
+		reason, _ := condition["reason"].(string)
+		message, _ := condition["message"].(string)
+
+		// Ready should be True, every other condition should be False.
+		healthy := status == "False"
+		if conditionType == "Ready" {
+			healthy = status == "True"
+		}
+		line := fmt.Sprintf("- %s: %s", conditionType, status)
+		if !defaultNodeConditions[conditionType] {
+			line += " (problem detector)"
+		}
+		if !healthy {
+			line = fmt.Sprintf("- [PROBLEM] %s: %s (%s: %s)", conditionType, status, reason, message)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return "Conditions: the kubelet has not reported any; the node may never have registered properly"
+	}
+	return "Conditions:\n" + strings.Join(lines, "\n")
+}
+
+func taintsSection(node *unstructured.Unstructured) string {
+	taints, _, _ := unstructured.NestedSlice(node.Object, "spec", "taints")
+	if len(taints) == 0 {
+		return "Taints: none"
+	}
+	var lines []string
+	for _, raw := range taints {
+		taint, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		key, _ := taint["key"].(string)
+		value, _ := taint["value"].(string)
+		effect, _ := taint["effect"].(string)
+		line := fmt.Sprintf("- %s=%s:%s", key, value, effect)
+		if value == "" {
+			line = fmt.Sprintf("- %s:%s", key, effect)
+		}
+		lines = append(lines, line)
+	}
+	return "Taints:\n" + strings.Join(lines, "\n")
+}
+
+// nodeEventsSection reports the recent events attached to the Node object,
+// which is where the kubelet and the Node Problem Detector (e.g. OOMKilling,
+// TaskHung, KernelOops) surface incidents.
+func nodeEventsSection(params api.ToolHandlerParams, nodeName string) string {
+	events, err := params.DynamicClient().Resource(eventsGVR).List(params.Context, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Node,involvedObject.name=" + nodeName,
+	})
+	if err != nil {
+		return fmt.Sprintf("Recent node events: could not be listed (%v)", err)
+	}
+	if len(events.Items) == 0 {
+		return ""
+	}
+	var lines []string
+	for i := range events.Items {
+		event := &events.Items[i]
+		eventType, _, _ := unstructured.NestedString(event.Object, "type")
+		reason, _, _ := unstructured.NestedString(event.Object, "reason")
+		message, _, _ := unstructured.NestedString(event.Object, "message")
+		timestamp, _, _ := unstructured.NestedString(event.Object, "lastTimestamp")
+		lines = append(lines, fmt.Sprintf("- %s [%s] %s: %s", timestamp, eventType, reason, message))
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(lines)))
+	return fmt.Sprintf("Recent node events (%d):\n%s", len(lines), strings.Join(lines, "\n"))
+}
+
+// podsSection reports memory overcommit against the node's allocatable memory
+// and the pods on the node that were OOM-killed or run containers without a
+// memory limit.
+func podsSection(params api.ToolHandlerParams, node *unstructured.Unstructured) string {
+	pods, err := params.DynamicClient().Resource(podsGVR).List(params.Context, metav1.ListOptions{
+		FieldSelector: "spec.nodeName=" + node.GetName(),
+	})
+	if err != nil {
+		return fmt.Sprintf("Pods on the node: could not be listed (%v)", err)
+	}
+
+	var limitsSum resource.Quantity
+	var unlimited, oomKilled []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		podRef := pod.GetNamespace() + "/" + pod.GetName()
+		containers, _, _ := unstructured.NestedSlice(pod.Object, "spec", "containers")
+		for _, raw := range containers {
+			container, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			containerName, _ := container["name"].(string)
+			memoryLimit, _, _ := unstructured.NestedString(container, "resources", "limits", "memory")
+			if memoryLimit == "" {
+				unlimited = append(unlimited, fmt.Sprintf("- %s container %s has no memory limit", podRef, containerName))
+				continue
+			}
+			if quantity, err := resource.ParseQuantity(memoryLimit); err == nil {
+				limitsSum.Add(quantity)
+			}
+		}
+		statuses, _, _ := unstructured.NestedSlice(pod.Object, "status", "containerStatuses")
+		for _, raw := range statuses {
+			status, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			reason, _, _ := unstructured.NestedString(status, "lastState", "terminated", "reason")
+			if reason == "OOMKilled" {
+				containerName, _ := status["name"].(string)
+				restarts, _ := status["restartCount"].(int64)
+				oomKilled = append(oomKilled, fmt.Sprintf("- %s container %s was OOM-killed (%d restart(s))", podRef, containerName, restarts))
+			}
+		}
+	}
+
+	lines := []string{fmt.Sprintf("- %d pod(s) scheduled on the node", len(pods.Items))}
+	if allocatable, found, _ := unstructured.NestedString(node.Object, "status", "allocatable", "memory"); found {
+		if allocatableQuantity, err := resource.ParseQuantity(allocatable); err == nil && !allocatableQuantity.IsZero() {
+			ratio := float64(limitsSum.Value()) / float64(allocatableQuantity.Value())
+			lines = append(lines, fmt.Sprintf("- memory limits total %s against %s allocatable (%.0f%% overcommit)", limitsSum.String(), allocatable, ratio*100))
+		}
+	}
+	if len(oomKilled) > 0 {
+		sort.Strings(oomKilled)
+		lines = append(lines, oomKilled...)
+	}
+	if len(unlimited) > 0 {
+		sort.Strings(unlimited)
+		lines = append(lines, fmt.Sprintf("- %d container(s) without a memory limit can consume node memory unbounded:", len(unlimited)))
+		lines = append(lines, unlimited...)
+	}
+	return "Pods on the node:\n" + strings.Join(lines, "\n")
+}
@@ -0,0 +1,295 @@
+package orphans
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// wellKnownConfigMaps are injected into every namespace by the platform and
+// are never referenced by pod specs, so they are excluded from the report.
+var wellKnownConfigMaps = map[string]bool{
+	"kube-root-ca.crt":         true,
+	"openshift-service-ca.crt": true,
+}
+
+// skippedSecretTypes are secret types that are managed by controllers rather
+// than referenced by workloads (service account tokens, Helm release storage,
+// TLS certificates issued for services).
+var skippedSecretTypes = map[corev1.SecretType]bool{
+	corev1.SecretTypeServiceAccountToken: true,
+	"helm.sh/release.v1":                 true,
+}
+
+// InitOrphans returns the orphaned resource detection tool.
+func InitOrphans() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "orphaned_resources_report",
+				Description: "Find likely-orphaned resources that are candidates for cleanup: ReplicaSets scaled to zero (old rollout leftovers), " +
+					"PersistentVolumeClaims not mounted by any pod, Services without endpoints, and ConfigMaps/Secrets not referenced by any pod or ServiceAccount. " +
+					"References from CustomResources or operators are not detected, so review each candidate before deleting it",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to analyze (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Orphaned Resources: Report",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: orphanedResourcesReport,
+		},
+	}
+}
+
+func orphanedResourcesReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to report orphaned resources: %w", err)), nil
+	}
+
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods: %w", err)), nil
+	}
+	serviceAccounts, err := params.CoreV1().ServiceAccounts(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list service accounts: %w", err)), nil
+	}
+	referencedConfigMaps, referencedSecrets, referencedClaims := referencedNames(pods, serviceAccounts)
+
+	var sections []string
+	if section, sectionErr := orphanedReplicaSets(params, namespace); sectionErr != nil {
+		return api.NewToolCallResult("", sectionErr), nil
+	} else if section != "" {
+		sections = append(sections, section)
+	}
+	if section, sectionErr := orphanedClaims(params, namespace, referencedClaims); sectionErr != nil {
+		return api.NewToolCallResult("", sectionErr), nil
+	} else if section != "" {
+		sections = append(sections, section)
+	}
+	if section, sectionErr := servicesWithoutEndpoints(params, namespace); sectionErr != nil {
+		return api.NewToolCallResult("", sectionErr), nil
+	} else if section != "" {
+		sections = append(sections, section)
+	}
+	if section, sectionErr := unreferencedConfigMaps(params, namespace, referencedConfigMaps); sectionErr != nil {
+		return api.NewToolCallResult("", sectionErr), nil
+	} else if section != "" {
+		sections = append(sections, section)
+	}
+	if section, sectionErr := unreferencedSecrets(params, namespace, referencedSecrets); sectionErr != nil {
+		return api.NewToolCallResult("", sectionErr), nil
+	} else if section != "" {
+		sections = append(sections, section)
+	}
+
+	scope := "the cluster"
+	if namespace != "" {
+		scope = "namespace '" + namespace + "'"
+	}
+	if len(sections) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No orphaned resources found in %s", scope), nil), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Likely-orphaned resources in %s:\n\n%s\n\nNote: references from CustomResources or operators are not detected; review each candidate before deleting it.",
+		scope, strings.Join(sections, "\n\n")), nil), nil
+}
+
+// referencedNames collects the namespaced names ("namespace/name") of every
+// ConfigMap, Secret, and PersistentVolumeClaim referenced by the given pods
+// and service accounts.
+func referencedNames(pods *corev1.PodList, serviceAccounts *corev1.ServiceAccountList) (configMaps, secrets, claims map[string]bool) {
+	configMaps, secrets, claims = map[string]bool{}, map[string]bool{}, map[string]bool{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		key := func(name string) string { return pod.Namespace + "/" + name }
+		for j := range pod.Spec.Volumes {
+			volume := &pod.Spec.Volumes[j]
+			switch {
+			case volume.ConfigMap != nil:
+				configMaps[key(volume.ConfigMap.Name)] = true
+			case volume.Secret != nil:
+				secrets[key(volume.Secret.SecretName)] = true
+			case volume.PersistentVolumeClaim != nil:
+				claims[key(volume.PersistentVolumeClaim.ClaimName)] = true
+			case volume.Projected != nil:
+				for k := range volume.Projected.Sources {
+					source := &volume.Projected.Sources[k]
+					if source.ConfigMap != nil {
+						configMaps[key(source.ConfigMap.Name)] = true
+					}
+					if source.Secret != nil {
+						secrets[key(source.Secret.Name)] = true
+					}
+				}
+			}
+		}
+		containers := make([]corev1.Container, 0, len(pod.Spec.Containers)+len(pod.Spec.InitContainers))
+		containers = append(containers, pod.Spec.Containers...)
+		containers = append(containers, pod.Spec.InitContainers...)
+		for j := range containers {
+			container := &containers[j]
+			for k := range container.EnvFrom {
+				if container.EnvFrom[k].ConfigMapRef != nil {
+					configMaps[key(container.EnvFrom[k].ConfigMapRef.Name)] = true
+				}
+				if container.EnvFrom[k].SecretRef != nil {
+					secrets[key(container.EnvFrom[k].SecretRef.Name)] = true
+				}
+			}
+			for k := range container.Env {
+				if valueFrom := container.Env[k].ValueFrom; valueFrom != nil {
+					if valueFrom.ConfigMapKeyRef != nil {
+						configMaps[key(valueFrom.ConfigMapKeyRef.Name)] = true
+					}
+					if valueFrom.SecretKeyRef != nil {
+						secrets[key(valueFrom.SecretKeyRef.Name)] = true
+					}
+				}
+			}
+		}
+		for j := range pod.Spec.ImagePullSecrets {
+			secrets[key(pod.Spec.ImagePullSecrets[j].Name)] = true
+		}
+	}
+	for i := range serviceAccounts.Items {
+		serviceAccount := &serviceAccounts.Items[i]
+		for j := range serviceAccount.Secrets {
+			secrets[serviceAccount.Namespace+"/"+serviceAccount.Secrets[j].Name] = true
+		}
+		for j := range serviceAccount.ImagePullSecrets {
+			secrets[serviceAccount.Namespace+"/"+serviceAccount.ImagePullSecrets[j].Name] = true
+		}
+	}
+	return configMaps, secrets, claims
+}
+
+func orphanedReplicaSets(params api.ToolHandlerParams, namespace string) (string, error) {
+	replicaSets, err := params.AppsV1().ReplicaSets(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list replica sets: %w", err)
+	}
+	var lines []string
+	for i := range replicaSets.Items {
+		replicaSet := &replicaSets.Items[i]
+		if (replicaSet.Spec.Replicas != nil && *replicaSet.Spec.Replicas != 0) || replicaSet.Status.Replicas != 0 {
+			continue
+		}
+		owner := "no owner"
+		for j := range replicaSet.OwnerReferences {
+			owner = fmt.Sprintf("owned by %s %s", replicaSet.OwnerReferences[j].Kind, replicaSet.OwnerReferences[j].Name)
+		}
+		lines = append(lines, fmt.Sprintf("- %s/%s (%s, age %s)", replicaSet.Namespace, replicaSet.Name, owner,
+			time.Since(replicaSet.CreationTimestamp.Time).Round(time.Minute)))
+	}
+	return section("ReplicaSets scaled to zero (old rollout revisions)", lines), nil
+}
+
+func orphanedClaims(params api.ToolHandlerParams, namespace string, referenced map[string]bool) (string, error) {
+	claims, err := params.CoreV1().PersistentVolumeClaims(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list persistent volume claims: %w", err)
+	}
+	var lines []string
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if referenced[claim.Namespace+"/"+claim.Name] {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("- %s/%s (phase %s)", claim.Namespace, claim.Name, claim.Status.Phase))
+	}
+	return section("PersistentVolumeClaims not mounted by any pod", lines), nil
+}
+
+func servicesWithoutEndpoints(params api.ToolHandlerParams, namespace string) (string, error) {
+	services, err := params.CoreV1().Services(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list services: %w", err)
+	}
+	endpoints, err := params.CoreV1().Endpoints(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list endpoints: %w", err)
+	}
+	ready := map[string]bool{}
+	for i := range endpoints.Items {
+		for j := range endpoints.Items[i].Subsets {
+			if len(endpoints.Items[i].Subsets[j].Addresses) > 0 {
+				ready[endpoints.Items[i].Namespace+"/"+endpoints.Items[i].Name] = true
+			}
+		}
+	}
+	var lines []string
+	for i := range services.Items {
+		service := &services.Items[i]
+		// ExternalName services have no endpoints by design.
+		if service.Spec.Type == corev1.ServiceTypeExternalName || service.Name == "kubernetes" {
+			continue
+		}
+		if ready[service.Namespace+"/"+service.Name] {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("- %s/%s (type %s, selector %v)", service.Namespace, service.Name, service.Spec.Type, service.Spec.Selector))
+	}
+	return section("Services without ready endpoints", lines), nil
+}
+
+func unreferencedConfigMaps(params api.ToolHandlerParams, namespace string, referenced map[string]bool) (string, error) {
+	configMaps, err := params.CoreV1().ConfigMaps(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list config maps: %w", err)
+	}
+	var lines []string
+	for i := range configMaps.Items {
+		configMap := &configMaps.Items[i]
+		if wellKnownConfigMaps[configMap.Name] || referenced[configMap.Namespace+"/"+configMap.Name] {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("- %s/%s (%d key(s))", configMap.Namespace, configMap.Name, len(configMap.Data)+len(configMap.BinaryData)))
+	}
+	return section("ConfigMaps not referenced by any pod", lines), nil
+}
+
+func unreferencedSecrets(params api.ToolHandlerParams, namespace string, referenced map[string]bool) (string, error) {
+	secrets, err := params.CoreV1().Secrets(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list secrets: %w", err)
+	}
+	var lines []string
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if skippedSecretTypes[secret.Type] || referenced[secret.Namespace+"/"+secret.Name] {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("- %s/%s (type %s, %d key(s))", secret.Namespace, secret.Name, secret.Type, len(secret.Data)))
+	}
+	return section("Secrets not referenced by any pod or ServiceAccount", lines), nil
+}
+
+func section(title string, lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	sort.Strings(lines)
+	return fmt.Sprintf("%s (%d):\n%s", title, len(lines), strings.Join(lines, "\n"))
+}
@@ -0,0 +1,278 @@
+package podsecurity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// Pod Security Admission namespace labels, see
+// https://kubernetes.io/docs/concepts/security/pod-security-admission/
+const (
+	enforceLabel = "pod-security.kubernetes.io/enforce"
+	auditLabel   = "pod-security.kubernetes.io/audit"
+	warnLabel    = "pod-security.kubernetes.io/warn"
+)
+
+// InitPodSecurity returns the Pod Security Admission diagnostic tools.
+func InitPodSecurity() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "pod_security_report",
+				Description: "Report Pod Security Admission levels: without arguments, list each namespace's enforce/audit/warn labels. " +
+					"With a namespace, evaluate its running pods against the enforced level and list the violating fields. " +
+					"With a podSpec, evaluate the provided pod manifest instead",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to analyze; its running pods are evaluated against the enforced level (Optional)",
+						},
+						"podSpec": {
+							Type:        "string",
+							Description: "Pod manifest (YAML or JSON) to evaluate instead of existing workloads (Optional)",
+						},
+						"level": {
+							Type:        "string",
+							Description: "Pod Security level to evaluate against, overriding the namespace's enforce label (Optional)",
+							Enum:        []any{"privileged", "baseline", "restricted"},
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Pod Security: Report",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: podSecurityReport,
+		},
+	}
+}
+
+func podSecurityReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	podSpec := p.OptionalString("podSpec", "")
+	level := p.OptionalString("level", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to report pod security: %w", err)), nil
+	}
+
+	if namespace == "" && podSpec == "" {
+		return namespaceLabelReport(params)
+	}
+
+	if level == "" && namespace != "" {
+		ns, err := params.CoreV1().Namespaces().Get(params.Context, namespace, metav1.GetOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get namespace %s: %w", namespace, err)), nil
+		}
+		level = ns.Labels[enforceLabel]
+	}
+	if level == "" {
+		// Unlabeled namespaces default to privileged (no enforcement); use
+		// restricted so the evaluation still tells the caller what would
+		// break under the strictest level.
+		level = "restricted"
+	}
+	if level == "privileged" {
+		return api.NewToolCallResult("The privileged level imposes no restrictions: every pod is allowed", nil), nil
+	}
+
+	if podSpec != "" {
+		var pod corev1.Pod
+		if err := yaml.Unmarshal([]byte(podSpec), &pod); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse podSpec: %w", err)), nil
+		}
+		violations := evaluate(&pod.Spec, level)
+		name := pod.Name
+		if name == "" {
+			name = "the provided pod"
+		}
+		if len(violations) == 0 {
+			return api.NewToolCallResult(fmt.Sprintf("%s conforms to the %s level", name, level), nil), nil
+		}
+		return api.NewToolCallResult(fmt.Sprintf("%s violates the %s level:\n- %s", name, level, strings.Join(violations, "\n- ")), nil), nil
+	}
+
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)), nil
+	}
+	var lines []string
+	conforming := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		violations := evaluate(&pod.Spec, level)
+		if len(violations) == 0 {
+			conforming++
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("- %s:\n    %s", pod.Name, strings.Join(violations, "\n    ")))
+	}
+	sort.Strings(lines)
+	if len(lines) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("All %d pod(s) in namespace %s conform to the %s level", conforming, namespace, level), nil), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Pods in namespace %s violating the %s level (%d of %d):\n%s",
+		namespace, level, len(lines), len(pods.Items), strings.Join(lines, "\n")), nil), nil
+}
+
+// namespaceLabelReport lists every namespace's Pod Security Admission labels.
+func namespaceLabelReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespaces, err := params.CoreV1().Namespaces().List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list namespaces: %w", err)), nil
+	}
+	var lines []string
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		enforce := ns.Labels[enforceLabel]
+		if enforce == "" {
+			enforce = "privileged (default, no label)"
+		}
+		extra := ""
+		if audit := ns.Labels[auditLabel]; audit != "" {
+			extra += ", audit: " + audit
+		}
+		if warn := ns.Labels[warnLabel]; warn != "" {
+			extra += ", warn: " + warn
+		}
+		lines = append(lines, fmt.Sprintf("- %s (enforce: %s%s)", ns.Name, enforce, extra))
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Pod Security Admission levels per namespace (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+// evaluate returns the Pod Security violations of a pod spec against the
+// baseline or restricted level. The checks cover the fields the Pod Security
+// Standards call out most often; they are a diagnostic approximation, the API
+// server's admission verdict is authoritative.
+func evaluate(spec *corev1.PodSpec, level string) []string {
+	violations := baselineViolations(spec)
+	if level == "restricted" {
+		violations = append(violations, restrictedViolations(spec)...)
+	}
+	sort.Strings(violations)
+	return violations
+}
+
+func baselineViolations(spec *corev1.PodSpec) []string {
+	var violations []string
+	if spec.HostNetwork {
+		violations = append(violations, "spec.hostNetwork is true")
+	}
+	if spec.HostPID {
+		violations = append(violations, "spec.hostPID is true")
+	}
+	if spec.HostIPC {
+		violations = append(violations, "spec.hostIPC is true")
+	}
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			violations = append(violations, fmt.Sprintf("volume %q uses hostPath", volume.Name))
+		}
+	}
+	forEachContainer(spec, func(container *corev1.Container) {
+		sc := container.SecurityContext
+		if sc != nil && sc.Privileged != nil && *sc.Privileged {
+			violations = append(violations, fmt.Sprintf("container %q is privileged", container.Name))
+		}
+		if sc != nil && sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				if capability != "NET_BIND_SERVICE" {
+					violations = append(violations, fmt.Sprintf("container %q adds capability %s", container.Name, capability))
+				}
+			}
+		}
+		for _, port := range container.Ports {
+			if port.HostPort != 0 {
+				violations = append(violations, fmt.Sprintf("container %q uses hostPort %d", container.Name, port.HostPort))
+			}
+		}
+	})
+	return violations
+}
+
+// restrictedVolumeAllowed reports whether a volume uses one of the volume
+// types the restricted level allows.
+func restrictedVolumeAllowed(volume *corev1.Volume) bool {
+	return volume.ConfigMap != nil || volume.CSI != nil || volume.DownwardAPI != nil ||
+		volume.EmptyDir != nil || volume.Ephemeral != nil || volume.PersistentVolumeClaim != nil ||
+		volume.Projected != nil || volume.Secret != nil
+}
+
+func restrictedViolations(spec *corev1.PodSpec) []string {
+	var violations []string
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			continue // already reported by the baseline checks
+		}
+		volume := volume
+		if !restrictedVolumeAllowed(&volume) {
+			violations = append(violations, fmt.Sprintf("volume %q uses a volume type the restricted level forbids", volume.Name))
+		}
+	}
+	podRunAsNonRoot := spec.SecurityContext != nil && spec.SecurityContext.RunAsNonRoot != nil && *spec.SecurityContext.RunAsNonRoot
+	podSeccomp := spec.SecurityContext != nil && spec.SecurityContext.SeccompProfile != nil && seccompAllowed(spec.SecurityContext.SeccompProfile)
+	forEachContainer(spec, func(container *corev1.Container) {
+		sc := container.SecurityContext
+		if sc == nil || sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+			violations = append(violations, fmt.Sprintf("container %q must set allowPrivilegeEscalation: false", container.Name))
+		}
+		if sc == nil || sc.Capabilities == nil || !dropsAll(sc.Capabilities.Drop) {
+			violations = append(violations, fmt.Sprintf("container %q must drop the ALL capability", container.Name))
+		}
+		runAsNonRoot := podRunAsNonRoot
+		if sc != nil && sc.RunAsNonRoot != nil {
+			runAsNonRoot = *sc.RunAsNonRoot
+		}
+		if !runAsNonRoot {
+			violations = append(violations, fmt.Sprintf("container %q must set runAsNonRoot: true (on the container or pod securityContext)", container.Name))
+		}
+		seccomp := podSeccomp
+		if sc != nil && sc.SeccompProfile != nil {
+			seccomp = seccompAllowed(sc.SeccompProfile)
+		}
+		if !seccomp {
+			violations = append(violations, fmt.Sprintf("container %q must set a RuntimeDefault or Localhost seccompProfile (on the container or pod securityContext)", container.Name))
+		}
+	})
+	return violations
+}
+
+func seccompAllowed(profile *corev1.SeccompProfile) bool {
+	return profile.Type == corev1.SeccompProfileTypeRuntimeDefault || profile.Type == corev1.SeccompProfileTypeLocalhost
+}
+
+func dropsAll(drop []corev1.Capability) bool {
+	for _, capability := range drop {
+		if capability == "ALL" {
+			return true
+		}
+	}
+	return false
+}
+
+// forEachContainer visits every init and regular container of a pod spec.
+func forEachContainer(spec *corev1.PodSpec, visit func(*corev1.Container)) {
+	for i := range spec.InitContainers {
+		visit(&spec.InitContainers[i])
+	}
+	for i := range spec.Containers {
+		visit(&spec.Containers[i])
+	}
+}
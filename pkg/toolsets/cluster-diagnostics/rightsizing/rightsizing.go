@@ -0,0 +1,212 @@
+package rightsizing
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+const (
+	// overProvisionedThreshold marks a workload as over-provisioned when its
+	// usage stays below this fraction of its requests.
+	overProvisionedThreshold = 0.3
+	// underProvisionedThreshold marks a workload as under-provisioned when
+	// its usage exceeds this fraction of its requests.
+	underProvisionedThreshold = 0.9
+)
+
+// InitRightsizing returns the resource right-sizing diagnostic tools.
+func InitRightsizing() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "rightsizing_report",
+				Description: "Compare workload CPU and memory requests with current usage from the metrics API and report over- and under-provisioned workloads with right-sizing recommendations. " +
+					"Usage is a point-in-time sample; confirm findings over a longer window before changing requests",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to analyze (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Right-Sizing: Report",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: rightsizingReport,
+		},
+	}
+}
+
+// workloadUsage accumulates requests and observed usage across all pods of a
+// workload.
+type workloadUsage struct {
+	pods          int
+	cpuRequest    int64 // millicores
+	cpuUsage      int64 // millicores
+	memoryRequest int64 // bytes
+	memoryUsage   int64 // bytes
+	noRequests    bool
+}
+
+func rightsizingReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to build right-sizing report: %w", err)), nil
+	}
+
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods: %w", err)), nil
+	}
+	metrics, err := params.MetricsV1beta1Client().PodMetricses(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to read pod metrics (is the metrics server installed?): %w", err)), nil
+	}
+
+	// Index usage by namespace/pod, summed across containers.
+	type usage struct{ cpu, memory int64 }
+	usageByPod := make(map[string]usage, len(metrics.Items))
+	for i := range metrics.Items {
+		m := &metrics.Items[i]
+		var u usage
+		for j := range m.Containers {
+			u.cpu += m.Containers[j].Usage.Cpu().MilliValue()
+			u.memory += m.Containers[j].Usage.Memory().Value()
+		}
+		usageByPod[m.Namespace+"/"+m.Name] = u
+	}
+
+	// Resolve ReplicaSet owners to their Deployments so replicas group under
+	// the workload the user actually manages.
+	replicaSetOwner := make(map[string]string)
+	if replicaSets, rsErr := params.AppsV1().ReplicaSets(namespace).List(params.Context, metav1.ListOptions{}); rsErr == nil {
+		for i := range replicaSets.Items {
+			rs := &replicaSets.Items[i]
+			if owner := metav1.GetControllerOf(rs); owner != nil {
+				replicaSetOwner[rs.Namespace+"/"+rs.Name] = fmt.Sprintf("%s %s/%s", owner.Kind, rs.Namespace, owner.Name)
+			}
+		}
+	}
+
+	workloads := make(map[string]*workloadUsage)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		u, measured := usageByPod[pod.Namespace+"/"+pod.Name]
+		if !measured {
+			continue
+		}
+		key := workloadKey(pod, replicaSetOwner)
+		w := workloads[key]
+		if w == nil {
+			w = &workloadUsage{}
+			workloads[key] = w
+		}
+		w.pods++
+		w.cpuUsage += u.cpu
+		w.memoryUsage += u.memory
+		for j := range pod.Spec.Containers {
+			requests := pod.Spec.Containers[j].Resources.Requests
+			w.cpuRequest += requests.Cpu().MilliValue()
+			w.memoryRequest += requests.Memory().Value()
+		}
+		if w.cpuRequest == 0 && w.memoryRequest == 0 {
+			w.noRequests = true
+		}
+	}
+
+	var overProvisioned, underProvisioned, unrequested []string
+	for key, w := range workloads {
+		if w.noRequests {
+			unrequested = append(unrequested, fmt.Sprintf("- %s: no requests set (using %dm CPU, %s memory across %d pod(s)) — set requests so the scheduler and this report can reason about it",
+				key, w.cpuUsage, formatBytes(w.memoryUsage), w.pods))
+			continue
+		}
+		cpuRatio := ratio(w.cpuUsage, w.cpuRequest)
+		memoryRatio := ratio(w.memoryUsage, w.memoryRequest)
+		line := fmt.Sprintf("- %s: CPU %dm used of %dm requested (%.0f%%), memory %s used of %s requested (%.0f%%), %d pod(s)",
+			key, w.cpuUsage, w.cpuRequest, cpuRatio*100, formatBytes(w.memoryUsage), formatBytes(w.memoryRequest), memoryRatio*100, w.pods)
+		switch {
+		case cpuRatio > underProvisionedThreshold || memoryRatio > underProvisionedThreshold:
+			underProvisioned = append(underProvisioned, line+" — consider raising requests to avoid throttling or eviction")
+		case cpuRatio < overProvisionedThreshold && memoryRatio < overProvisionedThreshold:
+			overProvisioned = append(overProvisioned, line+" — consider lowering requests to free schedulable capacity")
+		}
+	}
+	sort.Strings(overProvisioned)
+	sort.Strings(underProvisioned)
+	sort.Strings(unrequested)
+
+	scope := "the cluster"
+	if namespace != "" {
+		scope = "namespace '" + namespace + "'"
+	}
+	var sections []string
+	if len(underProvisioned) > 0 {
+		sections = append(sections, fmt.Sprintf("UNDER-PROVISIONED workloads (%d):\n%s", len(underProvisioned), strings.Join(underProvisioned, "\n")))
+	}
+	if len(overProvisioned) > 0 {
+		sections = append(sections, fmt.Sprintf("Over-provisioned workloads (%d):\n%s", len(overProvisioned), strings.Join(overProvisioned, "\n")))
+	}
+	if len(unrequested) > 0 {
+		sections = append(sections, fmt.Sprintf("Workloads without resource requests (%d):\n%s", len(unrequested), strings.Join(unrequested, "\n")))
+	}
+	if len(sections) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("All %d measured workload(s) in %s are sized reasonably (usage between %.0f%% and %.0f%% of requests)",
+			len(workloads), scope, overProvisionedThreshold*100, underProvisionedThreshold*100), nil), nil
+	}
+	sections = append(sections, "Note: usage is a point-in-time sample from the metrics API; confirm over a longer window before changing requests.")
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// workloadKey groups a pod under its controlling workload, resolving
+// ReplicaSets to their owning Deployment.
+func workloadKey(pod *corev1.Pod, replicaSetOwner map[string]string) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "Pod " + pod.Namespace + "/" + pod.Name
+	}
+	if owner.Kind == "ReplicaSet" {
+		if deployment, ok := replicaSetOwner[pod.Namespace+"/"+owner.Name]; ok {
+			return deployment
+		}
+	}
+	return fmt.Sprintf("%s %s/%s", owner.Kind, pod.Namespace, owner.Name)
+}
+
+func ratio(used, requested int64) float64 {
+	if requested == 0 {
+		return 0
+	}
+	return float64(used) / float64(requested)
+}
+
+func formatBytes(b int64) string {
+	switch {
+	case b >= 1<<30:
+		return fmt.Sprintf("%.1fGi", float64(b)/(1<<30))
+	case b >= 1<<20:
+		return fmt.Sprintf("%.1fMi", float64(b)/(1<<20))
+	case b >= 1<<10:
+		return fmt.Sprintf("%.1fKi", float64(b)/(1<<10))
+	}
+	return fmt.Sprintf("%dB", b)
+}
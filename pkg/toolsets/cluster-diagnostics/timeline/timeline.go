@@ -0,0 +1,251 @@
+package timeline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// timelineWindowDefaultMinutes is the default look-back window for the timeline.
+const timelineWindowDefaultMinutes = 60
+
+var clusterOperatorGVR = schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "clusteroperators"}
+
+// entry is a single dated line in the merged timeline.
+type entry struct {
+	when time.Time
+	line string
+}
+
+// InitTimeline returns the incident review timeline tool.
+func InitTimeline() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "events_timeline",
+				Description: "Merge Events, container restarts, Deployment rollout transitions, node condition changes, and (on OpenShift) ClusterOperator degradations " +
+					"into a single chronological timeline over a time window, for 'what happened at 14:05' incident investigations",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to analyze; node and operator entries are cluster-scoped and always included (Optional, all namespaces if not provided)",
+						},
+						"sinceMinutes": {
+							Type:        "integer",
+							Description: "Look-back window in minutes (Optional, default: 60)",
+							Minimum:     ptr.To(float64(1)),
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Events: Timeline",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: eventsTimeline,
+		},
+	}
+}
+
+func eventsTimeline(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	sinceMinutes := p.OptionalInt64("sinceMinutes", timelineWindowDefaultMinutes)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to build events timeline: %w", err)), nil
+	}
+	cutoff := time.Now().Add(-time.Duration(sinceMinutes) * time.Minute)
+
+	var entries []entry
+	if eventEntries, err := eventEntries(params, namespace, cutoff); err != nil {
+		return api.NewToolCallResult("", err), nil
+	} else {
+		entries = append(entries, eventEntries...)
+	}
+	if restartEntries, err := containerRestartEntries(params, namespace, cutoff); err != nil {
+		return api.NewToolCallResult("", err), nil
+	} else {
+		entries = append(entries, restartEntries...)
+	}
+	if rolloutEntries, err := rolloutEntries(params, namespace, cutoff); err != nil {
+		return api.NewToolCallResult("", err), nil
+	} else {
+		entries = append(entries, rolloutEntries...)
+	}
+	if nodeEntries, err := nodeConditionEntries(params, cutoff); err != nil {
+		return api.NewToolCallResult("", err), nil
+	} else {
+		entries = append(entries, nodeEntries...)
+	}
+	// ClusterOperators only exist on OpenShift; silently skip on plain Kubernetes.
+	entries = append(entries, clusterOperatorEntries(params, cutoff)...)
+
+	scope := "the cluster"
+	if namespace != "" {
+		scope = "namespace '" + namespace + "'"
+	}
+	if len(entries) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No timeline entries for %s in the last %d minute(s)", scope, sinceMinutes), nil), nil
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].when.Before(entries[j].when) })
+	lines := make([]string, 0, len(entries))
+	for i := range entries {
+		lines = append(lines, fmt.Sprintf("%s %s", entries[i].when.UTC().Format("15:04:05"), entries[i].line))
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Timeline for %s over the last %d minute(s) (all timestamps UTC, oldest first):\n%s",
+		scope, sinceMinutes, strings.Join(lines, "\n")), nil), nil
+}
+
+// eventTime returns the most recent timestamp an Event carries, across the
+// fields different API versions and reporters populate.
+func eventTime(event *corev1.Event) time.Time {
+	when := event.EventTime.Time
+	if event.LastTimestamp.After(when) {
+		when = event.LastTimestamp.Time
+	}
+	if event.FirstTimestamp.After(when) {
+		when = event.FirstTimestamp.Time
+	}
+	return when
+}
+
+func eventEntries(params api.ToolHandlerParams, namespace string, cutoff time.Time) ([]entry, error) {
+	events, err := params.CoreV1().Events(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	var entries []entry
+	for i := range events.Items {
+		event := &events.Items[i]
+		when := eventTime(event)
+		if when.Before(cutoff) {
+			continue
+		}
+		count := ""
+		if event.Count > 1 {
+			count = fmt.Sprintf(" (x%d)", event.Count)
+		}
+		entries = append(entries, entry{when, fmt.Sprintf("[Event/%s] %s/%s %s: %s%s",
+			event.Type, event.Namespace, event.InvolvedObject.Name, event.Reason, event.Message, count)})
+	}
+	return entries, nil
+}
+
+func containerRestartEntries(params api.ToolHandlerParams, namespace string, cutoff time.Time) ([]entry, error) {
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	var entries []entry
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.ContainerStatuses)+len(pod.Status.InitContainerStatuses))
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		statuses = append(statuses, pod.Status.InitContainerStatuses...)
+		for j := range statuses {
+			status := &statuses[j]
+			terminated := status.LastTerminationState.Terminated
+			if terminated == nil || terminated.FinishedAt.Time.Before(cutoff) {
+				continue
+			}
+			entries = append(entries, entry{terminated.FinishedAt.Time, fmt.Sprintf(
+				"[Restart] %s/%s container %s exited with code %d (%s), restart count %d",
+				pod.Namespace, pod.Name, status.Name, terminated.ExitCode, terminated.Reason, status.RestartCount)})
+		}
+	}
+	return entries, nil
+}
+
+func rolloutEntries(params api.ToolHandlerParams, namespace string, cutoff time.Time) ([]entry, error) {
+	deployments, err := params.AppsV1().Deployments(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	var entries []entry
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		for j := range deployment.Status.Conditions {
+			condition := &deployment.Status.Conditions[j]
+			when := condition.LastUpdateTime.Time
+			if condition.LastTransitionTime.After(when) {
+				when = condition.LastTransitionTime.Time
+			}
+			if when.Before(cutoff) {
+				continue
+			}
+			entries = append(entries, entry{when, fmt.Sprintf("[Rollout] %s/%s %s=%s %s: %s",
+				deployment.Namespace, deployment.Name, condition.Type, condition.Status, condition.Reason, condition.Message)})
+		}
+	}
+	return entries, nil
+}
+
+func nodeConditionEntries(params api.ToolHandlerParams, cutoff time.Time) ([]entry, error) {
+	nodes, err := params.CoreV1().Nodes().List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	var entries []entry
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		for j := range node.Status.Conditions {
+			condition := &node.Status.Conditions[j]
+			if condition.LastTransitionTime.Time.Before(cutoff) {
+				continue
+			}
+			entries = append(entries, entry{condition.LastTransitionTime.Time, fmt.Sprintf(
+				"[Node] %s %s=%s %s: %s", node.Name, condition.Type, condition.Status, condition.Reason, condition.Message)})
+		}
+	}
+	return entries, nil
+}
+
+func clusterOperatorEntries(params api.ToolHandlerParams, cutoff time.Time) []entry {
+	operators, err := params.DynamicClient().Resource(clusterOperatorGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+	var entries []entry
+	for i := range operators.Items {
+		operator := &operators.Items[i]
+		conditions, _, _ := unstructured.NestedSlice(operator.Object, "status", "conditions")
+		for _, raw := range conditions {
+			condition, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			conditionType, _, _ := unstructured.NestedString(condition, "type")
+			conditionStatus, _, _ := unstructured.NestedString(condition, "status")
+			// Only Degraded=True and Available=False are incident-worthy.
+			if !(conditionType == "Degraded" && conditionStatus == "True") &&
+				!(conditionType == "Available" && conditionStatus == "False") {
+				continue
+			}
+			transition, _, _ := unstructured.NestedString(condition, "lastTransitionTime")
+			when, parseErr := time.Parse(time.RFC3339, transition)
+			if parseErr != nil || when.Before(cutoff) {
+				continue
+			}
+			message, _, _ := unstructured.NestedString(condition, "message")
+			entries = append(entries, entry{when, fmt.Sprintf("[ClusterOperator] %s %s=%s: %s",
+				operator.GetName(), conditionType, conditionStatus, message)})
+		}
+	}
+	return entries
+}
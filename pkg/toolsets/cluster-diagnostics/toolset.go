@@ -5,7 +5,20 @@ import (
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/apiservices"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/capacity"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/compare"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/deprecations"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/disruption"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/idle"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/lint"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/nodes"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/orphans"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/podsecurity"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/rightsizing"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/timeline"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/vpa"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/cluster-diagnostics/webhooks"
 )
 
 type Toolset struct{}
@@ -22,7 +35,21 @@ func (t *Toolset) GetDescription() string {
 
 func (t *Toolset) GetTools(_ api.FilteringProvider) []api.ServerTool {
 	return slices.Concat(
+		apiservices.InitAPIServices(),
+		capacity.InitCapacity(),
+		compare.InitCompare(),
+		deprecations.InitDeprecations(),
+		disruption.InitDisruption(),
+		idle.InitIdle(),
+		lint.InitLint(),
+		nodes.InitDiagnose(),
 		nodes.InitNodes(),
+		orphans.InitOrphans(),
+		podsecurity.InitPodSecurity(),
+		rightsizing.InitRightsizing(),
+		timeline.InitTimeline(),
+		vpa.InitVPA(),
+		webhooks.InitWebhooks(),
 	)
 }
 
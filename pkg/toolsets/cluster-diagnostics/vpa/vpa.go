@@ -0,0 +1,256 @@
+package vpa
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var vpasGVR = schema.GroupVersionResource{Group: "autoscaling.k8s.io", Version: "v1", Resource: "verticalpodautoscalers"}
+
+// InitVPA returns the Vertical Pod Autoscaler recommendation tools.
+func InitVPA() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "vpa_list",
+				Description: "List the VerticalPodAutoscalers of a namespace (or all namespaces) with their target workload, update mode, " +
+					"and the recommender's target recommendation per container",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to list VerticalPodAutoscalers in (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "VPA: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: vpaList,
+		},
+		{
+			Tool: api.Tool{
+				Name: "vpa_get",
+				Description: "Get a VerticalPodAutoscaler with the recommender's full per-container bounds: target, lower bound, upper bound, " +
+					"and uncapped target, as operator-grade input for right-sizing the workload",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the VerticalPodAutoscaler",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the VerticalPodAutoscaler",
+						},
+					},
+					Required: []string{"namespace", "name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "VPA: Get",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: vpaGet,
+		},
+		{
+			Tool: api.Tool{
+				Name: "vpa_create",
+				Description: "Create a VerticalPodAutoscaler in Off mode for a workload: the recommender computes resource recommendations " +
+					"without ever evicting or mutating pods, so the bounds can be reviewed with vpa_get before applying them manually",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the workload",
+						},
+						"kind": {
+							Type:        "string",
+							Description: "Kind of the workload to recommend for (e.g. Deployment, StatefulSet, DaemonSet)",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the workload to recommend for; the VerticalPodAutoscaler is created with the same name",
+						},
+					},
+					Required: []string{"namespace", "kind", "name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "VPA: Create",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: vpaCreate,
+		},
+	}
+}
+
+func vpaList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list VerticalPodAutoscalers: %w", err)), nil
+	}
+	client := params.DynamicClient().Resource(vpasGVR)
+	var list *unstructured.UnstructuredList
+	var err error
+	if namespace != "" {
+		list, err = client.Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	} else {
+		list, err = client.List(params.Context, metav1.ListOptions{})
+	}
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list VerticalPodAutoscalers: %w", err)), nil
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult("No VerticalPodAutoscalers found", nil), nil
+	}
+	var lines []string
+	for i := range list.Items {
+		vpa := &list.Items[i]
+		targetKind, _, _ := unstructured.NestedString(vpa.Object, "spec", "targetRef", "kind")
+		targetName, _, _ := unstructured.NestedString(vpa.Object, "spec", "targetRef", "name")
+		updateMode, _, _ := unstructured.NestedString(vpa.Object, "spec", "updatePolicy", "updateMode")
+		if updateMode == "" {
+			updateMode = "Auto"
+		}
+		line := fmt.Sprintf("- %s/%s → %s %s (mode: %s)", vpa.GetNamespace(), vpa.GetName(), targetKind, targetName, updateMode)
+		recommendations, _, _ := unstructured.NestedSlice(vpa.Object, "status", "recommendation", "containerRecommendations")
+		if len(recommendations) == 0 {
+			line += "\n  no recommendation yet"
+		}
+		for _, raw := range recommendations {
+			recommendation, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			containerName, _ := recommendation["containerName"].(string)
+			line += fmt.Sprintf("\n  %s: target %s", containerName, resources(recommendation, "target"))
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("VerticalPodAutoscalers (%d):\n%s", len(list.Items), strings.Join(lines, "\n")), nil), nil
+}
+
+func vpaGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	name := p.RequiredString("name")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the VerticalPodAutoscaler: %w", err)), nil
+	}
+	vpa, err := params.DynamicClient().Resource(vpasGVR).Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get VerticalPodAutoscaler %s/%s: %w", namespace, name, err)), nil
+	}
+	targetKind, _, _ := unstructured.NestedString(vpa.Object, "spec", "targetRef", "kind")
+	targetName, _, _ := unstructured.NestedString(vpa.Object, "spec", "targetRef", "name")
+	updateMode, _, _ := unstructured.NestedString(vpa.Object, "spec", "updatePolicy", "updateMode")
+	if updateMode == "" {
+		updateMode = "Auto"
+	}
+	header := fmt.Sprintf("VerticalPodAutoscaler %s/%s → %s %s (mode: %s)", namespace, name, targetKind, targetName, updateMode)
+
+	recommendations, _, _ := unstructured.NestedSlice(vpa.Object, "status", "recommendation", "containerRecommendations")
+	if len(recommendations) == 0 {
+		return api.NewToolCallResult(header+"\n\nThe recommender has not produced a recommendation yet; it needs usage history for the target's pods", nil), nil
+	}
+	var sections []string
+	for _, raw := range recommendations {
+		recommendation, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		containerName, _ := recommendation["containerName"].(string)
+		sections = append(sections, fmt.Sprintf("Container %s:\n- target: %s\n- lower bound: %s\n- upper bound: %s\n- uncapped target: %s",
+			containerName,
+			resources(recommendation, "target"),
+			resources(recommendation, "lowerBound"),
+			resources(recommendation, "upperBound"),
+			resources(recommendation, "uncappedTarget")))
+	}
+	sort.Strings(sections)
+	return api.NewToolCallResult(header+"\n\n"+strings.Join(sections, "\n\n"), nil), nil
+}
+
+func vpaCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	kind := p.RequiredString("kind")
+	name := p.RequiredString("name")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create the VerticalPodAutoscaler: %w", err)), nil
+	}
+
+	apiVersion := "apps/v1"
+	if kind == "CronJob" || kind == "Job" {
+		apiVersion = "batch/v1"
+	}
+	vpa := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "autoscaling.k8s.io/v1",
+		"kind":       "VerticalPodAutoscaler",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]any{
+			"targetRef": map[string]any{
+				"apiVersion": apiVersion,
+				"kind":       kind,
+				"name":       name,
+			},
+			"updatePolicy": map[string]any{
+				"updateMode": "Off",
+			},
+		},
+	}}
+	if _, err := params.DynamicClient().Resource(vpasGVR).Namespace(namespace).Create(params.Context, vpa, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create VerticalPodAutoscaler %s/%s: %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Created VerticalPodAutoscaler %s/%s in Off mode for %s %s; no pods will be evicted or mutated. "+
+			"Check the recommendation with vpa_get once the recommender has gathered usage history", namespace, name, kind, name), nil), nil
+}
+
+// resources renders the cpu and memory quantities of one bound of a container
+// recommendation (e.g. "cpu: 25m, memory: 262144k").
+func resources(recommendation map[string]any, bound string) string {
+	quantities, _, _ := unstructured.NestedMap(recommendation, bound)
+	if len(quantities) == 0 {
+		return "not available"
+	}
+	keys := make([]string, 0, len(quantities))
+	for key := range quantities {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s: %v", key, quantities[key]))
+	}
+	return strings.Join(parts, ", ")
+}
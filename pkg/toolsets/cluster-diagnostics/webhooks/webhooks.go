@@ -0,0 +1,442 @@
+package webhooks
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// InitWebhooks returns the admission webhook diagnostic tools.
+func InitWebhooks() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "webhooks_list",
+				Description: "List the cluster's Validating and Mutating webhook configurations with each webhook's target, failure policy, and timeout. " +
+					"Misbehaving admission webhooks are a top cause of mysterious apply failures",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"type": {
+							Type:        "string",
+							Description: "Restrict the listing to one webhook kind (Optional)",
+							Enum:        []any{"validating", "mutating"},
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Webhooks: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: webhooksList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "webhooks_check",
+				Description: "Check the health of a Validating or Mutating webhook configuration: whether its backing Service exists, has ready endpoints, and whether its CA bundle parses and is not expired",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the webhook configuration to check",
+						},
+						"type": {
+							Type:        "string",
+							Description: "Kind of webhook configuration to check",
+							Enum:        []any{"validating", "mutating"},
+						},
+					},
+					Required: []string{"name", "type"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Webhooks: Check",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: webhooksCheck,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "webhooks_simulate",
+				Description: "Report which admission webhooks would intercept a given operation on a resource, including each webhook's failure policy, so blocked applies can be traced to the intercepting webhook",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"apiGroup": {
+							Type:        "string",
+							Description: "API group of the resource (empty for core resources like pods or configmaps) (Optional)",
+						},
+						"apiVersion": {
+							Type:        "string",
+							Description: "API version of the resource, e.g. v1 (Optional, any version matches if not provided)",
+						},
+						"resource": {
+							Type:        "string",
+							Description: "Plural resource name, e.g. deployments or pods",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the operation; its labels are evaluated against webhook namespace selectors (Optional, cluster-scoped if not provided)",
+						},
+						"operation": {
+							Type:        "string",
+							Description: "Operation to simulate (Optional, default: CREATE)",
+							Enum:        []any{"CREATE", "UPDATE", "DELETE", "CONNECT"},
+						},
+					},
+					Required: []string{"resource"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Webhooks: Simulate",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: webhooksSimulate,
+		},
+	}
+}
+
+func webhooksList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	webhookType := p.OptionalString("type", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list webhook configurations: %w", err)), nil
+	}
+
+	var sections []string
+	if webhookType == "" || webhookType == "validating" {
+		configurations, err := params.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(params.Context, metav1.ListOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to list ValidatingWebhookConfigurations: %w", err)), nil
+		}
+		var lines []string
+		for i := range configurations.Items {
+			cfg := &configurations.Items[i]
+			for j := range cfg.Webhooks {
+				lines = append(lines, describeWebhook(cfg.Name, cfg.Webhooks[j].Name, cfg.Webhooks[j].ClientConfig, cfg.Webhooks[j].FailurePolicy, cfg.Webhooks[j].TimeoutSeconds))
+			}
+		}
+		sort.Strings(lines)
+		sections = append(sections, section("ValidatingWebhookConfigurations", lines))
+	}
+	if webhookType == "" || webhookType == "mutating" {
+		configurations, err := params.AdmissionregistrationV1().MutatingWebhookConfigurations().List(params.Context, metav1.ListOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to list MutatingWebhookConfigurations: %w", err)), nil
+		}
+		var lines []string
+		for i := range configurations.Items {
+			cfg := &configurations.Items[i]
+			for j := range cfg.Webhooks {
+				lines = append(lines, describeWebhook(cfg.Name, cfg.Webhooks[j].Name, cfg.Webhooks[j].ClientConfig, cfg.Webhooks[j].FailurePolicy, cfg.Webhooks[j].TimeoutSeconds))
+			}
+		}
+		sort.Strings(lines)
+		sections = append(sections, section("MutatingWebhookConfigurations", lines))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+func section(title string, lines []string) string {
+	if len(lines) == 0 {
+		return fmt.Sprintf("No %s found", title)
+	}
+	return fmt.Sprintf("%s (%d webhook(s)):\n%s", title, len(lines), strings.Join(lines, "\n"))
+}
+
+func describeWebhook(configName, webhookName string, clientConfig admissionregistrationv1.WebhookClientConfig, failurePolicy *admissionregistrationv1.FailurePolicyType, timeoutSeconds *int32) string {
+	target := "no target configured"
+	if clientConfig.Service != nil {
+		target = fmt.Sprintf("Service %s/%s", clientConfig.Service.Namespace, clientConfig.Service.Name)
+	} else if clientConfig.URL != nil {
+		target = "URL " + *clientConfig.URL
+	}
+	policy := string(admissionregistrationv1.Fail)
+	if failurePolicy != nil {
+		policy = string(*failurePolicy)
+	}
+	timeout := "10s"
+	if timeoutSeconds != nil {
+		timeout = fmt.Sprintf("%ds", *timeoutSeconds)
+	}
+	return fmt.Sprintf("- %s/%s (%s, failurePolicy: %s, timeout: %s)", configName, webhookName, target, policy, timeout)
+}
+
+// checkedWebhook is the subset of a webhook spec webhooksCheck needs,
+// identical between the Validating and Mutating types.
+type checkedWebhook struct {
+	name         string
+	clientConfig admissionregistrationv1.WebhookClientConfig
+}
+
+func webhooksCheck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	webhookType := p.RequiredString("type")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check webhook configuration: %w", err)), nil
+	}
+
+	var webhooks []checkedWebhook
+	switch webhookType {
+	case "validating":
+		cfg, err := params.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(params.Context, name, metav1.GetOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get ValidatingWebhookConfiguration %s: %w", name, err)), nil
+		}
+		for i := range cfg.Webhooks {
+			webhooks = append(webhooks, checkedWebhook{name: cfg.Webhooks[i].Name, clientConfig: cfg.Webhooks[i].ClientConfig})
+		}
+	case "mutating":
+		cfg, err := params.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(params.Context, name, metav1.GetOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get MutatingWebhookConfiguration %s: %w", name, err)), nil
+		}
+		for i := range cfg.Webhooks {
+			webhooks = append(webhooks, checkedWebhook{name: cfg.Webhooks[i].Name, clientConfig: cfg.Webhooks[i].ClientConfig})
+		}
+	default:
+		return api.NewToolCallResult("", fmt.Errorf("invalid webhook type %q: must be 'validating' or 'mutating'", webhookType)), nil
+	}
+
+	healthy := true
+	var lines []string
+	for _, webhook := range webhooks {
+		problems := checkWebhookHealth(params, webhook.clientConfig)
+		if len(problems) == 0 {
+			lines = append(lines, fmt.Sprintf("- %s: OK", webhook.name))
+			continue
+		}
+		healthy = false
+		lines = append(lines, fmt.Sprintf("- %s:\n    %s", webhook.name, strings.Join(problems, "\n    ")))
+	}
+	verdict := "all webhooks healthy"
+	if !healthy {
+		verdict = "PROBLEMS FOUND"
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Webhook configuration %s (%s): %s\n%s", name, webhookType, verdict, strings.Join(lines, "\n")), nil), nil
+}
+
+// checkWebhookHealth inspects a webhook's client configuration and returns the
+// list of problems found (empty when healthy).
+func checkWebhookHealth(params api.ToolHandlerParams, clientConfig admissionregistrationv1.WebhookClientConfig) []string {
+	var problems []string
+	if clientConfig.Service != nil {
+		service := clientConfig.Service
+		if _, err := params.CoreV1().Services(service.Namespace).Get(params.Context, service.Name, metav1.GetOptions{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				problems = append(problems, fmt.Sprintf("WARN backing Service %s/%s does not exist", service.Namespace, service.Name))
+			} else {
+				problems = append(problems, fmt.Sprintf("WARN failed to get backing Service %s/%s: %v", service.Namespace, service.Name, err))
+			}
+		} else if ready, err := readyEndpointCount(params, service.Namespace, service.Name); err != nil {
+			problems = append(problems, fmt.Sprintf("WARN failed to inspect endpoints of Service %s/%s: %v", service.Namespace, service.Name, err))
+		} else if ready == 0 {
+			problems = append(problems, fmt.Sprintf("WARN Service %s/%s has no ready endpoints: admission requests will fail until a serving pod is ready", service.Namespace, service.Name))
+		}
+	} else if clientConfig.URL == nil {
+		problems = append(problems, "WARN neither a Service nor a URL is configured")
+	}
+	if len(clientConfig.CABundle) == 0 {
+		problems = append(problems, "WARN no caBundle configured: the API server cannot verify the webhook's serving certificate")
+	} else if problem := checkCABundle(clientConfig.CABundle); problem != "" {
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+// readyEndpointCount returns the number of ready addresses behind a Service.
+func readyEndpointCount(params api.ToolHandlerParams, namespace, name string) (int, error) {
+	endpoints, err := params.CoreV1().Endpoints(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	ready := 0
+	for _, subset := range endpoints.Subsets {
+		ready += len(subset.Addresses)
+	}
+	return ready, nil
+}
+
+// checkCABundle parses the PEM certificates in a caBundle and reports expiry
+// problems. Returns the empty string when the bundle is healthy.
+func checkCABundle(caBundle []byte) string {
+	rest := caBundle
+	parsed := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return fmt.Sprintf("WARN caBundle contains an unparsable certificate: %v", err)
+		}
+		parsed++
+		now := time.Now()
+		if now.After(certificate.NotAfter) {
+			return fmt.Sprintf("WARN caBundle certificate %q expired on %s", certificate.Subject.CommonName, certificate.NotAfter.Format(time.RFC3339))
+		}
+		if certificate.NotAfter.Sub(now) < 30*24*time.Hour {
+			return fmt.Sprintf("WARN caBundle certificate %q expires soon (%s)", certificate.Subject.CommonName, certificate.NotAfter.Format(time.RFC3339))
+		}
+	}
+	if parsed == 0 {
+		return "WARN caBundle contains no PEM certificates"
+	}
+	return ""
+}
+
+func webhooksSimulate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	apiGroup := p.OptionalString("apiGroup", "")
+	apiVersion := p.OptionalString("apiVersion", "")
+	resource := p.RequiredString("resource")
+	namespace := p.OptionalString("namespace", "")
+	operation := p.OptionalString("operation", string(admissionregistrationv1.Create))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to simulate webhook interception: %w", err)), nil
+	}
+
+	var namespaceLabels map[string]string
+	if namespace != "" {
+		ns, err := params.CoreV1().Namespaces().Get(params.Context, namespace, metav1.GetOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get namespace %s to evaluate namespace selectors: %w", namespace, err)), nil
+		}
+		namespaceLabels = ns.Labels
+	}
+
+	var intercepting []string
+	validating, err := params.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ValidatingWebhookConfigurations: %w", err)), nil
+	}
+	for i := range validating.Items {
+		cfg := &validating.Items[i]
+		for j := range cfg.Webhooks {
+			webhook := &cfg.Webhooks[j]
+			if line, ok := matchWebhook("validating", cfg.Name, webhook.Name, webhook.Rules, webhook.NamespaceSelector, webhook.ObjectSelector, webhook.FailurePolicy,
+				apiGroup, apiVersion, resource, namespace, namespaceLabels, operation); ok {
+				intercepting = append(intercepting, line)
+			}
+		}
+	}
+	mutating, err := params.AdmissionregistrationV1().MutatingWebhookConfigurations().List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list MutatingWebhookConfigurations: %w", err)), nil
+	}
+	for i := range mutating.Items {
+		cfg := &mutating.Items[i]
+		for j := range cfg.Webhooks {
+			webhook := &cfg.Webhooks[j]
+			if line, ok := matchWebhook("mutating", cfg.Name, webhook.Name, webhook.Rules, webhook.NamespaceSelector, webhook.ObjectSelector, webhook.FailurePolicy,
+				apiGroup, apiVersion, resource, namespace, namespaceLabels, operation); ok {
+				intercepting = append(intercepting, line)
+			}
+		}
+	}
+
+	described := operation + " " + resource
+	if apiGroup != "" {
+		described += "." + apiGroup
+	}
+	if namespace != "" {
+		described += " in namespace " + namespace
+	}
+	if len(intercepting) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No admission webhooks would intercept %s", described), nil), nil
+	}
+	sort.Strings(intercepting)
+	return api.NewToolCallResult(fmt.Sprintf("Admission webhooks intercepting %s (%d):\n%s", described, len(intercepting), strings.Join(intercepting, "\n")), nil), nil
+}
+
+// matchWebhook evaluates a single webhook's rules and selectors against the
+// simulated operation. It returns a descriptive line and true when the webhook
+// would intercept the operation.
+func matchWebhook(kind, configName, webhookName string, rules []admissionregistrationv1.RuleWithOperations, namespaceSelector, objectSelector *metav1.LabelSelector, failurePolicy *admissionregistrationv1.FailurePolicyType,
+	apiGroup, apiVersion, resource, namespace string, namespaceLabels map[string]string, operation string) (string, bool) {
+	if !rulesMatch(rules, apiGroup, apiVersion, resource, operation) {
+		return "", false
+	}
+	if namespace != "" && namespaceSelector != nil && len(namespaceSelector.MatchLabels)+len(namespaceSelector.MatchExpressions) > 0 {
+		selector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+		if err != nil || !selector.Matches(labels.Set(namespaceLabels)) {
+			return "", false
+		}
+	}
+	policy := string(admissionregistrationv1.Fail)
+	if failurePolicy != nil {
+		policy = string(*failurePolicy)
+	}
+	note := ""
+	if objectSelector != nil && len(objectSelector.MatchLabels)+len(objectSelector.MatchExpressions) > 0 {
+		note = ", only if the object matches its objectSelector"
+	}
+	return fmt.Sprintf("- [%s] %s/%s (failurePolicy: %s%s)", kind, configName, webhookName, policy, note), true
+}
+
+// rulesMatch reports whether any rule covers the given group/version/resource
+// and operation, honoring the '*' wildcards the admission API allows.
+func rulesMatch(rules []admissionregistrationv1.RuleWithOperations, apiGroup, apiVersion, resource, operation string) bool {
+	for _, rule := range rules {
+		if !operationMatches(rule.Operations, operation) {
+			continue
+		}
+		if !wildcardMatches(rule.APIGroups, apiGroup) {
+			continue
+		}
+		if apiVersion != "" && !wildcardMatches(rule.APIVersions, apiVersion) {
+			continue
+		}
+		if wildcardMatches(rule.Resources, resource) {
+			return true
+		}
+	}
+	return false
+}
+
+func operationMatches(operations []admissionregistrationv1.OperationType, operation string) bool {
+	for _, op := range operations {
+		if op == admissionregistrationv1.OperationAll || string(op) == operation {
+			return true
+		}
+	}
+	return false
+}
+
+func wildcardMatches(values []string, value string) bool {
+	for _, v := range values {
+		if v == "*" || v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// clusterConfigGroup is the API group of the OpenShift cluster-scoped
+// configuration singletons (all named "cluster").
+const clusterConfigGroup = "config.openshift.io"
+
+func initClusterSnapshot(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "configuration_cluster_snapshot",
+				Description: "Summarize the key OpenShift cluster configuration resources (Infrastructure, Network, Proxy, APIServer, OAuth, Ingress) in one report, " +
+					"answering \"describe this cluster's configuration\" with a single call",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Configuration: Cluster Snapshot",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: clusterSnapshot,
+			TargetCompatibilityFilters: []func() bool{
+				func() bool {
+					return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+						{Group: clusterConfigGroup, Version: "v1", Kind: "Infrastructure"},
+					})
+				},
+			},
+		},
+	}
+}
+
+// clusterConfigSummarizer renders one section of the snapshot from the
+// cluster-scoped singleton of the given resource.
+type clusterConfigSummarizer struct {
+	resource  string
+	title     string
+	summarize func(obj *unstructured.Unstructured) []string
+}
+
+func clusterSnapshot(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	summarizers := []clusterConfigSummarizer{
+		{resource: "infrastructures", title: "Infrastructure", summarize: summarizeInfrastructure},
+		{resource: "networks", title: "Network", summarize: summarizeNetwork},
+		{resource: "proxies", title: "Proxy", summarize: summarizeProxy},
+		{resource: "apiservers", title: "APIServer", summarize: summarizeAPIServer},
+		{resource: "oauths", title: "OAuth", summarize: summarizeOAuth},
+		{resource: "ingresses", title: "Ingress", summarize: summarizeIngress},
+	}
+
+	var sections []string
+	for _, summarizer := range summarizers {
+		gvr := schema.GroupVersionResource{Group: clusterConfigGroup, Version: "v1", Resource: summarizer.resource}
+		obj, err := params.DynamicClient().Resource(gvr).Get(params.Context, "cluster", metav1.GetOptions{})
+		if err != nil {
+			sections = append(sections, fmt.Sprintf("%s: not available (%v)", summarizer.title, err))
+			continue
+		}
+		lines := summarizer.summarize(obj)
+		if len(lines) == 0 {
+			sections = append(sections, fmt.Sprintf("%s: no notable configuration", summarizer.title))
+			continue
+		}
+		sections = append(sections, fmt.Sprintf("%s:\n- %s", summarizer.title, strings.Join(lines, "\n- ")))
+	}
+	return api.NewToolCallResult("Cluster configuration snapshot:\n\n"+strings.Join(sections, "\n\n"), nil), nil
+}
+
+func summarizeInfrastructure(obj *unstructured.Unstructured) []string {
+	var lines []string
+	if name, _, _ := unstructured.NestedString(obj.Object, "status", "infrastructureName"); name != "" {
+		lines = append(lines, "infrastructure name: "+name)
+	}
+	if platform, _, _ := unstructured.NestedString(obj.Object, "status", "platformStatus", "type"); platform != "" {
+		lines = append(lines, "platform: "+platform)
+	}
+	if apiServerURL, _, _ := unstructured.NestedString(obj.Object, "status", "apiServerURL"); apiServerURL != "" {
+		lines = append(lines, "API server URL: "+apiServerURL)
+	}
+	if topology, _, _ := unstructured.NestedString(obj.Object, "status", "controlPlaneTopology"); topology != "" {
+		lines = append(lines, "control plane topology: "+topology)
+	}
+	if topology, _, _ := unstructured.NestedString(obj.Object, "status", "infrastructureTopology"); topology != "" {
+		lines = append(lines, "infrastructure topology: "+topology)
+	}
+	return lines
+}
+
+func summarizeNetwork(obj *unstructured.Unstructured) []string {
+	var lines []string
+	if networkType, _, _ := unstructured.NestedString(obj.Object, "status", "networkType"); networkType != "" {
+		lines = append(lines, "network type: "+networkType)
+	}
+	if clusterNetworks, _, _ := unstructured.NestedSlice(obj.Object, "status", "clusterNetwork"); len(clusterNetworks) > 0 {
+		var cidrs []string
+		for _, entry := range clusterNetworks {
+			network, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if cidr, _ := network["cidr"].(string); cidr != "" {
+				cidrs = append(cidrs, cidr)
+			}
+		}
+		if len(cidrs) > 0 {
+			lines = append(lines, "cluster network: "+strings.Join(cidrs, ", "))
+		}
+	}
+	if serviceNetworks, _, _ := unstructured.NestedStringSlice(obj.Object, "status", "serviceNetwork"); len(serviceNetworks) > 0 {
+		lines = append(lines, "service network: "+strings.Join(serviceNetworks, ", "))
+	}
+	return lines
+}
+
+func summarizeProxy(obj *unstructured.Unstructured) []string {
+	var lines []string
+	if httpProxy, _, _ := unstructured.NestedString(obj.Object, "status", "httpProxy"); httpProxy != "" {
+		lines = append(lines, "HTTP proxy: "+httpProxy)
+	}
+	if httpsProxy, _, _ := unstructured.NestedString(obj.Object, "status", "httpsProxy"); httpsProxy != "" {
+		lines = append(lines, "HTTPS proxy: "+httpsProxy)
+	}
+	if noProxy, _, _ := unstructured.NestedString(obj.Object, "status", "noProxy"); noProxy != "" {
+		lines = append(lines, "no proxy: "+noProxy)
+	}
+	if len(lines) == 0 {
+		return []string{"no cluster-wide proxy configured"}
+	}
+	return lines
+}
+
+func summarizeAPIServer(obj *unstructured.Unstructured) []string {
+	var lines []string
+	if profile, _, _ := unstructured.NestedString(obj.Object, "spec", "audit", "profile"); profile != "" {
+		lines = append(lines, "audit profile: "+profile)
+	}
+	if tlsProfile, found, _ := unstructured.NestedMap(obj.Object, "spec", "tlsSecurityProfile"); found {
+		if profileType, _ := tlsProfile["type"].(string); profileType != "" {
+			lines = append(lines, "TLS security profile: "+profileType)
+		}
+	}
+	if namedCerts, _, _ := unstructured.NestedSlice(obj.Object, "spec", "servingCerts", "namedCertificates"); len(namedCerts) > 0 {
+		lines = append(lines, fmt.Sprintf("named serving certificates: %d", len(namedCerts)))
+	}
+	if encryptionType, _, _ := unstructured.NestedString(obj.Object, "spec", "encryption", "type"); encryptionType != "" {
+		lines = append(lines, "etcd encryption: "+encryptionType)
+	}
+	return lines
+}
+
+func summarizeOAuth(obj *unstructured.Unstructured) []string {
+	identityProviders, _, _ := unstructured.NestedSlice(obj.Object, "spec", "identityProviders")
+	if len(identityProviders) == 0 {
+		return []string{"no identity providers configured"}
+	}
+	var lines []string
+	for _, entry := range identityProviders {
+		provider, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := provider["name"].(string)
+		providerType, _ := provider["type"].(string)
+		lines = append(lines, fmt.Sprintf("identity provider: %s (%s)", name, providerType))
+	}
+	return lines
+}
+
+func summarizeIngress(obj *unstructured.Unstructured) []string {
+	var lines []string
+	if domain, _, _ := unstructured.NestedString(obj.Object, "spec", "domain"); domain != "" {
+		lines = append(lines, "domain: "+domain)
+	}
+	if components, _, _ := unstructured.NestedSlice(obj.Object, "status", "componentRoutes"); len(components) > 0 {
+		lines = append(lines, fmt.Sprintf("component routes: %d", len(components)))
+	}
+	return lines
+}
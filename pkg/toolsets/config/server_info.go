@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	staticconfig "github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/version"
+)
+
+func initServerInfo() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "server_info",
+				Description: "Show the MCP server version, the enabled toolsets, a summary of the active configuration, the target cluster version, " +
+					"and whether OpenShift APIs were detected, so clients can adapt their behavior without out-of-band knowledge. " +
+					"Use config_show for the full redacted configuration",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Server: Info",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(false),
+				},
+			},
+			Handler: serverInfo,
+		},
+	}
+}
+
+func serverInfo(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Server: %s %s (commit %s, built %s)\n", version.BinaryName, version.Version, version.CommitHash, version.BuildTime)
+
+	if cfg, ok := params.BaseConfig.(*staticconfig.StaticConfig); ok {
+		fmt.Fprintf(&sb, "Enabled toolsets: %s\n", strings.Join(cfg.Toolsets, ", "))
+		fmt.Fprintf(&sb, "Read-only mode: %t\n", cfg.ReadOnly)
+		fmt.Fprintf(&sb, "List output format: %s\n", cfg.ListOutput)
+		if cfg.ClusterProviderStrategy != "" {
+			fmt.Fprintf(&sb, "Cluster provider strategy: %s\n", cfg.ClusterProviderStrategy)
+		}
+		fmt.Fprintf(&sb, "OAuth required: %t\n", cfg.IsRequireOAuth())
+		fmt.Fprintf(&sb, "Pre-execution validation: %t\n", cfg.IsValidationEnabled())
+	}
+
+	if serverVersion, err := params.DiscoveryClient().ServerVersion(); err == nil {
+		fmt.Fprintf(&sb, "Target cluster version: %s\n", serverVersion.GitVersion)
+	} else {
+		fmt.Fprintf(&sb, "Target cluster version: unavailable (%v)\n", err)
+	}
+	isOpenShift := params.FilteringProvider.AnyTargetHasGVKs(params.Context, []schema.GroupVersionKind{
+		{Group: "project.openshift.io", Version: "v1", Kind: "Project"},
+	})
+	fmt.Fprintf(&sb, "OpenShift APIs detected: %t", isOpenShift)
+
+	return api.NewToolCallResult(sb.String(), nil), nil
+}
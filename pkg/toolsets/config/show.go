@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	staticconfig "github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+func initShow() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "config_show",
+				Description: "Show the effective server configuration (defaults merged with config files, environment variables, and flags) as a TOML document. " +
+					"Sensitive values are redacted and raw toolset/provider config sections are omitted",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Configuration: Show",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(false),
+				},
+			},
+			ClusterAware: ptr.To(false),
+			Handler:      configShow,
+		},
+	}
+}
+
+func configShow(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	cfg, ok := params.BaseConfig.(*staticconfig.StaticConfig)
+	if !ok {
+		return api.NewToolCallResult("", fmt.Errorf("failed to show configuration: effective configuration is not available")), nil
+	}
+	rendered, err := cfg.Redacted().TOML()
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to show configuration: %w", err)), nil
+	}
+	return api.NewToolCallResult(rendered, nil), nil
+}
@@ -19,9 +19,12 @@ func (t *Toolset) GetDescription() string {
 	return "View and manage the current local Kubernetes configuration (kubeconfig)"
 }
 
-func (t *Toolset) GetTools(_ api.FilteringProvider) []api.ServerTool {
+func (t *Toolset) GetTools(p api.FilteringProvider) []api.ServerTool {
 	return slices.Concat(
 		initConfiguration(),
+		initServerInfo(),
+		initShow(),
+		initClusterSnapshot(p),
 	)
 }
 
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initApps() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "app_deploy",
+			Description: "Deploy an application from a container image or a Git repository as a single call (the oc new-app quickstart workflow): " +
+				"creates a Deployment, Service, and Route (on OpenShift) with sane defaults and managed-by labels, plus an S2I BuildConfig when deploying from Git, and reports the application URL",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"image": {
+						Type:        "string",
+						Description: "Container image to deploy (e.g. quay.io/org/my-app:v1). Exactly one of image or gitUrl must be provided",
+					},
+					"gitUrl": {
+						Type:        "string",
+						Description: "Git repository URL to build with Source-to-Image and deploy (OpenShift only). Exactly one of image or gitUrl must be provided",
+					},
+					"gitRef": {
+						Type:        "string",
+						Description: "Git branch, tag, or commit to build (Optional, defaults to the repository default branch)",
+					},
+					"builder": {
+						Type:        "string",
+						Description: "S2I builder ImageStreamTag in the openshift namespace (e.g. nodejs:latest). Required with gitUrl",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Application name (Optional, derived from the image or Git URL if not provided)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to deploy the application in (Optional, defaults to the configured namespace)",
+					},
+					"port": {
+						Type:        "integer",
+						Description: "TCP port the application listens on (Optional, defaults to 8080)",
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(float64(65535)),
+					},
+					"replicas": {
+						Type:        "integer",
+						Description: "Number of replicas (Optional, defaults to 1)",
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Apps: Deploy",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: appDeploy},
+	}
+}
+
+func appDeploy(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	options := kubernetes.AppDeployOptions{
+		Image:     p.OptionalString("image", ""),
+		GitURL:    p.OptionalString("gitUrl", ""),
+		GitRef:    p.OptionalString("gitRef", ""),
+		Builder:   p.OptionalString("builder", ""),
+		Name:      p.OptionalString("name", ""),
+		Namespace: p.OptionalString("namespace", ""),
+		Port:      int32(p.OptionalInt64("port", 0)),
+		Replicas:  int32(p.OptionalInt64("replicas", 0)),
+	}
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to deploy application: %w", err)), nil
+	}
+	resources, err := kubernetes.NewCore(params).AppDeploy(params, options)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to deploy application: %w", err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(resources)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to deploy application: %w", err)), nil
+	}
+	header := "# The following resources (YAML) have been created or updated successfully\n"
+	if url := appURL(resources); url != "" {
+		header = "# Application deployed successfully, URL: " + url + "\n" + header
+	}
+	if options.GitURL != "" {
+		header += "# The S2I build pushes to the application ImageStream; the Deployment rolls out once the first build completes\n"
+	}
+	return api.NewToolCallResult(header+marshalledYaml, nil), nil
+}
+
+// appURL extracts the application URL from the created Route, if any. The
+// OpenShift route admission plugin assigns spec.host at creation time, so it
+// is normally available immediately.
+func appURL(resources []*unstructured.Unstructured) string {
+	for _, resource := range resources {
+		if resource.GetKind() != "Route" {
+			continue
+		}
+		if host, _, _ := unstructured.NestedString(resource.Object, "spec", "host"); host != "" {
+			return "https://" + host
+		}
+	}
+	return ""
+}
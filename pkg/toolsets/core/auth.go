@@ -0,0 +1,103 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// openShiftScopesExtraKey is the user extra key OpenShift uses to carry OAuth
+// token scopes.
+const openShiftScopesExtraKey = "scopes.authorization.openshift.io"
+
+func initAuth() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "auth_whoami",
+			Description: "Show the authenticated identity the server is using against the cluster: username, UID, groups, token scopes, and the token expiry when available. " +
+				"Useful to explain permission errors and decide between admin and non-admin flows",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Auth: Who Am I",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: authWhoAmI},
+	}
+}
+
+func authWhoAmI(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	review, err := params.AuthenticationV1().SelfSubjectReviews().
+		Create(params.Context, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get authenticated identity: %w", err)), nil
+	}
+	userInfo := review.Status.UserInfo
+
+	lines := []string{"Username: " + userInfo.Username}
+	if userInfo.UID != "" {
+		lines = append(lines, "UID: "+userInfo.UID)
+	}
+	if len(userInfo.Groups) > 0 {
+		lines = append(lines, "Groups:\n- "+strings.Join(userInfo.Groups, "\n- "))
+	}
+	var extraKeys []string
+	for key := range userInfo.Extra {
+		extraKeys = append(extraKeys, key)
+	}
+	sort.Strings(extraKeys)
+	for _, key := range extraKeys {
+		values := userInfo.Extra[key]
+		if key == openShiftScopesExtraKey {
+			lines = append(lines, "Scopes: "+strings.Join(values, ", "))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("Extra (%s): %s", key, strings.Join(values, ", ")))
+	}
+	if expiry := tokenExpiry(params.RESTConfig().BearerToken); !expiry.IsZero() {
+		line := "Token expiry: " + expiry.Format(time.RFC3339)
+		if remaining := time.Until(expiry); remaining > 0 {
+			line += fmt.Sprintf(" (in %s)", remaining.Round(time.Second))
+		} else {
+			line += " (EXPIRED)"
+		}
+		lines = append(lines, line)
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
+
+// tokenExpiry extracts the exp claim from a JWT bearer token without
+// verifying it (the API server already authenticated the request). Returns the
+// zero time for non-JWT tokens (e.g. opaque OAuth tokens) or tokens without an
+// expiry.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0).UTC()
+}
@@ -0,0 +1,210 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	namespacesGVR      = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "namespaces"}
+	resourceQuotasGVR  = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "resourcequotas"}
+	limitRangesGVR     = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "limitranges"}
+	secretsGVR         = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}
+	networkPoliciesGVR = schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}
+	roleBindingsGVR    = schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}
+)
+
+// bootstrapProfiles are the built-in sizing profiles for the namespace quota.
+var bootstrapProfiles = map[string]map[string]any{
+	"small":  {"requests.cpu": "2", "requests.memory": "4Gi", "limits.cpu": "4", "limits.memory": "8Gi", "pods": "20"},
+	"medium": {"requests.cpu": "8", "requests.memory": "16Gi", "limits.cpu": "16", "limits.memory": "32Gi", "pods": "60"},
+	"large":  {"requests.cpu": "32", "requests.memory": "64Gi", "limits.cpu": "64", "limits.memory": "128Gi", "pods": "200"},
+}
+
+func initBootstrap() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "namespace_bootstrap",
+			Description: "Provision a ready-to-use namespace: the namespace itself, a ResourceQuota sized by a profile (small, medium, large), " +
+				"a LimitRange with container defaults, a baseline NetworkPolicy that only allows traffic from within the namespace, " +
+				"an optional edit RoleBinding for a group, and an optional image pull secret copied from another namespace. " +
+				"Objects that already exist are left unchanged",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the namespace to bootstrap",
+					},
+					"profile": {
+						Type:        "string",
+						Description: "Sizing profile for the ResourceQuota (Optional, small by default)",
+						Enum:        []any{"small", "medium", "large"},
+					},
+					"group": {
+						Type:        "string",
+						Description: "Group to grant the edit ClusterRole in the namespace (Optional)",
+					},
+					"pullSecretFrom": {
+						Type:        "string",
+						Description: "Image pull secret to copy into the namespace, as namespace/name (Optional)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Namespace: Bootstrap",
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: namespaceBootstrap},
+	}
+}
+
+func namespaceBootstrap(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	profile := p.OptionalString("profile", "small")
+	group := p.OptionalString("group", "")
+	pullSecretFrom := p.OptionalString("pullSecretFrom", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to bootstrap the namespace: %w", err)), nil
+	}
+	lines, err := applyNamespaceBaseline(params, name, profile, group, pullSecretFrom)
+	if err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Bootstrapped namespace %s with the %s profile:\n%s", name, profile, strings.Join(lines, "\n")), nil), nil
+}
+
+// applyNamespaceBaseline creates the namespace and its baseline objects,
+// returning a line per object created or skipped. It is shared by the
+// namespace_bootstrap and tenant_onboard tools.
+func applyNamespaceBaseline(params api.ToolHandlerParams, name, profile, group, pullSecretFrom string) ([]string, error) {
+	quota, ok := bootstrapProfiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile %s; supported profiles are small, medium, and large", profile)
+	}
+
+	var lines []string
+	report := func(object *unstructured.Unstructured, err error) error {
+		reference := object.GetKind() + " " + object.GetName()
+		switch {
+		case err == nil:
+			lines = append(lines, "- created "+reference)
+		case apierrors.IsAlreadyExists(err):
+			lines = append(lines, "- "+reference+" already exists, left unchanged")
+		default:
+			return fmt.Errorf("failed to create %s: %w", reference, err)
+		}
+		return nil
+	}
+	create := func(gvr schema.GroupVersionResource, object *unstructured.Unstructured) error {
+		client := params.DynamicClient().Resource(gvr).Namespace(name)
+		_, err := client.Create(params.Context, object, metav1.CreateOptions{})
+		return report(object, err)
+	}
+
+	namespace := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   map[string]any{"name": name},
+	}}
+	_, err := params.DynamicClient().Resource(namespacesGVR).Create(params.Context, namespace, metav1.CreateOptions{})
+	if err := report(namespace, err); err != nil {
+		return nil, err
+	}
+
+	if err := create(resourceQuotasGVR, &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ResourceQuota",
+		"metadata":   map[string]any{"name": "default-quota", "namespace": name},
+		"spec":       map[string]any{"hard": quota},
+	}}); err != nil {
+		return nil, err
+	}
+
+	if err := create(limitRangesGVR, &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "LimitRange",
+		"metadata":   map[string]any{"name": "default-limits", "namespace": name},
+		"spec": map[string]any{
+			"limits": []any{map[string]any{
+				"type":           "Container",
+				"default":        map[string]any{"cpu": "500m", "memory": "512Mi"},
+				"defaultRequest": map[string]any{"cpu": "100m", "memory": "128Mi"},
+			}},
+		},
+	}}); err != nil {
+		return nil, err
+	}
+
+	if err := create(networkPoliciesGVR, &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "NetworkPolicy",
+		"metadata":   map[string]any{"name": "allow-same-namespace", "namespace": name},
+		"spec": map[string]any{
+			"podSelector": map[string]any{},
+			"policyTypes": []any{"Ingress"},
+			"ingress": []any{map[string]any{
+				"from": []any{map[string]any{"podSelector": map[string]any{}}},
+			}},
+		},
+	}}); err != nil {
+		return nil, err
+	}
+
+	if group != "" {
+		if err := create(roleBindingsGVR, &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "RoleBinding",
+			"metadata":   map[string]any{"name": group + "-edit", "namespace": name},
+			"roleRef": map[string]any{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "edit",
+			},
+			"subjects": []any{map[string]any{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "Group",
+				"name":     group,
+			}},
+		}}); err != nil {
+			return nil, err
+		}
+	}
+
+	if pullSecretFrom != "" {
+		sourceNamespace, sourceName, found := strings.Cut(pullSecretFrom, "/")
+		if !found {
+			return nil, fmt.Errorf("pullSecretFrom must be in the namespace/name form, got %s", pullSecretFrom)
+		}
+		source, err := params.DynamicClient().Resource(secretsGVR).Namespace(sourceNamespace).Get(params.Context, sourceName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull secret %s: %w", pullSecretFrom, err)
+		}
+		secretType, _, _ := unstructured.NestedString(source.Object, "type")
+		data, _, _ := unstructured.NestedMap(source.Object, "data")
+		if err := create(secretsGVR, &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": sourceName, "namespace": name},
+			"type":       secretType,
+			"data":       data,
+		}}); err != nil {
+			return nil, err
+		}
+	}
+
+	return lines, nil
+}
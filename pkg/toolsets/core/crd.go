@@ -0,0 +1,229 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// crdWaitDefaultTimeoutSeconds is how long crd_wait_established waits for the
+// Established condition when the caller does not specify a timeout.
+const crdWaitDefaultTimeoutSeconds = 60
+
+func initCrd() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "crd_list",
+			Description: "List the CustomResourceDefinitions installed in the cluster with their group, versions, scope, and establishment status",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"group": {
+						Type:        "string",
+						Description: "Only list CRDs belonging to this API group (Optional)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CRD: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: crdList},
+		{Tool: api.Tool{
+			Name:        "crd_get",
+			Description: "Get the full definition of a CustomResourceDefinition by name (e.g. certificates.cert-manager.io)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the CustomResourceDefinition (plural.group, e.g. certificates.cert-manager.io)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CRD: Get",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: crdGet},
+		{Tool: api.Tool{
+			Name: "crd_wait_established",
+			Description: "Wait until a CustomResourceDefinition reports the Established condition, so instances of the custom resource can be created safely. " +
+				"Use after installing a CRD with resources_create_or_update",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the CustomResourceDefinition to wait for (plural.group, e.g. certificates.cert-manager.io)",
+					},
+					"timeout": {
+						Type:        "integer",
+						Description: "Maximum time in seconds to wait for the CRD to become established (Optional, default: 60)",
+						Default:     api.ToRawMessage(crdWaitDefaultTimeoutSeconds),
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CRD: Wait Established",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: crdWaitEstablished},
+	}
+}
+
+func crdList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	group := p.OptionalString("group", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list CustomResourceDefinitions: %w", err)), nil
+	}
+	list, err := params.DynamicClient().Resource(crdGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list CustomResourceDefinitions: %w", err)), nil
+	}
+	var lines []string
+	for i := range list.Items {
+		crd := &list.Items[i]
+		crdGroup, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		if group != "" && crdGroup != group {
+			continue
+		}
+		scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+		established := "not established"
+		if status, _, _ := crdCondition(crd, "Established"); status == "True" {
+			established = "established"
+		}
+		lines = append(lines, fmt.Sprintf("- %s (group: %s, versions: %s, scope: %s, %s)",
+			crd.GetName(), crdGroup, strings.Join(crdServedVersions(crd), ", "), scope, established))
+	}
+	if len(lines) == 0 {
+		scope := "the cluster"
+		if group != "" {
+			scope = "group '" + group + "'"
+		}
+		return api.NewToolCallResult(fmt.Sprintf("No CustomResourceDefinitions found in %s", scope), nil), nil
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("CustomResourceDefinitions (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+func crdGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get CustomResourceDefinition: %w", err)), nil
+	}
+	crd, err := params.DynamicClient().Resource(crdGVR).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get CustomResourceDefinition %s: %w", name, err)), nil
+	}
+	printed, err := output.Yaml.PrintObjStructured(crd)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to format CustomResourceDefinition %s: %w", name, err)), nil
+	}
+	return api.NewToolCallResultFull(printed.Text, printed.Structured, nil), nil
+}
+
+func crdWaitEstablished(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	timeoutSeconds := p.OptionalInt64("timeout", crdWaitDefaultTimeoutSeconds)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to wait for CustomResourceDefinition: %w", err)), nil
+	}
+	timeout := time.Duration(timeoutSeconds) * time.Second
+	client := params.DynamicClient().Resource(crdGVR)
+	start := time.Now()
+	var lastReason string
+	err := wait.PollUntilContextTimeout(params.Context, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		crd, getErr := client.Get(ctx, name, metav1.GetOptions{})
+		if getErr != nil {
+			return false, getErr
+		}
+		status, reason, message := crdCondition(crd, "Established")
+		if status == "True" {
+			return true, nil
+		}
+		lastReason = reason
+		if message != "" {
+			lastReason += ": " + message
+		}
+		// A CRD with naming conflicts never establishes; fail fast with the
+		// reported reason instead of burning the whole timeout.
+		if namesStatus, namesReason, namesMessage := crdCondition(crd, "NamesAccepted"); namesStatus == "False" {
+			return false, fmt.Errorf("names not accepted (%s: %s)", namesReason, namesMessage)
+		}
+		return false, nil
+	})
+	if err != nil {
+		detail := ""
+		if lastReason != "" {
+			detail = fmt.Sprintf(" (last condition: %s)", lastReason)
+		}
+		return api.NewToolCallResult("", fmt.Errorf("CustomResourceDefinition %s did not become established within %s%s: %w", name, timeout, detail, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("CustomResourceDefinition %s is established (waited %s)", name, time.Since(start).Round(time.Millisecond)), nil), nil
+}
+
+// crdCondition extracts the status, reason, and message of the named condition
+// from a CustomResourceDefinition object.
+func crdCondition(crd *unstructured.Unstructured, conditionType string) (status, reason, message string) {
+	conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != conditionType {
+			continue
+		}
+		status, _ = condition["status"].(string)
+		reason, _ = condition["reason"].(string)
+		message, _ = condition["message"].(string)
+		return
+	}
+	return
+}
+
+// crdServedVersions returns the names of the versions a CRD serves.
+func crdServedVersions(crd *unstructured.Unstructured) []string {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	var served []string
+	for _, v := range versions {
+		version, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		if isServed, _ := version["served"].(bool); !isServed {
+			continue
+		}
+		if name, _ := version["name"].(string); name != "" {
+			served = append(served, name)
+		}
+	}
+	return served
+}
@@ -0,0 +1,247 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+// diagnoseLogTail is how many lines of (previous) container logs are included
+// in the diagnosis report.
+const diagnoseLogTail = 50
+
+func initDiagnose() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "pods_diagnose",
+			Description: "Diagnose a failing pod in one call: bundles status conditions, container states with last exit codes and restart counts, recent events, " +
+				"probe configuration, resource requests/limits vs current usage, and the tail of the previous container logs into a single report",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the pod to diagnose",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the pod (Optional, current namespace if not provided)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Diagnose",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsDiagnose},
+	}
+}
+
+func podsDiagnose(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diagnose pod: %w", err)), nil
+	}
+
+	pod, err := params.CoreV1().Pods(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diagnose pod '%s/%s': %w", namespace, name, err)), nil
+	}
+
+	sections := []string{fmt.Sprintf("Diagnosis of pod %s/%s (phase: %s, node: %s)", pod.Namespace, pod.Name, pod.Status.Phase, pod.Spec.NodeName)}
+	if lines := diagnoseConditions(pod); len(lines) > 0 {
+		sections = append(sections, "Conditions:\n"+strings.Join(lines, "\n"))
+	}
+	if lines := diagnoseContainers(pod); len(lines) > 0 {
+		sections = append(sections, "Containers:\n"+strings.Join(lines, "\n"))
+	}
+	if lines := diagnoseProbes(pod); len(lines) > 0 {
+		sections = append(sections, "Probes:\n"+strings.Join(lines, "\n"))
+	}
+	if lines := diagnoseResources(params, pod); len(lines) > 0 {
+		sections = append(sections, "Resources:\n"+strings.Join(lines, "\n"))
+	}
+	if lines := diagnoseEvents(params, pod); len(lines) > 0 {
+		sections = append(sections, "Recent events:\n"+strings.Join(lines, "\n"))
+	}
+	sections = append(sections, diagnoseLogs(params, pod)...)
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+func diagnoseConditions(pod *corev1.Pod) []string {
+	var lines []string
+	for _, condition := range pod.Status.Conditions {
+		line := fmt.Sprintf("- %s: %s", condition.Type, condition.Status)
+		if condition.Reason != "" {
+			line += " (" + condition.Reason
+			if condition.Message != "" {
+				line += ": " + condition.Message
+			}
+			line += ")"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func diagnoseContainers(pod *corev1.Pod) []string {
+	var lines []string
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for i := range statuses {
+		status := &statuses[i]
+		line := fmt.Sprintf("- %s: %s, restarts=%d", status.Name, containerStateString(status.State), status.RestartCount)
+		if terminated := status.LastTerminationState.Terminated; terminated != nil {
+			line += fmt.Sprintf(", last state: terminated with exit code %d (%s)", terminated.ExitCode, terminated.Reason)
+			if terminated.Message != "" {
+				line += ": " + strings.TrimSpace(terminated.Message)
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func containerStateString(state corev1.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return "running since " + state.Running.StartedAt.Format("2006-01-02T15:04:05Z07:00")
+	case state.Waiting != nil:
+		reason := state.Waiting.Reason
+		if state.Waiting.Message != "" {
+			reason += ": " + state.Waiting.Message
+		}
+		return "waiting (" + reason + ")"
+	case state.Terminated != nil:
+		return fmt.Sprintf("terminated with exit code %d (%s)", state.Terminated.ExitCode, state.Terminated.Reason)
+	}
+	return "unknown"
+}
+
+func diagnoseProbes(pod *corev1.Pod) []string {
+	var lines []string
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		for _, probe := range []struct {
+			name  string
+			probe *corev1.Probe
+		}{
+			{"liveness", container.LivenessProbe},
+			{"readiness", container.ReadinessProbe},
+			{"startup", container.StartupProbe},
+		} {
+			if probe.probe == nil {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("- %s %s: %s (initialDelay=%ds, period=%ds, timeout=%ds, failureThreshold=%d)",
+				container.Name, probe.name, probeHandlerString(probe.probe),
+				probe.probe.InitialDelaySeconds, probe.probe.PeriodSeconds, probe.probe.TimeoutSeconds, probe.probe.FailureThreshold))
+		}
+	}
+	if len(lines) == 0 {
+		return []string{"- no probes configured"}
+	}
+	return lines
+}
+
+func probeHandlerString(probe *corev1.Probe) string {
+	switch {
+	case probe.HTTPGet != nil:
+		return fmt.Sprintf("httpGet %s port %s", probe.HTTPGet.Path, probe.HTTPGet.Port.String())
+	case probe.TCPSocket != nil:
+		return "tcpSocket port " + probe.TCPSocket.Port.String()
+	case probe.Exec != nil:
+		return "exec " + strings.Join(probe.Exec.Command, " ")
+	case probe.GRPC != nil:
+		return fmt.Sprintf("grpc port %d", probe.GRPC.Port)
+	}
+	return "unknown handler"
+}
+
+// diagnoseResources renders requests/limits per container, with current usage
+// from the metrics API when available.
+func diagnoseResources(params api.ToolHandlerParams, pod *corev1.Pod) []string {
+	usage := map[string]corev1.ResourceList{}
+	if metrics, err := kubernetes.NewCore(params).PodsTop(params.Context, api.PodsTopOptions{Namespace: pod.Namespace, Name: pod.Name}); err == nil {
+		for _, podMetrics := range metrics.Items {
+			for _, containerMetrics := range podMetrics.Containers {
+				usage[containerMetrics.Name] = containerMetrics.Usage
+			}
+		}
+	}
+	var lines []string
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		line := fmt.Sprintf("- %s: requests=%s, limits=%s", container.Name,
+			resourceListString(container.Resources.Requests), resourceListString(container.Resources.Limits))
+		if containerUsage, ok := usage[container.Name]; ok {
+			line += ", usage=" + resourceListString(containerUsage)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func resourceListString(resources corev1.ResourceList) string {
+	if len(resources) == 0 {
+		return "none"
+	}
+	var parts []string
+	for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		if quantity, ok := resources[resourceName]; ok {
+			parts = append(parts, fmt.Sprintf("%s=%s", resourceName, quantity.String()))
+		}
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, ",")
+}
+
+func diagnoseEvents(params api.ToolHandlerParams, pod *corev1.Pod) []string {
+	events, err := params.CoreV1().Events(pod.Namespace).List(params.Context, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=Pod,involvedObject.name=%s", pod.Name),
+	})
+	if err != nil {
+		return nil
+	}
+	var lines []string
+	for i := range events.Items {
+		event := &events.Items[i]
+		lines = append(lines, fmt.Sprintf("- [%s] %s: %s", event.Type, event.Reason, event.Message))
+	}
+	return lines
+}
+
+// diagnoseLogs returns the tail of the previous container logs (the crashed
+// instance) when any container has restarted, falling back to current logs.
+func diagnoseLogs(params api.ToolHandlerParams, pod *corev1.Pod) []string {
+	restarted := false
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.RestartCount > 0 {
+			restarted = true
+			break
+		}
+	}
+	logs, err := kubernetes.NewCore(params).PodsLog(params.Context, pod.Namespace, pod.Name, "", restarted, diagnoseLogTail)
+	if err != nil || strings.TrimSpace(logs) == "" {
+		return nil
+	}
+	title := fmt.Sprintf("Log tail (last %d lines)", diagnoseLogTail)
+	if restarted {
+		title = fmt.Sprintf("Previous container log tail (last %d lines)", diagnoseLogTail)
+	}
+	return []string{title + ":\n" + logs}
+}
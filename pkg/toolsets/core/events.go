@@ -30,6 +30,30 @@ func initEvents() []api.ServerTool {
 					},
 				},
 			},
+			OutputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"items": {
+						Type:        "array",
+						Description: "The matching events",
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"Namespace": {Type: "string"},
+								"Timestamp": {Type: "string"},
+								"Type":      {Type: "string"},
+								"Reason":    {Type: "string"},
+								"InvolvedObject": {
+									Type:        "object",
+									Description: "The object this event is about (apiVersion, Kind, Name)",
+								},
+								"Message": {Type: "string"},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
 			Annotations: api.ToolAnnotations{
 				Title:           "Events: List",
 				ReadOnlyHint:    ptr.To(true),
@@ -43,7 +67,7 @@ func initEvents() []api.ServerTool {
 func eventsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	p := api.WrapParams(params)
 	namespace := p.OptionalString("namespace", "")
-	options := api.ListOptions{}
+	options := api.ListOptions{MaxObjects: params.GetListMaxObjects()}
 	options.FieldSelector = p.OptionalString("fieldSelector", "")
 	if err := p.Err(); err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to list events in all namespaces: %w", err)), nil
@@ -57,7 +81,7 @@ func eventsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	}
 	yamlEvents, err := output.MarshalYaml(eventMap)
 	if err != nil {
-		err = fmt.Errorf("failed to list events in all namespaces: %w", err)
+		return api.NewToolCallResult("", fmt.Errorf("failed to list events in all namespaces: %w", err)), nil
 	}
-	return api.NewToolCallResult(fmt.Sprintf("# The following events (YAML format) were found:\n%s", yamlEvents), err), nil
+	return api.NewToolCallResultFull(fmt.Sprintf("# The following events (YAML format) were found:\n%s", yamlEvents), eventMap, nil), nil
 }
@@ -0,0 +1,170 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// exportableResources are the declarative resources worth seeding into a
+// GitOps repository; runtime objects (pods, replica sets, endpoints, events)
+// are deliberately absent.
+var exportableResources = []struct {
+	gvr  schema.GroupVersionResource
+	kind string
+}{
+	{schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, "Deployment"},
+	{schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}, "StatefulSet"},
+	{schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}, "DaemonSet"},
+	{schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}, "CronJob"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}, "Service"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "configmaps"}, "ConfigMap"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "serviceaccounts"}, "ServiceAccount"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumeclaims"}, "PersistentVolumeClaim"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "resourcequotas"}, "ResourceQuota"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "limitranges"}, "LimitRange"},
+	{schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"}, "Role"},
+	{schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}, "RoleBinding"},
+	{schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}, "Ingress"},
+	{schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}, "NetworkPolicy"},
+	{schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}, "HorizontalPodAutoscaler"},
+	{schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}, "Route"},
+}
+
+// strippedAnnotations are runtime annotations that must not be committed to a
+// GitOps repository.
+var strippedAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"deployment.kubernetes.io/revision",
+	"kubernetes.io/service-account.uid",
+}
+
+func initExport() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "gitops_export",
+			Description: "Export the declarative resources of a namespace as clean, apply-ready YAML: status, managedFields, and other " +
+				"cluster-specific fields are stripped, and each document is prefixed with a suggested repository path " +
+				"(namespace/kind/name.yaml) so the output can be committed directly to a GitOps repository",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace whose resources to export",
+					},
+					"kinds": {
+						Type:        "string",
+						Description: `Comma-separated list of kinds to export (Optional, e.g. "Deployment,Service"; all supported kinds if not provided)`,
+					},
+				},
+				Required: []string{"namespace"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "GitOps: Export",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: gitopsExport},
+	}
+}
+
+func gitopsExport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	kindsFilter := p.OptionalString("kinds", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to export the namespace: %w", err)), nil
+	}
+	wanted := map[string]bool{}
+	for _, kind := range strings.Split(kindsFilter, ",") {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			wanted[kind] = true
+		}
+	}
+
+	var documents []string
+	exported := 0
+	for _, resource := range exportableResources {
+		if len(wanted) > 0 && !wanted[resource.kind] {
+			continue
+		}
+		list, err := params.DynamicClient().Resource(resource.gvr).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+		if err != nil {
+			// Kinds missing from the cluster (e.g. Route on plain Kubernetes) are skipped.
+			continue
+		}
+		for i := range list.Items {
+			item := &list.Items[i]
+			cleanExportedObject(item)
+			rendered, err := yaml.Marshal(item.Object)
+			if err != nil {
+				return api.NewToolCallResult("", fmt.Errorf("failed to render %s %s as YAML: %w", resource.kind, item.GetName(), err)), nil
+			}
+			documents = append(documents, fmt.Sprintf("# %s/%s/%s.yaml\n%s", namespace, strings.ToLower(resource.kind), item.GetName(), string(rendered)))
+			exported++
+		}
+	}
+	if exported == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("Namespace %s has no exportable resources", namespace), nil), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Exported %d resource(s) from namespace %s; each document starts with its suggested repository path:\n\n%s",
+		exported, namespace, strings.Join(documents, "---\n")), nil), nil
+}
+
+// cleanExportedObject strips the runtime and cluster-specific fields so the
+// object can be applied to another cluster (or the same one) as-is.
+func cleanExportedObject(object *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(object.Object, "status")
+	unstructured.RemoveNestedField(object.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(object.Object, "metadata", "uid")
+	unstructured.RemoveNestedField(object.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(object.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(object.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(object.Object, "metadata", "ownerReferences")
+
+	annotations := object.GetAnnotations()
+	for _, annotation := range strippedAnnotations {
+		delete(annotations, annotation)
+	}
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(object.Object, "metadata", "annotations")
+	} else {
+		object.SetAnnotations(annotations)
+	}
+
+	if object.GetKind() == "Service" {
+		unstructured.RemoveNestedField(object.Object, "spec", "clusterIP")
+		unstructured.RemoveNestedField(object.Object, "spec", "clusterIPs")
+		unstructured.RemoveNestedField(object.Object, "spec", "healthCheckNodePort")
+		ports, found, _ := unstructured.NestedSlice(object.Object, "spec", "ports")
+		if found {
+			for _, raw := range ports {
+				if port, ok := raw.(map[string]any); ok {
+					delete(port, "nodePort")
+				}
+			}
+			_ = unstructured.SetNestedSlice(object.Object, ports, "spec", "ports")
+		}
+	}
+	if object.GetKind() == "PersistentVolumeClaim" {
+		unstructured.RemoveNestedField(object.Object, "spec", "volumeName")
+		unstructured.RemoveNestedField(object.Object, "metadata", "finalizers")
+	}
+	// ServiceAccounts reference their generated token secrets; those are
+	// cluster-specific.
+	if object.GetKind() == "ServiceAccount" {
+		unstructured.RemoveNestedField(object.Object, "secrets")
+		unstructured.RemoveNestedField(object.Object, "imagePullSecrets")
+	}
+}
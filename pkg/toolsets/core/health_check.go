@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/klogutil"
 	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/parallel"
 )
 
 // podHighRestartThreshold is the number of container restarts above which a pod
@@ -569,13 +571,17 @@ func gatherEventDiagnostics(params api.PromptHandlerParams, namespace string) (s
 	totalErrors := 0
 	var recentEvents []string
 
-	for _, ns := range namespaces {
-		eventList, err := params.CoreV1().Events(ns).List(params.Context, metav1.ListOptions{})
-		if err != nil {
-			continue
-		}
+	// Fan the per-namespace listing out concurrently; on clusters with
+	// hundreds of openshift-* namespaces the sequential calls dominate the
+	// prompt's latency.
+	eventLists, failedNamespaces := parallel.ForEachNamespace(params.Context, namespaces, parallel.DefaultNamespaceWorkers,
+		func(ctx context.Context, ns string) (*v1.EventList, error) {
+			return params.CoreV1().Events(ns).List(ctx, metav1.ListOptions{})
+		})
 
+	for _, eventList := range eventLists {
 		for _, event := range eventList.Items {
+			ns := event.Namespace
 			// Only include Warning and Error events
 			if event.Type != v1.EventTypeWarning && event.Type != "Error" {
 				continue
@@ -619,6 +625,14 @@ func gatherEventDiagnostics(params api.PromptHandlerParams, namespace string) (s
 	} else {
 		sb.WriteString("*No recent warning/error events*")
 	}
+	if len(failedNamespaces) > 0 {
+		names := make([]string, 0, len(failedNamespaces))
+		for _, failed := range failedNamespaces {
+			names = append(names, failed.Namespace)
+		}
+		fmt.Fprintf(&sb, "\n\n*Events could not be listed in %d namespace(s) (insufficient permissions?): %s*",
+			len(names), strings.Join(names, ", "))
+	}
 
 	return sb.String(), nil
 }
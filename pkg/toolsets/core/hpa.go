@@ -0,0 +1,384 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func initHpa() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "hpa_list",
+			Description: "List HorizontalPodAutoscalers in the current or provided namespace, showing the scale target, replica bounds, and current vs desired replicas",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list HorizontalPodAutoscalers from (Optional, current namespace if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "HPA: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: hpaList},
+		{Tool: api.Tool{
+			Name: "hpa_get",
+			Description: "Get a HorizontalPodAutoscaler with scaling diagnostics: current vs desired replicas, per-metric current vs target values, recent scaling events, " +
+				"and metric availability problems (e.g. a missing metrics-server)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the HorizontalPodAutoscaler",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the HorizontalPodAutoscaler (Optional, current namespace if not provided)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "HPA: Get",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: hpaGet},
+		{Tool: api.Tool{
+			Name:        "hpa_create",
+			Description: "Create a HorizontalPodAutoscaler targeting a workload (e.g. a Deployment) with basic CPU and/or memory average utilization targets",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the HorizontalPodAutoscaler to create",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to create the HorizontalPodAutoscaler in (Optional, current namespace if not provided)",
+					},
+					"target_api_version": {
+						Type:        "string",
+						Description: "apiVersion of the workload to scale (e.g. apps/v1)",
+					},
+					"target_kind": {
+						Type:        "string",
+						Description: "kind of the workload to scale (e.g. Deployment, StatefulSet)",
+					},
+					"target_name": {
+						Type:        "string",
+						Description: "Name of the workload to scale",
+					},
+					"min_replicas": {
+						Type:        "integer",
+						Description: "Minimum number of replicas (Optional, defaults to 1)",
+					},
+					"max_replicas": {
+						Type:        "integer",
+						Description: "Maximum number of replicas",
+					},
+					"cpu_utilization": {
+						Type:        "integer",
+						Description: "Target average CPU utilization percentage (Optional, at least one of cpu_utilization or memory_utilization is required)",
+					},
+					"memory_utilization": {
+						Type:        "integer",
+						Description: "Target average memory utilization percentage (Optional, at least one of cpu_utilization or memory_utilization is required)",
+					},
+				},
+				Required: []string{"name", "target_api_version", "target_kind", "target_name", "max_replicas"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "HPA: Create",
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: hpaCreate},
+	}
+}
+
+func hpaList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list horizontal pod autoscalers: %w", err)), nil
+	}
+
+	hpas, err := params.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list horizontal pod autoscalers in namespace '%s': %w", namespace, err)), nil
+	}
+	if len(hpas.Items) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No HorizontalPodAutoscalers found in namespace '%s'", namespace), nil), nil
+	}
+	var lines []string
+	for i := range hpas.Items {
+		hpa := &hpas.Items[i]
+		minReplicas := int32(1)
+		if hpa.Spec.MinReplicas != nil {
+			minReplicas = *hpa.Spec.MinReplicas
+		}
+		line := fmt.Sprintf("- %s: targets %s/%s, min=%d, max=%d, current=%d, desired=%d",
+			hpa.Name, hpa.Spec.ScaleTargetRef.Kind, hpa.Spec.ScaleTargetRef.Name,
+			minReplicas, hpa.Spec.MaxReplicas, hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas)
+		if problem := hpaMetricProblem(hpa); problem != "" {
+			line += " - WARNING: " + problem
+		}
+		lines = append(lines, line)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("HorizontalPodAutoscalers (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+func hpaGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get horizontal pod autoscaler: %w", err)), nil
+	}
+
+	hpa, err := params.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get horizontal pod autoscaler '%s/%s': %w", namespace, name, err)), nil
+	}
+
+	minReplicas := int32(1)
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	sections := []string{fmt.Sprintf("HorizontalPodAutoscaler %s/%s targets %s/%s (min=%d, max=%d)\nCurrent replicas: %d, desired replicas: %d",
+		hpa.Namespace, hpa.Name, hpa.Spec.ScaleTargetRef.Kind, hpa.Spec.ScaleTargetRef.Name,
+		minReplicas, hpa.Spec.MaxReplicas, hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas)}
+
+	if lines := hpaMetrics(hpa); len(lines) > 0 {
+		sections = append(sections, "Metrics (current vs target):\n"+strings.Join(lines, "\n"))
+	}
+	if lines := hpaConditions(hpa); len(lines) > 0 {
+		sections = append(sections, "Conditions:\n"+strings.Join(lines, "\n"))
+	}
+	if problem := hpaMetricProblem(hpa); problem != "" {
+		sections = append(sections, "WARNING: "+problem)
+	}
+	if lines := hpaEvents(params, hpa); len(lines) > 0 {
+		sections = append(sections, "Recent events:\n"+strings.Join(lines, "\n"))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// hpaMetrics pairs each spec metric with its current value from status, by
+// position (status metrics are reported in spec order).
+func hpaMetrics(hpa *autoscalingv2.HorizontalPodAutoscaler) []string {
+	var lines []string
+	for i, metric := range hpa.Spec.Metrics {
+		target := hpaMetricTargetString(&metric)
+		current := "<unknown>"
+		if i < len(hpa.Status.CurrentMetrics) {
+			current = hpaMetricCurrentString(&hpa.Status.CurrentMetrics[i])
+		}
+		lines = append(lines, fmt.Sprintf("- %s: %s (target %s)", hpaMetricName(&metric), current, target))
+	}
+	return lines
+}
+
+func hpaMetricName(metric *autoscalingv2.MetricSpec) string {
+	switch metric.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		return string(metric.Resource.Name)
+	case autoscalingv2.PodsMetricSourceType:
+		return metric.Pods.Metric.Name
+	case autoscalingv2.ObjectMetricSourceType:
+		return metric.Object.Metric.Name
+	case autoscalingv2.ExternalMetricSourceType:
+		return metric.External.Metric.Name
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		return fmt.Sprintf("%s (container %s)", metric.ContainerResource.Name, metric.ContainerResource.Container)
+	}
+	return string(metric.Type)
+}
+
+func hpaMetricTargetString(metric *autoscalingv2.MetricSpec) string {
+	var target *autoscalingv2.MetricTarget
+	switch metric.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		target = &metric.Resource.Target
+	case autoscalingv2.PodsMetricSourceType:
+		target = &metric.Pods.Target
+	case autoscalingv2.ObjectMetricSourceType:
+		target = &metric.Object.Target
+	case autoscalingv2.ExternalMetricSourceType:
+		target = &metric.External.Target
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		target = &metric.ContainerResource.Target
+	}
+	if target == nil {
+		return "<none>"
+	}
+	switch {
+	case target.AverageUtilization != nil:
+		return fmt.Sprintf("%d%% average utilization", *target.AverageUtilization)
+	case target.AverageValue != nil:
+		return target.AverageValue.String() + " average value"
+	case target.Value != nil:
+		return target.Value.String()
+	}
+	return "<none>"
+}
+
+func hpaMetricCurrentString(metric *autoscalingv2.MetricStatus) string {
+	var current *autoscalingv2.MetricValueStatus
+	switch metric.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		current = &metric.Resource.Current
+	case autoscalingv2.PodsMetricSourceType:
+		current = &metric.Pods.Current
+	case autoscalingv2.ObjectMetricSourceType:
+		current = &metric.Object.Current
+	case autoscalingv2.ExternalMetricSourceType:
+		current = &metric.External.Current
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		current = &metric.ContainerResource.Current
+	}
+	if current == nil {
+		return "<unknown>"
+	}
+	switch {
+	case current.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *current.AverageUtilization)
+	case current.AverageValue != nil:
+		return current.AverageValue.String()
+	case current.Value != nil:
+		return current.Value.String()
+	}
+	return "<unknown>"
+}
+
+func hpaConditions(hpa *autoscalingv2.HorizontalPodAutoscaler) []string {
+	var lines []string
+	for _, condition := range hpa.Status.Conditions {
+		line := fmt.Sprintf("- %s: %s", condition.Type, condition.Status)
+		if condition.Reason != "" {
+			line += " (" + condition.Reason
+			if condition.Message != "" {
+				line += ": " + condition.Message
+			}
+			line += ")"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// hpaMetricProblem detects the common "metrics unavailable" failure mode: the
+// ScalingActive condition is False with a FailedGetResourceMetric-style reason,
+// which usually means the metrics-server (or custom metrics adapter) is
+// missing or not serving.
+func hpaMetricProblem(hpa *autoscalingv2.HorizontalPodAutoscaler) string {
+	for _, condition := range hpa.Status.Conditions {
+		if condition.Type != autoscalingv2.ScalingActive || condition.Status != corev1.ConditionFalse {
+			continue
+		}
+		problem := fmt.Sprintf("metrics are unavailable (%s: %s)", condition.Reason, condition.Message)
+		if strings.HasPrefix(condition.Reason, "FailedGet") {
+			problem += " - verify that the metrics-server (or the relevant custom metrics adapter) is installed and healthy"
+		}
+		return problem
+	}
+	return ""
+}
+
+func hpaEvents(params api.ToolHandlerParams, hpa *autoscalingv2.HorizontalPodAutoscaler) []string {
+	events, err := params.CoreV1().Events(hpa.Namespace).List(params.Context, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=HorizontalPodAutoscaler,involvedObject.name=%s", hpa.Name),
+	})
+	if err != nil {
+		return nil
+	}
+	var lines []string
+	for i := range events.Items {
+		event := &events.Items[i]
+		lines = append(lines, fmt.Sprintf("- [%s] %s: %s", event.Type, event.Reason, event.Message))
+	}
+	return lines
+}
+
+func hpaCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	targetAPIVersion := p.RequiredString("target_api_version")
+	targetKind := p.RequiredString("target_kind")
+	targetName := p.RequiredString("target_name")
+	minReplicas := p.OptionalInt64("min_replicas", 1)
+	maxReplicas := p.OptionalInt64("max_replicas", 0)
+	cpuUtilization := p.OptionalInt64("cpu_utilization", 0)
+	memoryUtilization := p.OptionalInt64("memory_utilization", 0)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create horizontal pod autoscaler: %w", err)), nil
+	}
+	if maxReplicas < minReplicas || maxReplicas < 1 {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create horizontal pod autoscaler: max_replicas must be at least 1 and not lower than min_replicas")), nil
+	}
+	if cpuUtilization == 0 && memoryUtilization == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create horizontal pod autoscaler: at least one of cpu_utilization or memory_utilization is required")), nil
+	}
+
+	var metrics []autoscalingv2.MetricSpec
+	for _, resource := range []struct {
+		name        corev1.ResourceName
+		utilization int64
+	}{
+		{corev1.ResourceCPU, cpuUtilization},
+		{corev1.ResourceMemory, memoryUtilization},
+	} {
+		if resource.utilization <= 0 {
+			continue
+		}
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: resource.name,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: ptr.To(int32(resource.utilization)),
+				},
+			},
+		})
+	}
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: targetAPIVersion,
+				Kind:       targetKind,
+				Name:       targetName,
+			},
+			MinReplicas: ptr.To(int32(minReplicas)),
+			MaxReplicas: int32(maxReplicas),
+			Metrics:     metrics,
+		},
+	}
+	created, err := params.AutoscalingV2().HorizontalPodAutoscalers(namespace).Create(params.Context, hpa, metav1.CreateOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create horizontal pod autoscaler '%s/%s': %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Created HorizontalPodAutoscaler '%s/%s' targeting %s/%s (min=%d, max=%d)",
+		created.Namespace, created.Name, targetKind, targetName, minReplicas, maxReplicas), nil), nil
+}
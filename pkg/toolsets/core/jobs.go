@@ -0,0 +1,303 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+// jobsLogTail is how many log lines are returned for the latest Job pod.
+const jobsLogTail = 100
+
+func initJobs() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "jobs_list",
+			Description: "List Kubernetes Jobs and CronJobs in the current or provided namespace, with the last run status of each (completions, failures, last schedule and last successful run times)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list Jobs and CronJobs from (Optional, current namespace if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Jobs: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: jobsList},
+		{Tool: api.Tool{
+			Name:        "cronjobs_trigger",
+			Description: "Trigger a Kubernetes CronJob immediately by creating a Job from its job template, without waiting for the next scheduled run",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the CronJob to trigger",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the CronJob (Optional, current namespace if not provided)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CronJobs: Trigger",
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: cronJobsTrigger},
+		{Tool: api.Tool{
+			Name:        "cronjobs_suspend",
+			Description: "Suspend or resume a Kubernetes CronJob. A suspended CronJob keeps its schedule but no new Jobs are created until it is resumed",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the CronJob to suspend or resume",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the CronJob (Optional, current namespace if not provided)",
+					},
+					"suspend": {
+						Type:        "boolean",
+						Description: "true to suspend the CronJob, false to resume it",
+					},
+				},
+				Required: []string{"name", "suspend"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CronJobs: Suspend",
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: cronJobsSuspend},
+		{Tool: api.Tool{
+			Name:        "jobs_log",
+			Description: "Get the logs of the most recently created pod of a Kubernetes Job in the current or provided namespace",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the Job to get the logs from",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Job (Optional, current namespace if not provided)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Jobs: Log",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: jobsLog},
+	}
+}
+
+func jobsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list jobs: %w", err)), nil
+	}
+
+	jobs, err := params.BatchV1().Jobs(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list jobs in namespace '%s': %w", namespace, err)), nil
+	}
+	cronJobs, err := params.BatchV1().CronJobs(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list cronjobs in namespace '%s': %w", namespace, err)), nil
+	}
+
+	var sections []string
+	var jobLines []string
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		jobLines = append(jobLines, "- "+job.Name+": "+jobStatusString(job))
+	}
+	if len(jobLines) > 0 {
+		sections = append(sections, fmt.Sprintf("Jobs (%d):\n%s", len(jobLines), strings.Join(jobLines, "\n")))
+	}
+	var cronJobLines []string
+	for i := range cronJobs.Items {
+		cronJob := &cronJobs.Items[i]
+		line := fmt.Sprintf("- %s: schedule=%s", cronJob.Name, cronJob.Spec.Schedule)
+		if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend {
+			line += ", SUSPENDED"
+		}
+		if len(cronJob.Status.Active) > 0 {
+			line += fmt.Sprintf(", %d active job(s)", len(cronJob.Status.Active))
+		}
+		if cronJob.Status.LastScheduleTime != nil {
+			line += ", last scheduled " + cronJob.Status.LastScheduleTime.Format(time.RFC3339)
+		}
+		if cronJob.Status.LastSuccessfulTime != nil {
+			line += ", last successful " + cronJob.Status.LastSuccessfulTime.Format(time.RFC3339)
+		} else {
+			line += ", never completed successfully"
+		}
+		cronJobLines = append(cronJobLines, line)
+	}
+	if len(cronJobLines) > 0 {
+		sections = append(sections, fmt.Sprintf("CronJobs (%d):\n%s", len(cronJobLines), strings.Join(cronJobLines, "\n")))
+	}
+	if len(sections) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No Jobs or CronJobs found in namespace '%s'", namespace), nil), nil
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// jobStatusString summarizes a Job's run status: completion or failure with
+// reason, active pod count, and duration when finished.
+func jobStatusString(job *batchv1.Job) string {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != "True" {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			status := fmt.Sprintf("completed (%d succeeded)", job.Status.Succeeded)
+			if job.Status.StartTime != nil && job.Status.CompletionTime != nil {
+				status += ", took " + job.Status.CompletionTime.Sub(job.Status.StartTime.Time).Round(time.Second).String()
+			}
+			return status
+		case batchv1.JobFailed:
+			status := fmt.Sprintf("FAILED (%d failed)", job.Status.Failed)
+			if condition.Reason != "" {
+				status += " - " + condition.Reason
+				if condition.Message != "" {
+					status += ": " + condition.Message
+				}
+			}
+			return status
+		}
+	}
+	if job.Status.Active > 0 {
+		return fmt.Sprintf("running (%d active, %d succeeded, %d failed)", job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+	}
+	return "pending"
+}
+
+func cronJobsTrigger(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to trigger cronjob: %w", err)), nil
+	}
+
+	cronJob, err := params.BatchV1().CronJobs(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to trigger cronjob '%s/%s': %w", namespace, name, err)), nil
+	}
+
+	// Mirrors `kubectl create job --from=cronjob/<name>`: the Job inherits the
+	// template and is annotated as a manual instantiation.
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-manual-%d", cronJob.Name, time.Now().Unix()),
+			Namespace:   cronJob.Namespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: map[string]string{"cronjob.kubernetes.io/instantiate": "manual"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: batchv1.SchemeGroupVersion.String(),
+				Kind:       "CronJob",
+				Name:       cronJob.Name,
+				UID:        cronJob.UID,
+			}},
+		},
+		Spec: cronJob.Spec.JobTemplate.Spec,
+	}
+	for k, v := range cronJob.Spec.JobTemplate.Annotations {
+		job.Annotations[k] = v
+	}
+	created, err := params.BatchV1().Jobs(namespace).Create(params.Context, job, metav1.CreateOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to trigger cronjob '%s/%s': %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Created job '%s/%s' from cronjob '%s'", created.Namespace, created.Name, cronJob.Name), nil), nil
+}
+
+func cronJobsSuspend(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	suspend := p.OptionalBool("suspend", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to suspend cronjob: %w", err)), nil
+	}
+
+	cronJob, err := params.BatchV1().CronJobs(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to suspend cronjob '%s/%s': %w", namespace, name, err)), nil
+	}
+	verb := "suspended"
+	if !suspend {
+		verb = "resumed"
+	}
+	if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend == suspend {
+		return api.NewToolCallResult(fmt.Sprintf("CronJob '%s/%s' is already %s", namespace, name, verb), nil), nil
+	}
+	cronJob.Spec.Suspend = ptr.To(suspend)
+	if _, err = params.BatchV1().CronJobs(namespace).Update(params.Context, cronJob, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to suspend cronjob '%s/%s': %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("CronJob '%s/%s' %s", namespace, name, verb), nil), nil
+}
+
+func jobsLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get job logs: %w", err)), nil
+	}
+
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{
+		LabelSelector: "job-name=" + name,
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get job logs for '%s/%s': %w", namespace, name, err)), nil
+	}
+	if len(pods.Items) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No pods found for job '%s/%s'", namespace, name), nil), nil
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].CreationTimestamp.After(pods.Items[j].CreationTimestamp.Time)
+	})
+	pod := &pods.Items[0]
+	logs, err := kubernetes.NewCore(params).PodsLog(params.Context, namespace, pod.Name, "", false, jobsLogTail)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get job logs for '%s/%s' from pod '%s': %w", namespace, name, pod.Name, err)), nil
+	}
+	if strings.TrimSpace(logs) == "" {
+		return api.NewToolCallResult(fmt.Sprintf("Pod '%s/%s' (latest pod of job '%s') has produced no logs", namespace, pod.Name, name), nil), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Logs of pod '%s/%s' (latest pod of job '%s', last %d lines):\n%s", namespace, pod.Name, name, jobsLogTail, logs), nil), nil
+}
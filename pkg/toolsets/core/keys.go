@@ -0,0 +1,221 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func initKeys() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "configmaps_get_key",
+			Description: "Read a single key from a Kubernetes ConfigMap without fetching the full manifest",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the ConfigMap",
+					},
+					"key": {
+						Type:        "string",
+						Description: "Key to read",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the ConfigMap (Optional, current namespace if not provided)",
+					},
+				},
+				Required: []string{"name", "key"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ConfigMaps: Get Key",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: configMapsGetKey},
+		{Tool: api.Tool{
+			Name: "configmaps_set_key",
+			Description: "Set a single key in a Kubernetes ConfigMap to the provided value, adding the key if it does not exist. " +
+				"Replacing an existing value requires overwrite to be true",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the ConfigMap",
+					},
+					"key": {
+						Type:        "string",
+						Description: "Key to set",
+					},
+					"value": {
+						Type:        "string",
+						Description: "Value to store under the key",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the ConfigMap (Optional, current namespace if not provided)",
+					},
+					"overwrite": {
+						Type:        "boolean",
+						Description: "Allow replacing the value of a key that already exists (Optional, defaults to false)",
+					},
+				},
+				Required: []string{"name", "key", "value"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ConfigMaps: Set Key",
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: configMapsSetKey},
+		{Tool: api.Tool{
+			Name: "secrets_get_key",
+			Description: "Read a single key from a Kubernetes Secret. The value is redacted by default (only its length is reported); " +
+				"pass reveal to return the decoded plaintext. Access to Secrets remains subject to the server's denied-resources policy",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the Secret",
+					},
+					"key": {
+						Type:        "string",
+						Description: "Key to read",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Secret (Optional, current namespace if not provided)",
+					},
+					"reveal": {
+						Type:        "boolean",
+						Description: "Return the decoded plaintext value instead of a redacted summary (Optional, defaults to false)",
+					},
+				},
+				Required: []string{"name", "key"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Secrets: Get Key",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: secretsGetKey},
+	}
+}
+
+func configMapsGetKey(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	key := p.RequiredString("key")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get configmap key: %w", err)), nil
+	}
+	configMap, err := params.CoreV1().ConfigMaps(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get configmap %s/%s: %w", namespace, name, err)), nil
+	}
+	if value, ok := configMap.Data[key]; ok {
+		return api.NewToolCallResult(value, nil), nil
+	}
+	if value, ok := configMap.BinaryData[key]; ok {
+		return api.NewToolCallResult("", fmt.Errorf(
+			"key %s of configmap %s/%s holds binary data (%d bytes) and cannot be returned as text", key, namespace, name, len(value))), nil
+	}
+	return api.NewToolCallResult("", fmt.Errorf(
+		"configmap %s/%s has no key %s (available keys: %s)", namespace, name, key, strings.Join(configMapKeys(configMap.Data, configMap.BinaryData), ", "))), nil
+}
+
+func configMapsSetKey(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	key := p.RequiredString("key")
+	value := p.RequiredString("value")
+	overwrite := p.OptionalBool("overwrite", false)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set configmap key: %w", err)), nil
+	}
+	configMap, err := params.CoreV1().ConfigMaps(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get configmap %s/%s: %w", namespace, name, err)), nil
+	}
+	existing, exists := configMap.Data[key]
+	if exists && existing == value {
+		return api.NewToolCallResult(fmt.Sprintf("Key %s of configmap %s/%s already has the provided value", key, namespace, name), nil), nil
+	}
+	if exists && !overwrite {
+		return api.NewToolCallResult("", fmt.Errorf(
+			"configmap %s/%s already has a value for key %s: pass overwrite to replace it", namespace, name, key)), nil
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[key] = value
+	if _, err = params.CoreV1().ConfigMaps(namespace).Update(params.Context, configMap, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update configmap %s/%s: %w", namespace, name, err)), nil
+	}
+	action := "added to"
+	if exists {
+		action = "updated in"
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Key %s %s configmap %s/%s", key, action, namespace, name), nil), nil
+}
+
+func secretsGetKey(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	key := p.RequiredString("key")
+	reveal := p.OptionalBool("reveal", false)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get secret key: %w", err)), nil
+	}
+	secret, err := params.CoreV1().Secrets(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get secret %s/%s: %w", namespace, name, err)), nil
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		keys := make([]string, 0, len(secret.Data))
+		for k := range secret.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return api.NewToolCallResult("", fmt.Errorf(
+			"secret %s/%s has no key %s (available keys: %s)", namespace, name, key, strings.Join(keys, ", "))), nil
+	}
+	if !reveal {
+		return api.NewToolCallResult(fmt.Sprintf(
+			"Key %s of secret %s/%s holds %d bytes (redacted); pass reveal to return the plaintext", key, namespace, name, len(value)), nil), nil
+	}
+	return api.NewToolCallResult(string(value), nil), nil
+}
+
+// configMapKeys returns the sorted union of a ConfigMap's data and binaryData
+// keys.
+func configMapKeys(data map[string]string, binaryData map[string][]byte) []string {
+	keys := make([]string, 0, len(data)+len(binaryData))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	for key := range binaryData {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,236 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initLabel() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "resources_label",
+			Description: "Add or remove labels and annotations on an existing Kubernetes object without sending back the whole manifest. " +
+				"Existing keys are protected: setting a key that already has a different value fails unless overwrite is true",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the object (examples of valid apiVersion are: v1, apps/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the object (examples of valid kind are: Pod, Deployment)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the object",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace of the object. If not provided, will use the configured namespace",
+					},
+					"labels": {
+						Type:                 "object",
+						Description:          "Labels to set on the object as key-value pairs (Optional)",
+						AdditionalProperties: &jsonschema.Schema{Type: "string"},
+					},
+					"annotations": {
+						Type:                 "object",
+						Description:          "Annotations to set on the object as key-value pairs (Optional)",
+						AdditionalProperties: &jsonschema.Schema{Type: "string"},
+					},
+					"remove_labels": {
+						Type:        "array",
+						Description: "Label keys to remove from the object (Optional)",
+						Items:       &jsonschema.Schema{Type: "string"},
+					},
+					"remove_annotations": {
+						Type:        "array",
+						Description: "Annotation keys to remove from the object (Optional)",
+						Items:       &jsonschema.Schema{Type: "string"},
+					},
+					"overwrite": {
+						Type:        "boolean",
+						Description: "Allow replacing the value of keys that already exist with a different value (Optional, defaults to false)",
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Label",
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesLabel},
+	}
+}
+
+func resourcesLabel(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	apiVersion := p.RequiredString("apiVersion")
+	kind := p.RequiredString("kind")
+	name := p.RequiredString("name")
+	namespace := p.OptionalString("namespace", "")
+	overwrite := p.OptionalBool("overwrite", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %w", err)), nil
+	}
+	labels, err := labelStringMapArgument(params, "labels")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %w", err)), nil
+	}
+	annotations, err := labelStringMapArgument(params, "annotations")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %w", err)), nil
+	}
+	removeLabels, err := labelStringSliceArgument(params, "remove_labels")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %w", err)), nil
+	}
+	removeAnnotations, err := labelStringSliceArgument(params, "remove_annotations")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %w", err)), nil
+	}
+	if len(labels) == 0 && len(annotations) == 0 && len(removeLabels) == 0 && len(removeAnnotations) == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: at least one of labels, annotations, remove_labels, or remove_annotations is required")), nil
+	}
+
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: invalid argument apiVersion")), nil
+	}
+	gvk := schema.GroupVersionKind{Group: gv.Group, Version: gv.Version, Kind: kind}
+	core := kubernetes.NewCore(params)
+
+	// Fetch the current object for the resolved namespace and the overwrite
+	// protection check.
+	obj, err := core.ResourcesGet(params, &gvk, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %w", err)), nil
+	}
+	if !overwrite {
+		var conflicts []string
+		for key, value := range labels {
+			if current, ok := obj.GetLabels()[key]; ok && current != value {
+				conflicts = append(conflicts, fmt.Sprintf("label '%s' is already set to '%s'", key, current))
+			}
+		}
+		for key, value := range annotations {
+			if current, ok := obj.GetAnnotations()[key]; ok && current != value {
+				conflicts = append(conflicts, fmt.Sprintf("annotation '%s' is already set to '%s'", key, current))
+			}
+		}
+		if len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %s (set overwrite to true to replace existing values)", strings.Join(conflicts, ", "))), nil
+		}
+	}
+
+	// A JSON merge patch on metadata: keys set to null are removed, the rest
+	// are merged into the existing maps.
+	patchLabels := map[string]interface{}{}
+	for key, value := range labels {
+		patchLabels[key] = value
+	}
+	for _, key := range removeLabels {
+		patchLabels[key] = nil
+	}
+	patchAnnotations := map[string]interface{}{}
+	for key, value := range annotations {
+		patchAnnotations[key] = value
+	}
+	for _, key := range removeAnnotations {
+		patchAnnotations[key] = nil
+	}
+	metadata := map[string]interface{}{}
+	if len(patchLabels) > 0 {
+		metadata["labels"] = patchLabels
+	}
+	if len(patchAnnotations) > 0 {
+		metadata["annotations"] = patchAnnotations
+	}
+	patch, err := json.Marshal(map[string]interface{}{"metadata": metadata})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %w", err)), nil
+	}
+
+	gvr, err := params.RESTMapper().RESTMapping(schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}, gvk.Version)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %w", err)), nil
+	}
+	patched, err := params.DynamicClient().Resource(gvr.Resource).Namespace(obj.GetNamespace()).
+		Patch(params.Context, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to label resource: %w", err)), nil
+	}
+
+	var changes []string
+	for key, value := range labels {
+		changes = append(changes, fmt.Sprintf("set label %s=%s", key, value))
+	}
+	for _, key := range removeLabels {
+		changes = append(changes, "removed label "+key)
+	}
+	for key, value := range annotations {
+		changes = append(changes, fmt.Sprintf("set annotation %s=%s", key, value))
+	}
+	for _, key := range removeAnnotations {
+		changes = append(changes, "removed annotation "+key)
+	}
+	sort.Strings(changes)
+	return api.NewToolCallResult(fmt.Sprintf("Updated %s/%s in namespace '%s': %s",
+		patched.GetKind(), patched.GetName(), patched.GetNamespace(), strings.Join(changes, ", ")), nil), nil
+}
+
+func labelStringMapArgument(params api.ToolHandlerParams, key string) (map[string]string, error) {
+	raw, ok := params.GetArguments()[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	rawMap, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s parameter must be an object with string values", key)
+	}
+	result := make(map[string]string, len(rawMap))
+	for k, v := range rawMap {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s parameter must be an object with string values", key)
+		}
+		result[k] = s
+	}
+	return result, nil
+}
+
+func labelStringSliceArgument(params api.ToolHandlerParams, key string) ([]string, error) {
+	raw, ok := params.GetArguments()[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	rawSlice, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s parameter must be an array of strings", key)
+	}
+	result := make([]string, 0, len(rawSlice))
+	for _, v := range rawSlice {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s parameter must be an array of strings", key)
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
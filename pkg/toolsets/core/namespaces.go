@@ -64,7 +64,7 @@ func initNamespaces(p api.FilteringProvider) []api.ServerTool {
 
 func namespacesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	p := api.WrapParams(params)
-	options := api.ListOptions{AsTable: params.ListOutput.AsTable()}
+	options := api.ListOptions{AsTable: params.ListOutput.AsTable(), MaxObjects: params.GetListMaxObjects()}
 	options.FieldSelector = p.OptionalString("fieldSelector", "")
 	if err := p.Err(); err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to list namespaces: %w", err)), nil
@@ -0,0 +1,272 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var applicationsGVR = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "applications"}
+
+// gitopsNamespace is where the OpenShift GitOps operator watches for Argo CD
+// Applications.
+const gitopsNamespace = "openshift-gitops"
+
+func initOnboard() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "tenant_onboard",
+			Description: "Onboard a tenant end to end: elicit any missing inputs from the user, produce an onboarding plan " +
+				"(namespace with quota, limits, network policy baseline, edit RBAC for the tenant's group, optional pull secret, " +
+				"and an optional Argo CD Application pointing at the tenant's Git repository), and apply the plan after user confirmation when execute=true",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"tenant": {
+						Type:        "string",
+						Description: "Name of the tenant; also used as the namespace name (Optional, elicited from the user if not provided)",
+					},
+					"profile": {
+						Type:        "string",
+						Description: "Sizing profile for the tenant's ResourceQuota (Optional, small by default)",
+						Enum:        []any{"small", "medium", "large"},
+					},
+					"group": {
+						Type:        "string",
+						Description: "Group to grant the edit ClusterRole in the tenant's namespace (Optional)",
+					},
+					"pullSecretFrom": {
+						Type:        "string",
+						Description: "Image pull secret to copy into the tenant's namespace, as namespace/name (Optional)",
+					},
+					"gitRepo": {
+						Type:        "string",
+						Description: "Git repository URL to deploy via an Argo CD Application (Optional)",
+					},
+					"gitPath": {
+						Type:        "string",
+						Description: "Path within the Git repository that holds the manifests (Optional, repository root if not provided)",
+					},
+					"execute": {
+						Type:        "boolean",
+						Description: "Apply the plan instead of only printing it; the user is asked for confirmation first (Optional, defaults to false)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Tenant: Onboard",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: tenantOnboard},
+	}
+}
+
+// onboardingInputs are the collected tenant onboarding parameters, filled from
+// the tool arguments and completed through elicitation.
+type onboardingInputs struct {
+	tenant         string
+	profile        string
+	group          string
+	pullSecretFrom string
+	gitRepo        string
+	gitPath        string
+}
+
+func tenantOnboard(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	inputs := onboardingInputs{
+		tenant:         p.OptionalString("tenant", ""),
+		profile:        p.OptionalString("profile", "small"),
+		group:          p.OptionalString("group", ""),
+		pullSecretFrom: p.OptionalString("pullSecretFrom", ""),
+		gitRepo:        p.OptionalString("gitRepo", ""),
+		gitPath:        p.OptionalString("gitPath", ""),
+	}
+	execute := p.OptionalBool("execute", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to onboard the tenant: %w", err)), nil
+	}
+
+	if inputs.tenant == "" {
+		declined, err := elicitOnboardingInputs(params, &inputs)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to onboard the tenant: %w", err)), nil
+		}
+		if declined {
+			return api.NewToolCallResult("Tenant onboarding was cancelled by the user; nothing was created", nil), nil
+		}
+	}
+	if _, ok := bootstrapProfiles[inputs.profile]; !ok {
+		return api.NewToolCallResult("", fmt.Errorf("unknown profile %s; supported profiles are small, medium, and large", inputs.profile)), nil
+	}
+
+	plan := renderOnboardingPlan(inputs)
+	if !execute {
+		return api.NewToolCallResult(plan+"\n\nRe-run with execute=true to apply this plan", nil), nil
+	}
+
+	confirmed, err := confirmOnboarding(params, plan)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to confirm the onboarding plan: %w", err)), nil
+	}
+	if !confirmed {
+		return api.NewToolCallResult("Onboarding plan was not confirmed; nothing was applied", nil), nil
+	}
+
+	lines, err := applyNamespaceBaseline(params, inputs.tenant, inputs.profile, inputs.group, inputs.pullSecretFrom)
+	if err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	if inputs.gitRepo != "" {
+		line, err := createTenantApplication(params, inputs)
+		if err != nil {
+			return api.NewToolCallResult("", err), nil
+		}
+		lines = append(lines, line)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Onboarded tenant %s:\n%s", inputs.tenant, strings.Join(lines, "\n")), nil), nil
+}
+
+// elicitOnboardingInputs asks the user for the missing onboarding parameters
+// through a form. It returns declined=true when the user declines or dismisses
+// the form.
+func elicitOnboardingInputs(params api.ToolHandlerParams, inputs *onboardingInputs) (declined bool, err error) {
+	if params.Elicitor == nil {
+		return false, fmt.Errorf("the tenant parameter is missing and %w; provide tenant explicitly", api.ErrElicitationNotSupported)
+	}
+	result, err := params.Elicitor.Elicit(params.Context, &api.ElicitParams{
+		Message: "Provide the tenant onboarding details",
+		RequestedSchema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"tenant": {
+					Type:        "string",
+					Description: "Name of the tenant; also used as the namespace name",
+				},
+				"profile": {
+					Type:        "string",
+					Description: "Sizing profile for the tenant's ResourceQuota",
+					Enum:        []any{"small", "medium", "large"},
+				},
+				"group": {
+					Type:        "string",
+					Description: "Group to grant the edit ClusterRole in the tenant's namespace (leave empty to skip)",
+				},
+				"gitRepo": {
+					Type:        "string",
+					Description: "Git repository URL to deploy via an Argo CD Application (leave empty to skip)",
+				},
+			},
+			Required: []string{"tenant"},
+		},
+	})
+	if err != nil {
+		if errors.Is(err, api.ErrElicitationNotSupported) {
+			return false, fmt.Errorf("the tenant parameter is missing and %w; provide tenant explicitly", err)
+		}
+		return false, err
+	}
+	if result.Action != api.ElicitActionAccept {
+		return true, nil
+	}
+	if tenant, ok := result.Content["tenant"].(string); ok && tenant != "" {
+		inputs.tenant = tenant
+	}
+	if inputs.tenant == "" {
+		return false, errors.New("the user did not provide a tenant name")
+	}
+	if profile, ok := result.Content["profile"].(string); ok && profile != "" {
+		inputs.profile = profile
+	}
+	if group, ok := result.Content["group"].(string); ok && group != "" {
+		inputs.group = group
+	}
+	if gitRepo, ok := result.Content["gitRepo"].(string); ok && gitRepo != "" {
+		inputs.gitRepo = gitRepo
+	}
+	return false, nil
+}
+
+func renderOnboardingPlan(inputs onboardingInputs) string {
+	steps := []string{
+		fmt.Sprintf("1. Create namespace %s", inputs.tenant),
+		fmt.Sprintf("2. Create ResourceQuota default-quota with the %s profile", inputs.profile),
+		"3. Create LimitRange default-limits with container defaults",
+		"4. Create NetworkPolicy allow-same-namespace (baseline: only same-namespace ingress)",
+	}
+	if inputs.group != "" {
+		steps = append(steps, fmt.Sprintf("%d. Grant the edit ClusterRole to group %s in the namespace", len(steps)+1, inputs.group))
+	}
+	if inputs.pullSecretFrom != "" {
+		steps = append(steps, fmt.Sprintf("%d. Copy pull secret %s into the namespace", len(steps)+1, inputs.pullSecretFrom))
+	}
+	if inputs.gitRepo != "" {
+		path := inputs.gitPath
+		if path == "" {
+			path = "."
+		}
+		steps = append(steps, fmt.Sprintf("%d. Create Argo CD Application %s in %s syncing %s (path %s) into the namespace", len(steps)+1, inputs.tenant, gitopsNamespace, inputs.gitRepo, path))
+	}
+	return fmt.Sprintf("Onboarding plan for tenant %s:\n%s", inputs.tenant, strings.Join(steps, "\n"))
+}
+
+// confirmOnboarding asks the user to confirm applying the plan via
+// elicitation. Execution proceeds only on an explicit accept; clients without
+// elicitation support cannot confirm, so execution is refused.
+func confirmOnboarding(params api.ToolHandlerParams, plan string) (bool, error) {
+	if params.Elicitor == nil {
+		return false, api.ErrElicitationNotSupported
+	}
+	result, err := params.Elicitor.Elicit(params.Context, &api.ElicitParams{
+		Message: fmt.Sprintf("About to apply the following onboarding plan. Do you want to proceed?\n\n%s", plan),
+	})
+	if err != nil {
+		if errors.Is(err, api.ErrElicitationNotSupported) {
+			return false, fmt.Errorf("cannot execute the plan: %w; re-run without execute and apply the steps manually", err)
+		}
+		return false, err
+	}
+	return result.Action == api.ElicitActionAccept, nil
+}
+
+func createTenantApplication(params api.ToolHandlerParams, inputs onboardingInputs) (string, error) {
+	path := inputs.gitPath
+	if path == "" {
+		path = "."
+	}
+	application := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Application",
+		"metadata":   map[string]any{"name": inputs.tenant, "namespace": gitopsNamespace},
+		"spec": map[string]any{
+			"project": "default",
+			"source": map[string]any{
+				"repoURL":        inputs.gitRepo,
+				"path":           path,
+				"targetRevision": "HEAD",
+			},
+			"destination": map[string]any{
+				"server":    "https://kubernetes.default.svc",
+				"namespace": inputs.tenant,
+			},
+			"syncPolicy": map[string]any{
+				"automated": map[string]any{"prune": false, "selfHeal": false},
+			},
+		},
+	}}
+	if _, err := params.DynamicClient().Resource(applicationsGVR).Namespace(gitopsNamespace).Create(params.Context, application, metav1.CreateOptions{}); err != nil {
+		return "", fmt.Errorf("failed to create Argo CD Application %s: %w", inputs.tenant, err)
+	}
+	return "- created Application " + inputs.tenant + " in " + gitopsNamespace, nil
+}
@@ -0,0 +1,31 @@
+package core
+
+import "github.com/google/jsonschema-go/jsonschema"
+
+// listOutputSchema describes the structuredContent returned by the core list
+// tools: an object with an "items" array. The item shape depends on the
+// configured list output format — full Kubernetes objects (apiVersion, kind,
+// metadata, ...) for yaml or json output, one flat map of column name to cell
+// value per row for table output — so items are left as open objects.
+func listOutputSchema(itemsDescription string) *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"items": {
+				Type:        "array",
+				Description: itemsDescription,
+				Items:       &jsonschema.Schema{Type: "object"},
+			},
+		},
+		Required: []string{"items"},
+	}
+}
+
+// objectOutputSchema describes the structuredContent returned by the core get
+// tools: the requested Kubernetes object itself.
+func objectOutputSchema(description string) *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "object",
+		Description: description,
+	}
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"bytes"
+	"compress/gzip"
 	"errors"
 	"fmt"
 
@@ -34,6 +35,7 @@ func initPods() []api.ServerTool {
 					},
 				},
 			},
+			OutputSchema: listOutputSchema("The Pods in all namespaces"),
 			Annotations: api.ToolAnnotations{
 				Title:           "Pods: List",
 				ReadOnlyHint:    ptr.To(true),
@@ -64,6 +66,7 @@ func initPods() []api.ServerTool {
 				},
 				Required: []string{"namespace"},
 			},
+			OutputSchema: listOutputSchema("The Pods in the specified namespace"),
 			Annotations: api.ToolAnnotations{
 				Title:           "Pods: List in Namespace",
 				ReadOnlyHint:    ptr.To(true),
@@ -88,6 +91,7 @@ func initPods() []api.ServerTool {
 				},
 				Required: []string{"name"},
 			},
+			OutputSchema: objectOutputSchema("The requested Pod (apiVersion, kind, metadata, spec, status)"),
 			Annotations: api.ToolAnnotations{
 				Title:           "Pods: Get",
 				ReadOnlyHint:    ptr.To(true),
@@ -109,6 +113,10 @@ func initPods() []api.ServerTool {
 						Type:        "string",
 						Description: "Name of the Pod to delete",
 					},
+					"backup": {
+						Type:        "boolean",
+						Description: "Include the full YAML of the Pod in the result before deleting it, so the delete can be undone with resources_create_or_update (Optional, defaults to the server's backup_before_delete setting)",
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -215,6 +223,10 @@ func initPods() []api.ServerTool {
 						Type:        "boolean",
 						Description: "Return previous terminated container logs (Optional)",
 					},
+					"asBlob": {
+						Type:        "boolean",
+						Description: "If true, return the logs losslessly as a gzip-compressed binary blob (embedded MCP resource) instead of inline text, suitable for handing off to other tooling (Optional)",
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -262,7 +274,8 @@ func initPods() []api.ServerTool {
 func podsListInAllNamespaces(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	p := api.WrapParams(params)
 	resourceListOptions := api.ListOptions{
-		AsTable: params.ListOutput.AsTable(),
+		AsTable:    params.ListOutput.AsTable(),
+		MaxObjects: params.GetListMaxObjects(),
 	}
 	resourceListOptions.LabelSelector = p.OptionalString("labelSelector", "")
 	resourceListOptions.FieldSelector = p.OptionalString("fieldSelector", "")
@@ -273,14 +286,19 @@ func podsListInAllNamespaces(params api.ToolHandlerParams) (*api.ToolCallResult,
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to list pods in all namespaces: %w", err)), nil
 	}
-	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
+	printed, err := params.ListOutput.PrintObjStructured(ret)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to format pods: %w", err)), nil
+	}
+	return api.NewToolCallResultFull(printed.Text, printed.Structured, nil), nil
 }
 
 func podsListInNamespace(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	p := api.WrapParams(params)
 	ns := p.RequiredString("namespace")
 	resourceListOptions := api.ListOptions{
-		AsTable: params.ListOutput.AsTable(),
+		AsTable:    params.ListOutput.AsTable(),
+		MaxObjects: params.GetListMaxObjects(),
 	}
 	resourceListOptions.LabelSelector = p.OptionalString("labelSelector", "")
 	resourceListOptions.FieldSelector = p.OptionalString("fieldSelector", "")
@@ -291,7 +309,11 @@ func podsListInNamespace(params api.ToolHandlerParams) (*api.ToolCallResult, err
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to list pods in namespace %s: %w", ns, err)), nil
 	}
-	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
+	printed, err := params.ListOutput.PrintObjStructured(ret)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to format pods: %w", err)), nil
+	}
+	return api.NewToolCallResultFull(printed.Text, printed.Structured, nil), nil
 }
 
 func podsGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
@@ -305,20 +327,40 @@ func podsGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to get pod %s in namespace %s: %w", name, ns, err)), nil
 	}
-	return api.NewToolCallResult(output.MarshalYaml(ret)), nil
+	printed, err := output.Yaml.PrintObjStructured(ret)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to format pod: %w", err)), nil
+	}
+	return api.NewToolCallResultFull(printed.Text, printed.Structured, nil), nil
 }
 
 func podsDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	p := api.WrapParams(params)
 	ns := p.OptionalString("namespace", "")
 	name := p.RequiredString("name")
+	backup := p.OptionalBool("backup", params.IsBackupBeforeDelete())
 	if err := p.Err(); err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to delete pod: %w", err)), nil
 	}
+	backupYaml := ""
+	if backup {
+		pod, getErr := kubernetes.NewCore(params).PodsGet(params, ns, name)
+		if getErr != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to back up pod %s in namespace %s before deleting it: %w", name, ns, getErr)), nil
+		}
+		marshalled, marshalErr := output.MarshalYaml(pod)
+		if marshalErr != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to back up pod %s in namespace %s before deleting it: %w", name, ns, marshalErr)), nil
+		}
+		backupYaml = marshalled
+	}
 	ret, err := kubernetes.NewCore(params).PodsDelete(params, ns, name)
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to delete pod %s in namespace %s: %w", name, ns, err)), nil
 	}
+	if backupYaml != "" {
+		ret += "\n# Backup of the deleted pod (restore with resources_create_or_update):\n" + backupYaml
+	}
 	return api.NewToolCallResult(ret, err), nil
 }
 
@@ -382,6 +424,11 @@ func podsExec(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(ret, nil), nil
 }
 
+// podsLogBlobMaxBytes caps how much raw log output the asBlob path accepts
+// before compression, so a single tool call cannot balloon the MCP response
+// with an arbitrarily large base64 payload.
+const podsLogBlobMaxBytes = 10 << 20 // 10 MiB
+
 func podsLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	p := api.WrapParams(params)
 	ns := p.OptionalString("namespace", "")
@@ -389,6 +436,7 @@ func podsLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	container := p.OptionalString("container", "")
 	previousBool := p.OptionalBool("previous", false)
 	tailInt := p.OptionalInt64("tail", 0)
+	asBlob := p.OptionalBool("asBlob", false)
 	if err := p.Err(); err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to get pod log: %w", err)), nil
 	}
@@ -398,9 +446,36 @@ func podsLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	} else if ret == "" {
 		ret = fmt.Sprintf("The pod %s in namespace %s has not logged any message yet", name, ns)
 	}
+	if asBlob {
+		return podsLogBlob(ns, name, ret)
+	}
 	return api.NewToolCallResult(ret, err), nil
 }
 
+// podsLogBlob packages the raw log output as a gzip-compressed embedded
+// resource so clients can hand the logs to other tooling without the text
+// round-trip mangling them.
+func podsLogBlob(ns, name, logs string) (*api.ToolCallResult, error) {
+	if len(logs) > podsLogBlobMaxBytes {
+		return api.NewToolCallResult("", fmt.Errorf(
+			"pod %s log in namespace %s is %d bytes, exceeding the %d byte blob limit: use the tail parameter to reduce the output",
+			name, ns, len(logs), podsLogBlobMaxBytes)), nil
+	}
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(logs))
+	if closeErr := gz.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to compress pod %s log in namespace %s: %w", name, ns, err)), nil
+	}
+	summary := fmt.Sprintf(
+		"Returning %d bytes of logs for pod %s in namespace %s as a gzip-compressed blob (%d bytes compressed, base64-encoded embedded resource)",
+		len(logs), name, ns, buf.Len())
+	return api.NewToolCallResultBlob(summary, buf.Bytes(), "application/gzip", nil), nil
+}
+
 func podsRun(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	p := api.WrapParams(params)
 	ns := p.OptionalString("namespace", "")
@@ -0,0 +1,321 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// registryCheckTimeout bounds the registry connectivity probe.
+const registryCheckTimeout = 10 * time.Second
+
+func initPullSecrets() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "secrets_create_docker_registry",
+			Description: "Create a kubernetes.io/dockerconfigjson image pull Secret from registry server, username, and password (equivalent to kubectl create secret docker-registry), " +
+				"optionally linking it to a ServiceAccount so its pods can pull from the registry",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the Secret to create",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to create the Secret in (Optional, current namespace if not provided)",
+					},
+					"server": {
+						Type:        "string",
+						Description: "Registry server (e.g. quay.io, ghcr.io, registry.example.com:5000)",
+					},
+					"username": {
+						Type:        "string",
+						Description: "Registry username",
+					},
+					"password": {
+						Type:        "string",
+						Description: "Registry password or access token",
+					},
+					"email": {
+						Type:        "string",
+						Description: "Email for the registry account (Optional)",
+					},
+					"serviceAccount": {
+						Type:        "string",
+						Description: "ServiceAccount to link the Secret to as an image pull secret after creation (Optional)",
+					},
+				},
+				Required: []string{"name", "server", "username", "password"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Secrets: Create Docker Registry",
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: secretsCreateDockerRegistry},
+		{Tool: api.Tool{
+			Name: "registry_check",
+			Description: "Probe a container registry's /v2/ endpoint, optionally authenticating with the credentials of an existing image pull Secret, to diagnose ImagePullBackOff caused by bad credentials or unreachable registries. " +
+				"The probe runs from the server's network vantage point, which may differ from the nodes'",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"server": {
+						Type:        "string",
+						Description: "Registry server to probe (e.g. quay.io)",
+					},
+					"secret": {
+						Type:        "string",
+						Description: "Name of an image pull Secret whose credentials for the server are used for the probe (Optional, anonymous probe if not provided)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Secret (Optional, current namespace if not provided)",
+					},
+				},
+				Required: []string{"server"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Registry: Check",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: registryCheck},
+	}
+}
+
+// dockerConfigJSON mirrors the .dockerconfigjson payload of a
+// kubernetes.io/dockerconfigjson Secret.
+type dockerConfigJSON struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+type dockerConfigAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+func secretsCreateDockerRegistry(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	server := p.RequiredString("server")
+	username := p.RequiredString("username")
+	password := p.RequiredString("password")
+	email := p.OptionalString("email", "")
+	serviceAccount := p.OptionalString("serviceAccount", "")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create docker registry secret: %w", err)), nil
+	}
+
+	dockerConfig := dockerConfigJSON{Auths: map[string]dockerConfigAuth{
+		server: {
+			Username: username,
+			Password: password,
+			Email:    email,
+			Auth:     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+		},
+	}}
+	payload, err := json.Marshal(dockerConfig)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to encode docker config: %w", err)), nil
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: payload},
+	}
+	if _, err = params.CoreV1().Secrets(namespace).Create(params.Context, secret, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create secret %s in namespace %s: %w", name, namespace, err)), nil
+	}
+	result := fmt.Sprintf("Image pull secret %s created in namespace %s for registry %s", name, namespace, server)
+
+	if serviceAccount != "" {
+		sa, saErr := params.CoreV1().ServiceAccounts(namespace).Get(params.Context, serviceAccount, metav1.GetOptions{})
+		if saErr != nil {
+			return api.NewToolCallResult("", fmt.Errorf("secret %s created, but failed to get service account %s/%s to link it: %w", name, namespace, serviceAccount, saErr)), nil
+		}
+		sa.ImagePullSecrets = append(sa.ImagePullSecrets, corev1.LocalObjectReference{Name: name})
+		if _, saErr = params.CoreV1().ServiceAccounts(namespace).Update(params.Context, sa, metav1.UpdateOptions{}); saErr != nil {
+			return api.NewToolCallResult("", fmt.Errorf("secret %s created, but failed to link it to service account %s/%s: %w", name, namespace, serviceAccount, saErr)), nil
+		}
+		result += fmt.Sprintf(" and linked to service account %s", serviceAccount)
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
+
+func registryCheck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	server := p.RequiredString("server")
+	secretName := p.OptionalString("secret", "")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check registry: %w", err)), nil
+	}
+
+	username, password := "", ""
+	if secretName != "" {
+		secret, err := params.CoreV1().Secrets(namespace).Get(params.Context, secretName, metav1.GetOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get secret %s/%s: %w", namespace, secretName, err)), nil
+		}
+		username, password = registryCredentials(secret, server)
+		if username == "" && password == "" {
+			return api.NewToolCallResult("", fmt.Errorf("secret %s/%s holds no credentials for registry %s", namespace, secretName, server)), nil
+		}
+	}
+
+	verdict, err := probeRegistry(params, server, username, password)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check registry %s: %w", server, err)), nil
+	}
+	return api.NewToolCallResult(verdict, nil), nil
+}
+
+// registryCredentials extracts the username and password for the given server
+// from a docker config Secret, matching with and without an https:// prefix.
+func registryCredentials(secret *corev1.Secret, server string) (username, password string) {
+	payload, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return "", ""
+	}
+	var dockerConfig dockerConfigJSON
+	if err := json.Unmarshal(payload, &dockerConfig); err != nil {
+		return "", ""
+	}
+	for registry, auth := range dockerConfig.Auths {
+		if strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://") != server {
+			continue
+		}
+		if auth.Username != "" || auth.Password != "" {
+			return auth.Username, auth.Password
+		}
+		if decoded, err := base64.StdEncoding.DecodeString(auth.Auth); err == nil {
+			if user, pass, found := strings.Cut(string(decoded), ":"); found {
+				return user, pass
+			}
+		}
+	}
+	return "", ""
+}
+
+// probeRegistry hits the registry's /v2/ endpoint and, on a bearer challenge,
+// follows it to the token endpoint so credential problems surface as a clear
+// verdict instead of a generic 401.
+func probeRegistry(params api.ToolHandlerParams, server, username, password string) (string, error) {
+	client := &http.Client{Timeout: registryCheckTimeout}
+	endpoint := "https://" + server + "/v2/"
+	request, err := http.NewRequestWithContext(params.Context, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	if username != "" || password != "" {
+		request.SetBasicAuth(username, password)
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("registry is unreachable: %w", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	switch {
+	case response.StatusCode == http.StatusOK:
+		if username != "" {
+			return fmt.Sprintf("Registry %s is reachable and accepted the credentials (HTTP 200 on /v2/)", server), nil
+		}
+		return fmt.Sprintf("Registry %s is reachable and allows anonymous access (HTTP 200 on /v2/)", server), nil
+	case response.StatusCode == http.StatusUnauthorized:
+		challenge := response.Header.Get("WWW-Authenticate")
+		if strings.HasPrefix(strings.ToLower(challenge), "bearer") {
+			return probeBearerToken(params, client, server, challenge, username, password)
+		}
+		if username == "" {
+			return fmt.Sprintf("Registry %s is reachable but requires authentication (HTTP 401 on /v2/); retry with an image pull secret", server), nil
+		}
+		return fmt.Sprintf("Registry %s rejected the credentials (HTTP 401 on /v2/); check the username and password in the pull secret", server), nil
+	case response.StatusCode == http.StatusForbidden:
+		return fmt.Sprintf("Registry %s accepted the connection but denied access (HTTP 403 on /v2/); the account may lack pull permission", server), nil
+	}
+	return fmt.Sprintf("Registry %s answered /v2/ with unexpected HTTP %d", server, response.StatusCode), nil
+}
+
+// probeBearerToken requests a token from the realm advertised in a bearer
+// challenge (the docker token auth flow) using the provided credentials.
+func probeBearerToken(params api.ToolHandlerParams, client *http.Client, server, challenge, username, password string) (string, error) {
+	realm, service := parseBearerChallenge(challenge)
+	if realm == "" {
+		return fmt.Sprintf("Registry %s requires bearer token authentication but its challenge advertises no realm; cannot verify the credentials", server), nil
+	}
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", fmt.Errorf("registry advertised an invalid token realm %q: %w", realm, err)
+	}
+	if service != "" {
+		query := tokenURL.Query()
+		query.Set("service", service)
+		tokenURL.RawQuery = query.Encode()
+	}
+	request, err := http.NewRequestWithContext(params.Context, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if username != "" || password != "" {
+		request.SetBasicAuth(username, password)
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("token endpoint %s is unreachable: %w", realm, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		if username != "" {
+			return fmt.Sprintf("Registry %s is reachable and its token endpoint accepted the credentials", server), nil
+		}
+		return fmt.Sprintf("Registry %s is reachable and issues anonymous tokens; authenticated pulls may still require an image pull secret", server), nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		if username == "" {
+			return fmt.Sprintf("Registry %s is reachable but requires authentication (token endpoint answered HTTP %d); retry with an image pull secret", server, response.StatusCode), nil
+		}
+		return fmt.Sprintf("Registry %s rejected the credentials (token endpoint answered HTTP %d); check the username and password in the pull secret", server, response.StatusCode), nil
+	}
+	return fmt.Sprintf("Registry %s token endpoint answered unexpected HTTP %d", server, response.StatusCode), nil
+}
+
+// parseBearerChallenge extracts the realm and service parameters from a
+// WWW-Authenticate bearer challenge.
+func parseBearerChallenge(challenge string) (realm, service string) {
+	challenge = strings.TrimSpace(challenge[len("bearer"):])
+	for _, part := range strings.Split(challenge, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			continue
+		}
+		value = strings.Trim(value, `"`)
+		switch strings.ToLower(key) {
+		case "realm":
+			realm = value
+		case "service":
+			service = value
+		}
+	}
+	return realm, service
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 
@@ -64,6 +67,7 @@ func initResources(p api.FilteringProvider) []api.ServerTool {
 				},
 				Required: []string{"apiVersion", "kind"},
 			},
+			OutputSchema: listOutputSchema("The matching Kubernetes resources"),
 			Annotations: api.ToolAnnotations{
 				Title:           "Resources: List",
 				ReadOnlyHint:    ptr.To(true),
@@ -96,6 +100,7 @@ func initResources(p api.FilteringProvider) []api.ServerTool {
 				},
 				Required: []string{"apiVersion", "kind", "name"},
 			},
+			OutputSchema: objectOutputSchema("The requested Kubernetes resource (apiVersion, kind, metadata, spec, status, ...)"),
 			Annotations: api.ToolAnnotations{
 				Title:           "Resources: Get",
 				ReadOnlyHint:    ptr.To(true),
@@ -124,8 +129,9 @@ func initResources(p api.FilteringProvider) []api.ServerTool {
 			},
 		}, Handler: resourcesCreateOrUpdate},
 		{Tool: api.Tool{
-			Name:        "resources_delete",
-			Description: "Delete a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name\n" + commonApiVersion,
+			Name: "resources_delete",
+			Description: "Delete a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name. " +
+				"For bulk deletion, provide a labelSelector or fieldSelector instead of a name: matching objects are previewed, capped by maxObjects, and deleted only after the user confirms\n" + commonApiVersion,
 			InputSchema: &jsonschema.Schema{
 				Type: "object",
 				Properties: map[string]*jsonschema.Schema{
@@ -143,14 +149,33 @@ func initResources(p api.FilteringProvider) []api.ServerTool {
 					},
 					"name": {
 						Type:        "string",
-						Description: "Name of the resource",
+						Description: "Name of the resource (mutually exclusive with labelSelector and fieldSelector; one of them is required)",
+					},
+					"labelSelector": {
+						Type:        "string",
+						Description: "Kubernetes label selector (e.g. 'app=myapp,env=prod') selecting the resources to bulk delete (Optional, mutually exclusive with name)",
+						Pattern:     REGEX_LABELSELECTOR_VALID_CHARS,
+					},
+					"fieldSelector": {
+						Type:        "string",
+						Description: "Kubernetes field selector (e.g. 'status.phase=Failed') selecting the resources to bulk delete (Optional, mutually exclusive with name)",
+						Pattern:     REGEX_FIELDSELECTOR,
+					},
+					"maxObjects": {
+						Type:        "integer",
+						Description: "Safety limit for bulk deletion: the call is refused when the selector matches more objects (Optional, default: 20)",
+						Minimum:     ptr.To(float64(1)),
 					},
 					"gracePeriodSeconds": {
 						Type:        "integer",
 						Description: "Optional duration in seconds before the object should be deleted. Value must be non-negative integer. The value zero indicates delete immediately. If this value is nil, the default grace period for the specified type will be used",
 					},
+					"backup": {
+						Type:        "boolean",
+						Description: "Include the full YAML of the resource in the result before deleting it, so the delete can be undone with resources_create_or_update (Optional, defaults to the server's backup_before_delete setting)",
+					},
 				},
-				Required: []string{"apiVersion", "kind", "name"},
+				Required: []string{"apiVersion", "kind"},
 			},
 			Annotations: api.ToolAnnotations{
 				Title:           "Resources: Delete",
@@ -205,7 +230,8 @@ func resourcesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	}
 	labelSelector := params.GetArguments()["labelSelector"]
 	resourceListOptions := api.ListOptions{
-		AsTable: params.ListOutput.AsTable(),
+		AsTable:    params.ListOutput.AsTable(),
+		MaxObjects: params.GetListMaxObjects(),
 	}
 
 	if labelSelector != nil {
@@ -310,19 +336,20 @@ func resourcesDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error)
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to delete resource, %s", err)), nil
 	}
-	name := params.GetArguments()["name"]
-	if name == nil {
-		return api.NewToolCallResult("", errors.New("failed to delete resource, missing argument name")), nil
-	}
 
 	ns, ok := namespace.(string)
 	if !ok {
 		return api.NewToolCallResult("", fmt.Errorf("namespace is not a string")), nil
 	}
 
-	n, ok := name.(string)
-	if !ok {
-		return api.NewToolCallResult("", fmt.Errorf("name is not a string")), nil
+	p := api.WrapParams(params)
+	n := p.OptionalString("name", "")
+	labelSelector := p.OptionalString("labelSelector", "")
+	fieldSelector := p.OptionalString("fieldSelector", "")
+	maxObjects := p.OptionalInt64("maxObjects", bulkDeleteDefaultMaxObjects)
+	backup := p.OptionalBool("backup", params.IsBackupBeforeDelete())
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to delete resource: %w", err)), nil
 	}
 
 	var gracePeriodSecondsPtr *int64
@@ -334,13 +361,123 @@ func resourcesDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error)
 		gracePeriodSecondsPtr = &gracePeriodSeconds
 	}
 
+	if n == "" && labelSelector == "" && fieldSelector == "" {
+		return api.NewToolCallResult("", errors.New("failed to delete resource, missing argument name (or a labelSelector/fieldSelector for bulk deletion)")), nil
+	}
+	if n != "" && (labelSelector != "" || fieldSelector != "") {
+		return api.NewToolCallResult("", errors.New("failed to delete resource, name and labelSelector/fieldSelector are mutually exclusive")), nil
+	}
+	if n == "" {
+		return resourcesBulkDelete(params, gvk, ns, labelSelector, fieldSelector, maxObjects, gracePeriodSecondsPtr, backup)
+	}
+	backupYaml := ""
+	if backup {
+		resource, getErr := kubernetes.NewCore(params).ResourcesGet(params, gvk, ns, n)
+		if getErr != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to back up resource before deleting it: %w", getErr)), nil
+		}
+		marshalled, marshalErr := output.MarshalYaml(resource)
+		if marshalErr != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to back up resource before deleting it: %w", marshalErr)), nil
+		}
+		backupYaml = marshalled
+	}
+
 	err = kubernetes.NewCore(params).ResourcesDelete(params, gvk, ns, n, gracePeriodSecondsPtr)
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to delete resource: %w", err)), nil
 	}
+	if backupYaml != "" {
+		return api.NewToolCallResult("Resource deleted successfully\n# Backup of the deleted resource (restore with resources_create_or_update):\n"+backupYaml, nil), nil
+	}
 	return api.NewToolCallResult("Resource deleted successfully", err), nil
 }
 
+// bulkDeleteDefaultMaxObjects is the default cap on how many objects a
+// selector-based resources_delete call may remove.
+const bulkDeleteDefaultMaxObjects = 20
+
+// resourcesBulkDelete deletes every resource matching the selectors after
+// previewing the matches to the user and obtaining an explicit confirmation
+// via elicitation. Clients without elicitation support cannot confirm, so the
+// call is refused for them.
+func resourcesBulkDelete(params api.ToolHandlerParams, gvk *schema.GroupVersionKind, namespace, labelSelector, fieldSelector string, maxObjects int64, gracePeriodSeconds *int64, backup bool) (*api.ToolCallResult, error) {
+	listed, err := kubernetes.NewCore(params).ResourcesList(params, gvk, namespace, api.ListOptions{
+		ListOptions: metav1.ListOptions{LabelSelector: labelSelector, FieldSelector: fieldSelector},
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list resources to delete: %w", err)), nil
+	}
+	list, ok := listed.(*unstructured.UnstructuredList)
+	if !ok {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list resources to delete: unexpected list type %T", listed)), nil
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult("No resources match the provided selectors, nothing deleted", nil), nil
+	}
+	if int64(len(list.Items)) > maxObjects {
+		return api.NewToolCallResult("", fmt.Errorf(
+			"refusing bulk delete: the selectors match %d objects, above the maxObjects limit of %d; narrow the selectors or raise maxObjects explicitly", len(list.Items), maxObjects)), nil
+	}
+
+	preview := make([]string, 0, len(list.Items))
+	for i := range list.Items {
+		item := &list.Items[i]
+		if item.GetNamespace() != "" {
+			preview = append(preview, fmt.Sprintf("- %s/%s", item.GetNamespace(), item.GetName()))
+		} else {
+			preview = append(preview, "- "+item.GetName())
+		}
+	}
+
+	if params.Elicitor == nil {
+		return api.NewToolCallResult("", fmt.Errorf("bulk delete requires user confirmation: %w", api.ErrElicitationNotSupported)), nil
+	}
+	elicitResult, err := params.Elicitor.Elicit(params.Context, &api.ElicitParams{
+		Message: fmt.Sprintf("About to delete %d %s object(s):\n%s\nDo you want to proceed?", len(list.Items), gvk.Kind, strings.Join(preview, "\n")),
+	})
+	if err != nil {
+		if errors.Is(err, api.ErrElicitationNotSupported) {
+			return api.NewToolCallResult("", fmt.Errorf("bulk delete requires user confirmation: %w", err)), nil
+		}
+		return api.NewToolCallResult("", fmt.Errorf("failed to confirm bulk delete: %w", err)), nil
+	}
+	if elicitResult.Action != api.ElicitActionAccept {
+		return api.NewToolCallResult("Bulk delete not confirmed by the user, nothing deleted", nil), nil
+	}
+
+	backupYaml := ""
+	if backup {
+		marshalled, marshalErr := output.MarshalYaml(list)
+		if marshalErr != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to back up resources before deleting them: %w", marshalErr)), nil
+		}
+		backupYaml = marshalled
+	}
+
+	var deleted, failed []string
+	for i := range list.Items {
+		item := &list.Items[i]
+		if deleteErr := kubernetes.NewCore(params).ResourcesDelete(params, gvk, item.GetNamespace(), item.GetName(), gracePeriodSeconds); deleteErr != nil {
+			failed = append(failed, fmt.Sprintf("- %s: %v", item.GetName(), deleteErr))
+			continue
+		}
+		deleted = append(deleted, preview[i])
+	}
+
+	result := fmt.Sprintf("Deleted %d of %d matching %s object(s)", len(deleted), len(list.Items), gvk.Kind)
+	if len(deleted) > 0 {
+		result += ":\n" + strings.Join(deleted, "\n")
+	}
+	if len(failed) > 0 {
+		result += fmt.Sprintf("\nFailed to delete %d object(s):\n%s", len(failed), strings.Join(failed, "\n"))
+	}
+	if backupYaml != "" {
+		result += "\n# Backup of the deleted resources (restore with resources_create_or_update):\n" + backupYaml
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
+
 func resourcesScale(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	namespace := params.GetArguments()["namespace"]
 	if namespace == nil {
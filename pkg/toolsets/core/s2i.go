@@ -0,0 +1,174 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initS2I() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "s2i_builders_list",
+			Description: "List the Source-to-Image (S2I) builder ImageStreams available in the openshift namespace with their supported languages and versions. " +
+				"Optionally suggests the right builder for a Git repository by inspecting common manifest files (package.json, pom.xml, go.mod, ...), feeding the app_deploy workflow",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"gitUrl": {
+						Type:        "string",
+						Description: "Git repository URL to suggest a builder for (Optional; repository root files are inspected over HTTPS, supported for github.com and GitLab hosts)",
+					},
+					"gitRef": {
+						Type:        "string",
+						Description: "Git branch, tag, or commit to inspect (Optional, defaults to the repository default branch)",
+					},
+					"files": {
+						Type:        "array",
+						Description: "File names at the repository root to base the builder suggestion on, for repositories that cannot be inspected remotely (Optional)",
+						Items:       &jsonschema.Schema{Type: "string"},
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "S2I: Builders List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: s2iBuildersList},
+	}
+}
+
+func s2iBuildersList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	gitURL := p.OptionalString("gitUrl", "")
+	gitRef := p.OptionalString("gitRef", "")
+	files, err := labelStringSliceArgument(params, "files")
+	if err == nil {
+		err = p.Err()
+	}
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list S2I builders: %w", err)), nil
+	}
+	builders, err := kubernetes.NewCore(params).S2IBuilders(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list S2I builders: %w", err)), nil
+	}
+	var sb strings.Builder
+	if len(builders) == 0 {
+		sb.WriteString("No S2I builder ImageStreams found in the openshift namespace\n")
+	} else {
+		sb.WriteString("S2I builder ImageStreams in the openshift namespace:\n")
+		for _, builder := range builders {
+			var tags []string
+			var supports []string
+			for _, tag := range builder.Tags {
+				tags = append(tags, tag.Name)
+				if tag.Supports != "" {
+					supports = append(supports, tag.Supports)
+				}
+			}
+			sb.WriteString(fmt.Sprintf("  - %s (tags: %s", builder.Name, strings.Join(tags, ", ")))
+			if len(supports) > 0 {
+				slices.Sort(supports)
+				sb.WriteString("; supports: " + strings.Join(slices.Compact(supports), ", "))
+			}
+			sb.WriteString(")\n")
+		}
+	}
+	if gitURL == "" && len(files) == 0 {
+		return api.NewToolCallResult(sb.String(), nil), nil
+	}
+	source := "the provided files"
+	if len(files) == 0 {
+		source = gitURL
+		files, err = probeRepositoryManifests(params, gitURL, gitRef)
+		if err != nil {
+			sb.WriteString("\n" + err.Error() + "\n")
+			return api.NewToolCallResult(sb.String(), nil), nil
+		}
+	}
+	language, manifest := kubernetes.SuggestS2IBuilder(files)
+	if language == "" {
+		sb.WriteString(fmt.Sprintf("\nNo known manifest file found in %s; pick a builder manually\n", source))
+		return api.NewToolCallResult(sb.String(), nil), nil
+	}
+	suggestion := suggestedBuilderTag(builders, language)
+	if suggestion == "" {
+		sb.WriteString(fmt.Sprintf("\nDetected %s (%s found in %s), but no matching builder ImageStream is available in this cluster\n", language, manifest, source))
+		return api.NewToolCallResult(sb.String(), nil), nil
+	}
+	sb.WriteString(fmt.Sprintf("\nSuggested builder for %s: %s (%s found)\nDeploy it with app_deploy (gitUrl=%s, builder=%s)\n",
+		source, suggestion, manifest, gitURL, suggestion))
+	return api.NewToolCallResult(sb.String(), nil), nil
+}
+
+// suggestedBuilderTag matches a detected language against the available
+// builders and returns the ImageStreamTag to pass to app_deploy, preferring
+// the latest tag.
+func suggestedBuilderTag(builders []kubernetes.S2IBuilder, language string) string {
+	for _, builder := range builders {
+		if builder.Name != language && !strings.HasPrefix(builder.Name, language+"-") {
+			continue
+		}
+		for _, tag := range builder.Tags {
+			if tag.Name == "latest" {
+				return builder.Name + ":latest"
+			}
+		}
+		return builder.Name + ":" + builder.Tags[len(builder.Tags)-1].Name
+	}
+	return ""
+}
+
+// probeRepositoryManifests checks which of the known manifest files exist at
+// the root of the repository by requesting them from the forge's raw file
+// endpoint. Only github.com and GitLab hosts expose a predictable raw URL
+// scheme; for other hosts the caller must pass the file names explicitly.
+func probeRepositoryManifests(params api.ToolHandlerParams, gitURL, gitRef string) ([]string, error) {
+	parsed, err := url.Parse(gitURL)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("cannot inspect repository: %q is not a valid URL", gitURL)
+	}
+	repoPath := strings.TrimSuffix(strings.Trim(parsed.Path, "/"), ".git")
+	if gitRef == "" {
+		gitRef = "HEAD"
+	}
+	var rawBase string
+	switch {
+	case parsed.Host == "github.com":
+		rawBase = "https://raw.githubusercontent.com/" + repoPath + "/" + gitRef + "/"
+	case strings.Contains(parsed.Host, "gitlab"):
+		rawBase = "https://" + parsed.Host + "/" + repoPath + "/-/raw/" + gitRef + "/"
+	default:
+		return nil, fmt.Errorf("cannot inspect repositories on %s; pass the repository root file names via the files argument instead", parsed.Host)
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	var found []string
+	for _, file := range kubernetes.S2IManifestCandidates() {
+		req, err := http.NewRequestWithContext(params.Context, http.MethodGet, rawBase+file, nil)
+		if err != nil {
+			continue
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("cannot inspect repository %s: %w", gitURL, err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			found = append(found, file)
+		}
+	}
+	return found, nil
+}
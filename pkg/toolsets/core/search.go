@@ -0,0 +1,176 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+// defaultSearchKinds are the kinds resources_search scans when the caller does
+// not narrow the search to a single apiVersion/kind.
+var defaultSearchKinds = []schema.GroupVersionKind{
+	{Version: "v1", Kind: "Pod"},
+	{Version: "v1", Kind: "Service"},
+	{Version: "v1", Kind: "ConfigMap"},
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	{Group: "batch", Version: "v1", Kind: "Job"},
+	{Group: "batch", Version: "v1", Kind: "CronJob"},
+	{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+}
+
+// maxSearchResults caps the number of matches rendered so a broad query does
+// not flood the model's context.
+const maxSearchResults = 100
+
+func initSearch() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "resources_search",
+			Description: "Search Kubernetes objects by a substring or regular expression matched against their name, labels, and annotations. " +
+				"Useful when only a fragment of a resource name is known. Searches Pods, Services, ConfigMaps, Deployments, StatefulSets, DaemonSets, Jobs, CronJobs, and Ingresses by default; " +
+				"narrow with apiVersion and kind to search any other resource type",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"query": {
+						Type:        "string",
+						Description: "Substring or RE2 regular expression to search for (matched case-insensitively against object names, label keys and values, and annotation keys and values)",
+					},
+					"apiVersion": {
+						Type:        "string",
+						Description: "Optional apiVersion to restrict the search to a single resource type (requires kind)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "Optional kind to restrict the search to a single resource type (requires apiVersion)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to search in. If not provided, searches all namespaces",
+					},
+				},
+				Required: []string{"query"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Search",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesSearch},
+	}
+}
+
+func resourcesSearch(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	query := p.RequiredString("query")
+	apiVersion := p.OptionalString("apiVersion", "")
+	kind := p.OptionalString("kind", "")
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to search resources: %w", err)), nil
+	}
+	if (apiVersion == "") != (kind == "") {
+		return api.NewToolCallResult("", fmt.Errorf("failed to search resources: apiVersion and kind must be provided together")), nil
+	}
+
+	matcher := newSearchMatcher(query)
+
+	kinds := defaultSearchKinds
+	if apiVersion != "" {
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to search resources: invalid argument apiVersion")), nil
+		}
+		kinds = []schema.GroupVersionKind{{Group: gv.Group, Version: gv.Version, Kind: kind}}
+	}
+
+	var matches []string
+	var failures []string
+	for i := range kinds {
+		gvk := kinds[i]
+		list, err := kubernetes.NewCore(params).ResourcesList(params, &gvk, namespace, api.ListOptions{})
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("- %s %s: %v", gvk.GroupVersion().String(), gvk.Kind, err))
+			continue
+		}
+		items, ok := list.(*unstructured.UnstructuredList)
+		if !ok {
+			continue
+		}
+		for j := range items.Items {
+			item := &items.Items[j]
+			if reason := matchResource(item, matcher); reason != "" {
+				name := item.GetName()
+				if item.GetNamespace() != "" {
+					name = item.GetNamespace() + "/" + name
+				}
+				matches = append(matches, fmt.Sprintf("- %s %s (matched %s)", gvk.Kind, name, reason))
+			}
+		}
+	}
+	sort.Strings(matches)
+
+	var sections []string
+	if len(matches) == 0 {
+		sections = append(sections, fmt.Sprintf("No resources matching '%s' found", query))
+	} else {
+		total := len(matches)
+		truncated := ""
+		if total > maxSearchResults {
+			truncated = fmt.Sprintf(" (showing first %d)", maxSearchResults)
+			matches = matches[:maxSearchResults]
+		}
+		sections = append(sections, fmt.Sprintf("%d resource(s) matching '%s'%s:\n%s", total, query, truncated, strings.Join(matches, "\n")))
+	}
+	if len(failures) > 0 {
+		sections = append(sections, "Some resource types could not be searched:\n"+strings.Join(failures, "\n"))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// searchMatcher matches a query against a candidate string. The query is
+// treated as a case-insensitive RE2 regular expression; if it does not
+// compile, it degrades to a case-insensitive substring match.
+type searchMatcher func(candidate string) bool
+
+func newSearchMatcher(query string) searchMatcher {
+	if re, err := regexp.Compile("(?i)" + query); err == nil {
+		return re.MatchString
+	}
+	lowered := strings.ToLower(query)
+	return func(candidate string) bool {
+		return strings.Contains(strings.ToLower(candidate), lowered)
+	}
+}
+
+// matchResource reports what part of the object matched the query: its name,
+// a label, or an annotation. Empty string means no match.
+func matchResource(obj *unstructured.Unstructured, matches searchMatcher) string {
+	if matches(obj.GetName()) {
+		return "name"
+	}
+	for key, value := range obj.GetLabels() {
+		if matches(key) || matches(value) {
+			return fmt.Sprintf("label %s=%s", key, value)
+		}
+	}
+	for key, value := range obj.GetAnnotations() {
+		if matches(key) || matches(value) {
+			return fmt.Sprintf("annotation %s", key)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,297 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// serviceAccountTokenDefaultExpirationSeconds is the default validity of a
+// requested ServiceAccount token (1 hour).
+const serviceAccountTokenDefaultExpirationSeconds = 3600
+
+func initServiceAccounts() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "serviceaccounts_list",
+			Description: "List Kubernetes ServiceAccounts in the current or provided namespace with their image pull secrets and mounted secrets",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list ServiceAccounts from (Optional, current namespace if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ServiceAccounts: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: serviceAccountsList},
+		{Tool: api.Tool{
+			Name:        "serviceaccounts_create",
+			Description: "Create a Kubernetes ServiceAccount in the current or provided namespace, the starting point for workload identity setup",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the ServiceAccount to create",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to create the ServiceAccount in (Optional, current namespace if not provided)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ServiceAccounts: Create",
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: serviceAccountsCreate},
+		{Tool: api.Tool{
+			Name: "serviceaccounts_token_create",
+			Description: "Request a short-lived, bounded token for a ServiceAccount via the TokenRequest API (equivalent to kubectl create token). " +
+				"The token is returned to the caller: treat it as a credential and prefer short expirations",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the ServiceAccount to request a token for",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the ServiceAccount (Optional, current namespace if not provided)",
+					},
+					"audiences": {
+						Type:        "array",
+						Description: "Intended audiences of the token (Optional, defaults to the API server's audience)",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"expirationSeconds": {
+						Type:        "integer",
+						Description: "Requested token validity in seconds (Optional, default: 3600; the API server may adjust it)",
+						Minimum:     ptr.To(float64(600)),
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ServiceAccounts: Token Create",
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: serviceAccountsTokenCreate},
+		{Tool: api.Tool{
+			Name: "serviceaccounts_link_pull_secret",
+			Description: "Link an existing image pull Secret (kubernetes.io/dockerconfigjson) to a ServiceAccount so pods using the ServiceAccount can pull from the private registry, " +
+				"or unlink it when unlink is true",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the ServiceAccount",
+					},
+					"secret": {
+						Type:        "string",
+						Description: "Name of the image pull Secret to link",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the ServiceAccount and Secret (Optional, current namespace if not provided)",
+					},
+					"unlink": {
+						Type:        "boolean",
+						Description: "Remove the Secret from the ServiceAccount's image pull secrets instead of adding it (Optional, defaults to false)",
+					},
+				},
+				Required: []string{"name", "secret"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "ServiceAccounts: Link Pull Secret",
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: serviceAccountsLinkPullSecret},
+	}
+}
+
+func serviceAccountsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list service accounts: %w", err)), nil
+	}
+	serviceAccounts, err := params.CoreV1().ServiceAccounts(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list service accounts in namespace %s: %w", namespace, err)), nil
+	}
+	if len(serviceAccounts.Items) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No service accounts found in namespace %s", namespace), nil), nil
+	}
+	var lines []string
+	for i := range serviceAccounts.Items {
+		sa := &serviceAccounts.Items[i]
+		details := []string{}
+		if len(sa.ImagePullSecrets) > 0 {
+			names := make([]string, 0, len(sa.ImagePullSecrets))
+			for _, ref := range sa.ImagePullSecrets {
+				names = append(names, ref.Name)
+			}
+			details = append(details, "image pull secrets: "+strings.Join(names, ", "))
+		}
+		if len(sa.Secrets) > 0 {
+			names := make([]string, 0, len(sa.Secrets))
+			for _, ref := range sa.Secrets {
+				names = append(names, ref.Name)
+			}
+			details = append(details, "secrets: "+strings.Join(names, ", "))
+		}
+		line := "- " + sa.Name
+		if len(details) > 0 {
+			line += " (" + strings.Join(details, "; ") + ")"
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Service accounts in namespace %s (%d):\n%s", namespace, len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+func serviceAccountsCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create service account: %w", err)), nil
+	}
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	if _, err := params.CoreV1().ServiceAccounts(namespace).Create(params.Context, sa, metav1.CreateOptions{}); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return api.NewToolCallResult(fmt.Sprintf("Service account %s already exists in namespace %s", name, namespace), nil), nil
+		}
+		return api.NewToolCallResult("", fmt.Errorf("failed to create service account %s in namespace %s: %w", name, namespace, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Service account %s created in namespace %s", name, namespace), nil), nil
+}
+
+func serviceAccountsTokenCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	expirationSeconds := p.OptionalInt64("expirationSeconds", serviceAccountTokenDefaultExpirationSeconds)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create service account token: %w", err)), nil
+	}
+	audiences, err := optionalAudiences(params.GetArguments()["audiences"])
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create service account token: %w", err)), nil
+	}
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         audiences,
+			ExpirationSeconds: ptr.To(expirationSeconds),
+		},
+	}
+	token, err := params.CoreV1().ServiceAccounts(namespace).CreateToken(params.Context, name, tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create token for service account %s/%s: %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Token for service account %s/%s (expires %s):\n%s",
+		namespace, name, token.Status.ExpirationTimestamp.Format(time.RFC3339), token.Status.Token), nil), nil
+}
+
+// optionalAudiences parses the optional audiences array argument, returning
+// nil (API server default) when absent.
+func optionalAudiences(arg any) ([]string, error) {
+	if arg == nil {
+		return nil, nil
+	}
+	raw, ok := arg.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("audiences parameter must be an array of strings")
+	}
+	audiences := make([]string, 0, len(raw))
+	for _, item := range raw {
+		str, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("audiences items must be strings")
+		}
+		audiences = append(audiences, str)
+	}
+	return audiences, nil
+}
+
+func serviceAccountsLinkPullSecret(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	secret := p.RequiredString("secret")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	unlink := p.OptionalBool("unlink", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to link pull secret: %w", err)), nil
+	}
+	sa, err := params.CoreV1().ServiceAccounts(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get service account %s/%s: %w", namespace, name, err)), nil
+	}
+	linkedAt := -1
+	for i := range sa.ImagePullSecrets {
+		if sa.ImagePullSecrets[i].Name == secret {
+			linkedAt = i
+			break
+		}
+	}
+	if unlink {
+		if linkedAt < 0 {
+			return api.NewToolCallResult("", fmt.Errorf("secret %s is not linked to service account %s/%s", secret, namespace, name)), nil
+		}
+		sa.ImagePullSecrets = append(sa.ImagePullSecrets[:linkedAt], sa.ImagePullSecrets[linkedAt+1:]...)
+		if _, err = params.CoreV1().ServiceAccounts(namespace).Update(params.Context, sa, metav1.UpdateOptions{}); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to update service account %s/%s: %w", namespace, name, err)), nil
+		}
+		return api.NewToolCallResult(fmt.Sprintf("Secret %s unlinked from service account %s/%s", secret, namespace, name), nil), nil
+	}
+	if linkedAt >= 0 {
+		return api.NewToolCallResult(fmt.Sprintf("Secret %s is already linked to service account %s/%s", secret, namespace, name), nil), nil
+	}
+	// Validate the referenced secret exists and is a pull secret before
+	// linking: a dangling or mistyped reference would fail silently at image
+	// pull time.
+	pullSecret, err := params.CoreV1().Secrets(namespace).Get(params.Context, secret, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get secret %s/%s: %w", namespace, secret, err)), nil
+	}
+	if pullSecret.Type != corev1.SecretTypeDockerConfigJson && pullSecret.Type != corev1.SecretTypeDockercfg {
+		return api.NewToolCallResult("", fmt.Errorf(
+			"secret %s/%s has type %s, expected %s or %s", namespace, secret, pullSecret.Type, corev1.SecretTypeDockerConfigJson, corev1.SecretTypeDockercfg)), nil
+	}
+	sa.ImagePullSecrets = append(sa.ImagePullSecrets, corev1.LocalObjectReference{Name: secret})
+	if _, err = params.CoreV1().ServiceAccounts(namespace).Update(params.Context, sa, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update service account %s/%s: %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Secret %s linked to service account %s/%s as an image pull secret", secret, namespace, name), nil), nil
+}
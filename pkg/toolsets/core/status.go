@@ -0,0 +1,78 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initStatus() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "resources_status",
+			Description: "Summarize the health of any Kubernetes object (including custom resources) by interpreting its status block: Ready/Available/Degraded-style conditions " +
+				"and observedGeneration lag are turned into a short human-readable verdict",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the object (examples of valid apiVersion are: v1, apps/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the object (examples of valid kind are: Deployment, or any custom resource kind)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the object",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace of the object. If not provided, will use the configured namespace",
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Status",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesStatus},
+	}
+}
+
+func resourcesStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	apiVersion := p.RequiredString("apiVersion")
+	kind := p.RequiredString("kind")
+	name := p.RequiredString("name")
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to summarize resource status: %w", err)), nil
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to summarize resource status: invalid argument apiVersion")), nil
+	}
+	gvk := schema.GroupVersionKind{Group: gv.Group, Version: gv.Version, Kind: kind}
+
+	obj, err := kubernetes.NewCore(params).ResourcesGet(params, &gvk, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to summarize resource status: %w", err)), nil
+	}
+	summary := kubernetes.SummarizeStatus(obj)
+	header := fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+	if obj.GetNamespace() != "" {
+		header += " in namespace '" + obj.GetNamespace() + "'"
+	}
+	return api.NewToolCallResult(header+": "+summary.String(), nil), nil
+}
@@ -0,0 +1,291 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func initTaints() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "nodes_taint_add",
+			Description: "Add a taint to a Kubernetes Node. " +
+				"An existing taint with the same key and effect is protected: replacing its value fails unless overwrite is true",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the Node to taint",
+					},
+					"key": {
+						Type:        "string",
+						Description: "Taint key (e.g. dedicated)",
+					},
+					"value": {
+						Type:        "string",
+						Description: "Taint value (Optional)",
+					},
+					"effect": {
+						Type:        "string",
+						Description: "Taint effect. NoExecute evicts running pods that do not tolerate the taint",
+						Enum:        []any{"NoSchedule", "PreferNoSchedule", "NoExecute"},
+					},
+					"overwrite": {
+						Type:        "boolean",
+						Description: "Allow replacing the value of a taint that already exists with the same key and effect (Optional, defaults to false)",
+					},
+				},
+				Required: []string{"name", "key", "effect"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Nodes: Taint Add",
+				DestructiveHint: ptr.To(true), // NoExecute evicts pods that do not tolerate the taint
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nodesTaintAdd},
+		{Tool: api.Tool{
+			Name:        "nodes_taint_remove",
+			Description: "Remove a taint from a Kubernetes Node by key, optionally narrowed to a single effect",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the Node to remove the taint from",
+					},
+					"key": {
+						Type:        "string",
+						Description: "Key of the taint to remove",
+					},
+					"effect": {
+						Type:        "string",
+						Description: "Only remove the taint with this effect (Optional, all effects for the key if not provided)",
+						Enum:        []any{"NoSchedule", "PreferNoSchedule", "NoExecute"},
+					},
+				},
+				Required: []string{"name", "key"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Nodes: Taint Remove",
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nodesTaintRemove},
+		{Tool: api.Tool{
+			Name:        "nodes_taint_blocked_pods",
+			Description: "Report Pending pods that cannot be scheduled because of node taints, listing for each pod the untolerated taints keeping it off every node",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to inspect (Optional, all namespaces if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Nodes: Taint Blocked Pods",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nodesTaintBlockedPods},
+	}
+}
+
+func nodesTaintAdd(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	key := p.RequiredString("key")
+	value := p.OptionalString("value", "")
+	effect := p.RequiredString("effect")
+	overwrite := p.OptionalBool("overwrite", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to add taint: %w", err)), nil
+	}
+	node, err := params.CoreV1().Nodes().Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get node %s: %w", name, err)), nil
+	}
+	taint := corev1.Taint{Key: key, Value: value, Effect: corev1.TaintEffect(effect)}
+	replaced := false
+	for i := range node.Spec.Taints {
+		existing := &node.Spec.Taints[i]
+		if existing.Key != key || existing.Effect != taint.Effect {
+			continue
+		}
+		if existing.Value == value {
+			return api.NewToolCallResult(fmt.Sprintf("Node %s already has taint %s", name, formatTaint(&taint)), nil), nil
+		}
+		if !overwrite {
+			return api.NewToolCallResult("", fmt.Errorf(
+				"node %s already has a taint with key %s and effect %s (value %q): pass overwrite to replace it", name, key, effect, existing.Value)), nil
+		}
+		existing.Value = value
+		replaced = true
+		break
+	}
+	if !replaced {
+		node.Spec.Taints = append(node.Spec.Taints, taint)
+	}
+	if _, err = params.CoreV1().Nodes().Update(params.Context, node, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update node %s: %w", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Taint %s added to node %s", formatTaint(&taint), name), nil), nil
+}
+
+func nodesTaintRemove(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	key := p.RequiredString("key")
+	effect := p.OptionalString("effect", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to remove taint: %w", err)), nil
+	}
+	node, err := params.CoreV1().Nodes().Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get node %s: %w", name, err)), nil
+	}
+	var kept []corev1.Taint
+	var removed []string
+	for i := range node.Spec.Taints {
+		taint := node.Spec.Taints[i]
+		if taint.Key == key && (effect == "" || string(taint.Effect) == effect) {
+			removed = append(removed, formatTaint(&taint))
+			continue
+		}
+		kept = append(kept, taint)
+	}
+	if len(removed) == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("node %s has no taint with key %s", name, key)), nil
+	}
+	node.Spec.Taints = kept
+	if _, err = params.CoreV1().Nodes().Update(params.Context, node, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update node %s: %w", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Removed taint(s) %s from node %s", strings.Join(removed, ", "), name), nil), nil
+}
+
+func nodesTaintBlockedPods(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to report taint-blocked pods: %w", err)), nil
+	}
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{FieldSelector: "status.phase=Pending"})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pending pods: %w", err)), nil
+	}
+	nodes, err := params.CoreV1().Nodes().List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list nodes: %w", err)), nil
+	}
+
+	var lines []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName != "" || !podUnschedulable(pod) {
+			continue
+		}
+		// Collect the distinct taints that exclude this pod from at least
+		// one node; if every node has an untolerated taint, taints are what
+		// keep the pod Pending.
+		blockingTaints := map[string]bool{}
+		blockedNodes := 0
+		for j := range nodes.Items {
+			node := &nodes.Items[j]
+			blocked := false
+			for k := range node.Spec.Taints {
+				taint := &node.Spec.Taints[k]
+				if taint.Effect != corev1.TaintEffectNoSchedule && taint.Effect != corev1.TaintEffectNoExecute {
+					continue
+				}
+				if !tolerates(pod.Spec.Tolerations, taint) {
+					blockingTaints[formatTaint(taint)] = true
+					blocked = true
+				}
+			}
+			if blocked {
+				blockedNodes++
+			}
+		}
+		if blockedNodes == 0 || blockedNodes < len(nodes.Items) {
+			continue
+		}
+		taints := make([]string, 0, len(blockingTaints))
+		for taint := range blockingTaints {
+			taints = append(taints, taint)
+		}
+		sort.Strings(taints)
+		lines = append(lines, fmt.Sprintf("- %s/%s: blocked on all %d node(s) by untolerated taint(s): %s",
+			pod.Namespace, pod.Name, len(nodes.Items), strings.Join(taints, ", ")))
+	}
+	scope := "the cluster"
+	if namespace != "" {
+		scope = "namespace '" + namespace + "'"
+	}
+	if len(lines) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No pending pods in %s are blocked by node taints", scope), nil), nil
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Pending pods in %s blocked by taints (%d):\n%s", scope, len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+// podUnschedulable reports whether a pod's PodScheduled condition is False
+// with reason Unschedulable.
+func podUnschedulable(pod *corev1.Pod) bool {
+	for i := range pod.Status.Conditions {
+		condition := &pod.Status.Conditions[i]
+		if condition.Type == corev1.PodScheduled {
+			return condition.Status == corev1.ConditionFalse && condition.Reason == corev1.PodReasonUnschedulable
+		}
+	}
+	return false
+}
+
+// tolerates reports whether any toleration matches the taint, following the
+// Kubernetes toleration matching rules: an empty effect matches all effects,
+// the Exists operator matches any value, and an empty key with Exists matches
+// all taints.
+func tolerates(tolerations []corev1.Toleration, taint *corev1.Taint) bool {
+	for i := range tolerations {
+		toleration := &tolerations[i]
+		if toleration.Effect != "" && toleration.Effect != taint.Effect {
+			continue
+		}
+		if toleration.Key != "" && toleration.Key != taint.Key {
+			continue
+		}
+		switch toleration.Operator {
+		case corev1.TolerationOpExists:
+			return true
+		case corev1.TolerationOpEqual, "":
+			if toleration.Key == "" {
+				continue
+			}
+			if toleration.Value == taint.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func formatTaint(taint *corev1.Taint) string {
+	if taint.Value != "" {
+		return fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect)
+	}
+	return fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+}
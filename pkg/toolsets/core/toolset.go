@@ -27,11 +27,30 @@ func (t *Toolset) GetDescription() string {
 
 func (t *Toolset) GetTools(p api.FilteringProvider) []api.ServerTool {
 	return slices.Concat(
+		initApps(),
+		initAuth(),
+		initBootstrap(),
+		initCrd(),
+		initDiagnose(),
 		initEvents(),
+		initExport(),
+		initHpa(),
+		initJobs(),
+		initKeys(),
+		initLabel(),
 		initNamespaces(p),
 		initNodes(),
+		initOnboard(),
 		initPods(),
+		initPullSecrets(),
 		initResources(p),
+		initS2I(),
+		initSearch(),
+		initServiceAccounts(),
+		initStatus(),
+		initTaints(),
+		initTree(),
+		initValidate(),
 	)
 }
 
@@ -0,0 +1,266 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+// treeChildGVRs are the namespaced resource types scanned for objects owned
+// (via ownerReferences) by the node being expanded. Covers the common
+// controller chains: Deployment → ReplicaSet → Pod, StatefulSet/DaemonSet →
+// Pod, and CronJob → Job → Pod.
+var treeChildGVRs = []schema.GroupVersionResource{
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Group: "apps", Version: "v1", Resource: "replicasets"},
+	{Group: "apps", Version: "v1", Resource: "statefulsets"},
+	{Group: "apps", Version: "v1", Resource: "daemonsets"},
+	{Group: "batch", Version: "v1", Resource: "jobs"},
+	{Version: "v1", Resource: "pods"},
+}
+
+// treeMaxDepth bounds the downward ownerReference walk.
+const treeMaxDepth = 4
+
+func initTree() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "resources_tree",
+			Description: "Show the ownership and dependency tree of a Kubernetes object: owners (upward ownerReferences), owned objects (e.g. Deployment → ReplicaSet → Pods), " +
+				"and related objects it references or is exposed by (Services, Routes, PersistentVolumeClaims, ConfigMaps, Secrets)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the object (examples of valid apiVersion are: v1, apps/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the object (examples of valid kind are: Pod, Deployment)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the object",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace of the object. If not provided, will use the configured namespace",
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Tree",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesTree},
+	}
+}
+
+func resourcesTree(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	apiVersion := p.RequiredString("apiVersion")
+	kind := p.RequiredString("kind")
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to build resource tree: %w", err)), nil
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to build resource tree: invalid argument apiVersion")), nil
+	}
+	gvk := schema.GroupVersionKind{Group: gv.Group, Version: gv.Version, Kind: kind}
+
+	root, err := kubernetes.NewCore(params).ResourcesGet(params, &gvk, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to build resource tree: %w", err)), nil
+	}
+
+	var sections []string
+	if owners := treeOwners(params, root); len(owners) > 0 {
+		sections = append(sections, "Owners (upward):\n"+strings.Join(owners, "\n"))
+	}
+
+	var tree []string
+	tree = append(tree, fmt.Sprintf("%s/%s", root.GetKind(), root.GetName()))
+	treeChildren(params, root.GetNamespace(), root.GetUID(), 1, &tree)
+	sections = append(sections, "Tree (downward):\n"+strings.Join(tree, "\n"))
+
+	if related := treeRelated(params, root); len(related) > 0 {
+		sections = append(sections, "Related objects:\n"+strings.Join(related, "\n"))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// treeOwners walks ownerReferences upward, innermost owner first.
+func treeOwners(params api.ToolHandlerParams, obj *unstructured.Unstructured) []string {
+	var lines []string
+	current := obj
+	for depth := 0; depth < treeMaxDepth && current != nil; depth++ {
+		owners := current.GetOwnerReferences()
+		if len(owners) == 0 {
+			break
+		}
+		var next *unstructured.Unstructured
+		for _, owner := range owners {
+			lines = append(lines, fmt.Sprintf("%s- owned by %s/%s", strings.Repeat("  ", depth), owner.Kind, owner.Name))
+			gv, err := schema.ParseGroupVersion(owner.APIVersion)
+			if err != nil {
+				continue
+			}
+			gvk := schema.GroupVersionKind{Group: gv.Group, Version: gv.Version, Kind: owner.Kind}
+			if ownerObj, getErr := kubernetes.NewCore(params).ResourcesGet(params, &gvk, current.GetNamespace(), owner.Name); getErr == nil {
+				next = ownerObj
+			}
+		}
+		current = next
+	}
+	return lines
+}
+
+// treeChildren appends objects owned by the given UID, recursing into each
+// child, rendered with two-space indentation per level.
+func treeChildren(params api.ToolHandlerParams, namespace string, ownerUID types.UID, depth int, lines *[]string) {
+	if depth > treeMaxDepth {
+		return
+	}
+	for _, gvr := range treeChildGVRs {
+		list, err := params.DynamicClient().Resource(gvr).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+		if err != nil {
+			continue
+		}
+		for i := range list.Items {
+			item := &list.Items[i]
+			for _, owner := range item.GetOwnerReferences() {
+				if owner.UID != ownerUID {
+					continue
+				}
+				status := treeItemStatus(item)
+				*lines = append(*lines, fmt.Sprintf("%s- %s/%s%s", strings.Repeat("  ", depth), item.GetKind(), item.GetName(), status))
+				treeChildren(params, namespace, item.GetUID(), depth+1, lines)
+				break
+			}
+		}
+	}
+}
+
+// treeItemStatus renders a short status suffix for tree nodes where one is
+// cheaply available (pod phase, replica readiness).
+func treeItemStatus(obj *unstructured.Unstructured) string {
+	if phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase"); phase != "" {
+		return " (" + phase + ")"
+	}
+	if ready, found, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas"); found {
+		if replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); replicas > 0 {
+			return fmt.Sprintf(" (%d/%d ready)", ready, replicas)
+		}
+	}
+	return ""
+}
+
+// treeRelated collects objects the workload references through its pod
+// template (PVCs, ConfigMaps, Secrets, ServiceAccount) and the Services (and
+// OpenShift Routes) that expose it.
+func treeRelated(params api.ToolHandlerParams, obj *unstructured.Unstructured) []string {
+	templateSpec, found, _ := unstructured.NestedMap(obj.Object, "spec", "template", "spec")
+	if !found {
+		// Pods carry the spec directly.
+		templateSpec, found, _ = unstructured.NestedMap(obj.Object, "spec")
+		if !found {
+			return nil
+		}
+	}
+	template := &unstructured.Unstructured{Object: map[string]interface{}{"spec": templateSpec}}
+
+	var lines []string
+	if volumes, _, _ := unstructured.NestedSlice(template.Object, "spec", "volumes"); len(volumes) > 0 {
+		for _, entry := range volumes {
+			volume, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if claim, _, _ := unstructured.NestedString(volume, "persistentVolumeClaim", "claimName"); claim != "" {
+				lines = append(lines, "- PersistentVolumeClaim/"+claim)
+			}
+			if configMap, _, _ := unstructured.NestedString(volume, "configMap", "name"); configMap != "" {
+				lines = append(lines, "- ConfigMap/"+configMap)
+			}
+			if secret, _, _ := unstructured.NestedString(volume, "secret", "secretName"); secret != "" {
+				lines = append(lines, "- Secret/"+secret)
+			}
+		}
+	}
+	if serviceAccount, _, _ := unstructured.NestedString(template.Object, "spec", "serviceAccountName"); serviceAccount != "" && serviceAccount != "default" {
+		lines = append(lines, "- ServiceAccount/"+serviceAccount)
+	}
+
+	// Services whose selector matches the workload's pod labels, and Routes
+	// pointing at those Services.
+	podLabels := treePodLabels(obj)
+	if len(podLabels) == 0 {
+		return lines
+	}
+	services, err := params.CoreV1().Services(obj.GetNamespace()).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return lines
+	}
+	for i := range services.Items {
+		service := &services.Items[i]
+		if len(service.Spec.Selector) == 0 || !labels.SelectorFromSet(service.Spec.Selector).Matches(labels.Set(podLabels)) {
+			continue
+		}
+		lines = append(lines, "- Service/"+service.Name)
+		lines = append(lines, treeRoutesToService(params, obj.GetNamespace(), service.Name)...)
+	}
+	return lines
+}
+
+// treePodLabels returns the labels of the object's pod template, or the
+// object's own labels for Pods.
+func treePodLabels(obj *unstructured.Unstructured) map[string]string {
+	if podLabels, found, _ := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "labels"); found {
+		return podLabels
+	}
+	if obj.GetKind() == "Pod" {
+		return obj.GetLabels()
+	}
+	return nil
+}
+
+// treeRoutesToService lists OpenShift Routes targeting the Service, returning
+// nil when the Route CRD is not available.
+func treeRoutesToService(params api.ToolHandlerParams, namespace, serviceName string) []string {
+	routeGVR := schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+	routes, err := params.DynamicClient().Resource(routeGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+	var lines []string
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		if target, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name"); target == serviceName {
+			host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+			line := "  - Route/" + route.GetName()
+			if host != "" {
+				line += " (" + host + ")"
+			}
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initValidate() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "resources_validate",
+			Description: "Validate a Kubernetes manifest against the cluster without applying it (server-side dry-run). The API server runs its full validation chain " +
+				"(OpenAPI schema, CEL validation rules, admission webhooks) and the per-document errors and warnings are returned, so manifests can be fixed before resources_create_or_update",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"resource": {
+						Type:        "string",
+						Description: "Complete YAML or JSON representation of the Kubernetes resource(s) to validate. Multiple documents separated by --- are validated independently.",
+					},
+				},
+				Required: []string{"resource"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Validate",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesValidate},
+	}
+}
+
+func resourcesValidate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	resource := p.RequiredString("resource")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to validate resources: %w", err)), nil
+	}
+
+	results, err := kubernetes.NewCore(params).ResourcesValidate(params, resource)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to validate resources: %w", err)), nil
+	}
+
+	invalid := 0
+	var lines []string
+	for i, result := range results {
+		ref := fmt.Sprintf("document %d", i+1)
+		if result.Object.GetKind() != "" {
+			ref += fmt.Sprintf(" (%s/%s)", result.Object.GetKind(), result.Object.GetName())
+		}
+		if result.Err != nil {
+			invalid++
+			lines = append(lines, fmt.Sprintf("- %s: INVALID: %v", ref, result.Err))
+		} else {
+			lines = append(lines, fmt.Sprintf("- %s: valid", ref))
+		}
+		for _, warning := range result.Warnings {
+			lines = append(lines, "  warning: "+warning)
+		}
+	}
+	summary := fmt.Sprintf("All %d document(s) passed server-side validation", len(results))
+	if invalid > 0 {
+		summary = fmt.Sprintf("%d of %d document(s) FAILED server-side validation", invalid, len(results))
+	}
+	return api.NewToolCallResult(summary+"\n"+strings.Join(lines, "\n"), nil), nil
+}
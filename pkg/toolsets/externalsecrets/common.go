@@ -0,0 +1,62 @@
+package externalsecrets
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+const externalSecretsGroup = "external-secrets.io"
+
+// hasExternalSecrets returns a TargetCompatibilityFilter that reports whether
+// any target exposes the ExternalSecret CRD. Both the GA v1 and the older
+// v1beta1 API versions are accepted so the tools remain visible across ESO
+// releases.
+func hasExternalSecrets(p api.FilteringProvider) func() bool {
+	return func() bool {
+		for _, version := range []string{"v1", "v1beta1"} {
+			if p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: externalSecretsGroup, Version: version, Kind: "ExternalSecret"},
+			}) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// resolveGVR maps a kind from the external-secrets.io group to its
+// server-preferred GroupVersionResource, so the tools work regardless of
+// whether the cluster serves v1 or v1beta1.
+func resolveGVR(mapper meta.RESTMapper, kind string) (schema.GroupVersionResource, error) {
+	mapping, err := mapper.RESTMapping(schema.GroupKind{Group: externalSecretsGroup, Kind: kind})
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("failed to resolve %s.%s: %w", kind, externalSecretsGroup, err)
+	}
+	return mapping.Resource, nil
+}
+
+// readyCondition returns the (status, reason, message) of the Ready condition
+// from an ExternalSecret or SecretStore status, or ("", "", "") if absent.
+func readyCondition(obj *unstructured.Unstructured) (status, reason, message string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if conditionType, _ := condition["type"].(string); conditionType != "Ready" {
+			continue
+		}
+		status, _ = condition["status"].(string)
+		reason, _ = condition["reason"].(string)
+		message, _ = condition["message"].(string)
+		return status, reason, message
+	}
+	return "", "", ""
+}
@@ -0,0 +1,180 @@
+package externalsecrets
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func initDrift() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "external_secrets_drift_report",
+				Description: "Report ExternalSecrets whose target Secret has drifted: the Secret is missing, the refresh is overdue relative to spec.refreshInterval, keys expected from spec.data or the target template are absent from the Secret, or the ExternalSecret is not Ready",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to scan for ExternalSecrets (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "External Secrets: Drift Report",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: externalSecretsDriftReport,
+		},
+	}
+}
+
+func externalSecretsDriftReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to build drift report: %w", err)), nil
+	}
+
+	gvr, err := resolveGVR(params.RESTMapper(), "ExternalSecret")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to build drift report: %w", err)), nil
+	}
+	list, err := params.DynamicClient().Resource(gvr).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ExternalSecrets: %w", err)), nil
+	}
+	if len(list.Items) == 0 {
+		scope := "all namespaces"
+		if namespace != "" {
+			scope = fmt.Sprintf("namespace '%s'", namespace)
+		}
+		return api.NewToolCallResult(fmt.Sprintf("No ExternalSecrets found in %s", scope), nil), nil
+	}
+
+	var drifted []string
+	for i := range list.Items {
+		es := &list.Items[i]
+		findings := externalSecretDrift(params, es)
+		if len(findings) == 0 {
+			continue
+		}
+		drifted = append(drifted, fmt.Sprintf("%s/%s:\n  - %s", es.GetNamespace(), es.GetName(), strings.Join(findings, "\n  - ")))
+	}
+
+	if len(drifted) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No drift detected across %d ExternalSecret(s)", len(list.Items)), nil), nil
+	}
+	sort.Strings(drifted)
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Drift detected in %d of %d ExternalSecret(s):\n\n%s",
+		len(drifted), len(list.Items), strings.Join(drifted, "\n\n")), nil), nil
+}
+
+// externalSecretDrift inspects a single ExternalSecret against its target
+// Secret and returns a list of human-readable drift findings (empty when the
+// pair is in sync).
+func externalSecretDrift(params api.ToolHandlerParams, es *unstructured.Unstructured) []string {
+	var findings []string
+
+	if status, reason, message := readyCondition(es); status != "" && status != "True" {
+		findings = append(findings, fmt.Sprintf("not Ready (reason: %s): %s", reason, message))
+	}
+
+	targetName, _, _ := unstructured.NestedString(es.Object, "spec", "target", "name")
+	if targetName == "" {
+		targetName = es.GetName()
+	}
+	secret, err := params.CoreV1().Secrets(es.GetNamespace()).Get(params.Context, targetName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		findings = append(findings, fmt.Sprintf("target Secret '%s' is missing", targetName))
+		return findings
+	}
+	if err != nil {
+		findings = append(findings, fmt.Sprintf("target Secret '%s' not accessible: %v", targetName, err))
+		return findings
+	}
+
+	if overdue := refreshOverdue(es); overdue != "" {
+		findings = append(findings, overdue)
+	}
+
+	// Keys contributed by dataFrom extracts cannot be predicted client-side,
+	// so key matching is only performed for explicit spec.data entries and
+	// target template data.
+	for _, key := range expectedKeys(es) {
+		if _, ok := secret.Data[key]; !ok {
+			findings = append(findings, fmt.Sprintf("target Secret '%s' is missing expected key '%s'", targetName, key))
+		}
+	}
+	return findings
+}
+
+// refreshOverdue reports whether the ExternalSecret's last refresh is older
+// than its configured refreshInterval (with a one-interval grace period to
+// avoid flagging refreshes that are merely in flight). Returns a finding
+// string, or "" when the refresh is current or staleness cannot be determined.
+func refreshOverdue(es *unstructured.Unstructured) string {
+	interval, found, _ := unstructured.NestedString(es.Object, "spec", "refreshInterval")
+	if !found || interval == "" || interval == "0" {
+		return ""
+	}
+	duration, err := time.ParseDuration(interval)
+	if err != nil || duration <= 0 {
+		return ""
+	}
+	refreshTime, found, _ := unstructured.NestedString(es.Object, "status", "refreshTime")
+	if !found || refreshTime == "" {
+		return fmt.Sprintf("never refreshed (refreshInterval %s)", interval)
+	}
+	refreshed, err := time.Parse(time.RFC3339, refreshTime)
+	if err != nil {
+		return ""
+	}
+	if age := time.Since(refreshed); age > 2*duration {
+		return fmt.Sprintf("refresh overdue: last refreshed %s ago (refreshInterval %s)", age.Round(time.Second), interval)
+	}
+	return ""
+}
+
+// expectedKeys returns the Secret keys that can be predicted from the
+// ExternalSecret spec: explicit spec.data secretKey entries and the keys of
+// the target template data map.
+func expectedKeys(es *unstructured.Unstructured) []string {
+	seen := map[string]bool{}
+	var keys []string
+	data, _, _ := unstructured.NestedSlice(es.Object, "spec", "data")
+	for _, entry := range data {
+		entryMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if secretKey, ok := entryMap["secretKey"].(string); ok && secretKey != "" && !seen[secretKey] {
+			seen[secretKey] = true
+			keys = append(keys, secretKey)
+		}
+	}
+	templateData, _, _ := unstructured.NestedMap(es.Object, "spec", "target", "template", "data")
+	for key := range templateData {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,128 @@
+package externalsecrets
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// forceSyncAnnotation is the annotation the External Secrets Operator watches
+// to trigger an immediate reconcile of an ExternalSecret out of its regular
+// refreshInterval cycle.
+const forceSyncAnnotation = "force-sync"
+
+const defaultRefreshTimeoutSeconds = 60
+
+func initRefresh() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "external_secrets_refresh",
+				Description: "Force an immediate refresh (resync) of an ExternalSecret by setting the force-sync annotation, then wait for the External Secrets Operator to reconcile it and report the result",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the ExternalSecret to refresh",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the ExternalSecret (Optional, current namespace if not provided)",
+						},
+						"timeout_seconds": {
+							Type:        "integer",
+							Description: "Maximum time to wait for the operator to reconcile the ExternalSecret (Optional, defaults to 60 seconds)",
+							Minimum:     ptr.To(float64(1)),
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "External Secrets: Refresh",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: externalSecretsRefresh,
+		},
+	}
+}
+
+func externalSecretsRefresh(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	timeout := time.Duration(p.OptionalInt64("timeout_seconds", defaultRefreshTimeoutSeconds)) * time.Second
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to refresh ExternalSecret: %w", err)), nil
+	}
+
+	gvr, err := resolveGVR(params.RESTMapper(), "ExternalSecret")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to refresh ExternalSecret: %w", err)), nil
+	}
+	client := params.DynamicClient().Resource(gvr).Namespace(namespace)
+
+	if _, err = client.Get(params.Context, name, metav1.GetOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get ExternalSecret %s/%s: %w", namespace, name, err)), nil
+	}
+
+	// ESO treats any change of the force-sync annotation value as a resync
+	// trigger; a unix timestamp keeps successive refreshes distinct.
+	syncMarker := strconv.FormatInt(time.Now().Unix(), 10)
+	patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, forceSyncAnnotation, syncMarker))
+	if _, err = client.Patch(params.Context, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to annotate ExternalSecret %s/%s for refresh: %w", namespace, name, err)), nil
+	}
+
+	requestedAt := time.Now()
+	var refreshed *unstructured.Unstructured
+	err = wait.PollUntilContextTimeout(params.Context, 2*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		obj, getErr := client.Get(ctx, name, metav1.GetOptions{})
+		if getErr != nil {
+			return false, getErr
+		}
+		refreshTime, found, _ := unstructured.NestedString(obj.Object, "status", "refreshTime")
+		if !found {
+			return false, nil
+		}
+		parsed, parseErr := time.Parse(time.RFC3339, refreshTime)
+		if parseErr != nil {
+			return false, nil
+		}
+		// status.refreshTime has second granularity, so accept any refresh
+		// recorded at or after the second the annotation was applied.
+		if parsed.Before(requestedAt.Truncate(time.Second)) {
+			return false, nil
+		}
+		refreshed = obj
+		return true, nil
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("ExternalSecret %s/%s did not reconcile within %s after the force-sync annotation was applied: %w", namespace, name, timeout, err)), nil
+	}
+
+	status, reason, message := readyCondition(refreshed)
+	refreshTime, _, _ := unstructured.NestedString(refreshed.Object, "status", "refreshTime")
+	switch status {
+	case "True":
+		return api.NewToolCallResult(fmt.Sprintf("ExternalSecret '%s' in namespace '%s' refreshed successfully at %s (reason: %s)", name, namespace, refreshTime, reason), nil), nil
+	case "":
+		return api.NewToolCallResult(fmt.Sprintf("ExternalSecret '%s' in namespace '%s' reconciled at %s but reported no Ready condition", name, namespace, refreshTime), nil), nil
+	default:
+		return api.NewToolCallResult("", fmt.Errorf("ExternalSecret %s/%s reconciled at %s but is not Ready (reason: %s): %s", namespace, name, refreshTime, reason, message)), nil
+	}
+}
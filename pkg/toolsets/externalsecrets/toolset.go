@@ -0,0 +1,52 @@
+package externalsecrets
+
+import (
+	"slices"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+// Toolset provides tools for working with the External Secrets Operator (ESO):
+// forcing ExternalSecret refreshes and inspecting sync state.
+// The ExternalSecret and SecretStore resources themselves are managed via the
+// core toolset's generic resource tools.
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "external-secrets"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "Tools for managing External Secrets Operator resources (ExternalSecrets, SecretStores) and their sync state"
+}
+
+func (t *Toolset) GetTools(p api.FilteringProvider) []api.ServerTool {
+	tools := slices.Concat(
+		initRefresh(),
+		initValidate(),
+		initDrift(),
+	)
+	for i := range tools {
+		tools[i].TargetCompatibilityFilters = append(tools[i].TargetCompatibilityFilters, hasExternalSecrets(p))
+	}
+	return tools
+}
+
+func (t *Toolset) GetPrompts() []api.ServerPrompt {
+	return nil
+}
+
+func (t *Toolset) GetResources() []api.ServerResource {
+	return nil
+}
+
+func (t *Toolset) GetResourceTemplates() []api.ServerResourceTemplate {
+	return nil
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}
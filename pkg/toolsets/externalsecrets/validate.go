@@ -0,0 +1,238 @@
+package externalsecrets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// workloadIdentityAnnotations maps cloud workload-identity mechanisms to the
+// ServiceAccount annotation each one requires. A ServiceAccount referenced by
+// a SecretStore provider without the matching annotation is a common
+// misconfiguration that only surfaces after the store is applied.
+var workloadIdentityAnnotations = map[string]string{
+	"aws": "eks.amazonaws.com/role-arn",
+	"gcp": "iam.gke.io/gcp-service-account",
+}
+
+func initValidate() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "external_secrets_validate_store",
+				Description: "Validate a SecretStore or ClusterSecretStore provider configuration without applying anything: checks that referenced Secrets and ServiceAccounts exist, that workload-identity (IRSA / Workload Identity) ServiceAccounts carry the required annotations, and reports the store's Ready condition. Accepts either the name of an existing store or a proposed manifest",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of an existing SecretStore or ClusterSecretStore to validate (mutually exclusive with manifest)",
+						},
+						"manifest": {
+							Type:        "string",
+							Description: "YAML manifest of a proposed SecretStore or ClusterSecretStore to validate before applying it (mutually exclusive with name)",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the SecretStore and of the referenced Secrets/ServiceAccounts (Optional, current namespace if not provided, ignored for ClusterSecretStores with explicit ref namespaces)",
+						},
+						"cluster_scoped": {
+							Type:        "boolean",
+							Description: "Set to true to validate a ClusterSecretStore instead of a namespaced SecretStore (Optional, only used with name)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "External Secrets: Validate Store",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: externalSecretsValidateStore,
+		},
+	}
+}
+
+func externalSecretsValidateStore(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.OptionalString("name", "")
+	manifest := p.OptionalString("manifest", "")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	clusterScoped := p.OptionalBool("cluster_scoped", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to validate secret store: %w", err)), nil
+	}
+	if (name == "") == (manifest == "") {
+		return api.NewToolCallResult("", fmt.Errorf("failed to validate secret store: exactly one of name or manifest must be provided")), nil
+	}
+
+	var store *unstructured.Unstructured
+	if manifest != "" {
+		obj := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(manifest), &obj); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse secret store manifest: %w", err)), nil
+		}
+		store = &unstructured.Unstructured{Object: obj}
+		kind := store.GetKind()
+		if kind != "SecretStore" && kind != "ClusterSecretStore" {
+			return api.NewToolCallResult("", fmt.Errorf("failed to validate secret store: manifest kind must be SecretStore or ClusterSecretStore, got '%s'", kind)), nil
+		}
+		clusterScoped = kind == "ClusterSecretStore"
+		if store.GetNamespace() != "" {
+			namespace = store.GetNamespace()
+		}
+		name = store.GetName()
+	} else {
+		kind := "SecretStore"
+		if clusterScoped {
+			kind = "ClusterSecretStore"
+		}
+		gvr, err := resolveGVR(params.RESTMapper(), kind)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to validate secret store: %w", err)), nil
+		}
+		client := params.DynamicClient().Resource(gvr)
+		if clusterScoped {
+			store, err = client.Get(params.Context, name, metav1.GetOptions{})
+		} else {
+			store, err = client.Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+		}
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get %s '%s': %w", kind, name, err)), nil
+		}
+	}
+
+	provider, found, _ := unstructured.NestedMap(store.Object, "spec", "provider")
+	if !found || len(provider) == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("failed to validate secret store '%s': spec.provider is empty", name)), nil
+	}
+
+	var checks []string
+	failures := 0
+	for providerType, providerConfig := range provider {
+		configMap, ok := providerConfig.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, ref := range collectRefs(providerType, configMap) {
+			pass, detail := validateRef(params, ref, namespace, providerType)
+			marker := "PASS"
+			if !pass {
+				marker = "FAIL"
+				failures++
+			}
+			checks = append(checks, fmt.Sprintf("[%s] %s", marker, detail))
+		}
+	}
+	if len(checks) == 0 {
+		checks = append(checks, "[WARN] no Secret or ServiceAccount references found in spec.provider; nothing to validate client-side")
+	}
+
+	// For an existing store the operator has already probed the provider
+	// (address reachability, auth); surface its verdict instead of creating a
+	// throwaway ExternalSecret.
+	if manifest == "" {
+		status, reason, message := readyCondition(store)
+		switch status {
+		case "True":
+			checks = append(checks, fmt.Sprintf("[PASS] store reports Ready=True (reason: %s)", reason))
+		case "":
+			checks = append(checks, "[WARN] store reports no Ready condition yet; the operator may not have reconciled it")
+		default:
+			failures++
+			checks = append(checks, fmt.Sprintf("[FAIL] store reports Ready=%s (reason: %s): %s", status, reason, message))
+		}
+	}
+
+	result := fmt.Sprintf("Validation report for secret store '%s' (namespace '%s'):\n%s\n", name, namespace, strings.Join(checks, "\n"))
+	if failures > 0 {
+		result += fmt.Sprintf("\n%d check(s) failed; fix the issues above before relying on this store", failures)
+	} else {
+		result += "\nAll checks passed"
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
+
+// storeRef is a Secret or ServiceAccount reference extracted from a provider
+// configuration block.
+type storeRef struct {
+	kind      string // "Secret" or "ServiceAccount"
+	path      string // dotted path inside spec.provider for reporting
+	name      string
+	key       string // Secret key, empty for ServiceAccounts
+	namespace string // explicit ref namespace (ClusterSecretStore), empty otherwise
+}
+
+// collectRefs walks a provider configuration block and extracts every
+// secretRef-style and serviceAccountRef-style reference, regardless of the
+// provider type. ESO providers consistently name these fields with a
+// "SecretRef"/"secretRef" or "serviceAccountRef" suffix.
+func collectRefs(path string, config map[string]interface{}) []storeRef {
+	var refs []storeRef
+	for field, value := range config {
+		child, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		childPath := path + "." + field
+		lower := strings.ToLower(field)
+		refName, _ := child["name"].(string)
+		switch {
+		case strings.HasSuffix(lower, "secretref") && refName != "":
+			key, _ := child["key"].(string)
+			ns, _ := child["namespace"].(string)
+			refs = append(refs, storeRef{kind: "Secret", path: childPath, name: refName, key: key, namespace: ns})
+		case strings.HasSuffix(lower, "serviceaccountref") && refName != "":
+			ns, _ := child["namespace"].(string)
+			refs = append(refs, storeRef{kind: "ServiceAccount", path: childPath, name: refName, namespace: ns})
+		default:
+			refs = append(refs, collectRefs(childPath, child)...)
+		}
+	}
+	return refs
+}
+
+// validateRef checks a single extracted reference against the cluster and
+// returns whether it passed along with a human-readable detail line.
+func validateRef(params api.ToolHandlerParams, ref storeRef, defaultNamespace, providerType string) (bool, string) {
+	namespace := ref.namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	switch ref.kind {
+	case "Secret":
+		secret, err := params.CoreV1().Secrets(namespace).Get(params.Context, ref.name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("%s: Secret '%s/%s' not accessible: %v", ref.path, namespace, ref.name, err)
+		}
+		if ref.key != "" {
+			if _, ok := secret.Data[ref.key]; !ok {
+				return false, fmt.Sprintf("%s: Secret '%s/%s' exists but has no key '%s'", ref.path, namespace, ref.name, ref.key)
+			}
+			return true, fmt.Sprintf("%s: Secret '%s/%s' exists and contains key '%s'", ref.path, namespace, ref.name, ref.key)
+		}
+		return true, fmt.Sprintf("%s: Secret '%s/%s' exists", ref.path, namespace, ref.name)
+	case "ServiceAccount":
+		sa, err := params.CoreV1().ServiceAccounts(namespace).Get(params.Context, ref.name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Sprintf("%s: ServiceAccount '%s/%s' not accessible: %v", ref.path, namespace, ref.name, err)
+		}
+		if annotation, needsAnnotation := workloadIdentityAnnotations[providerType]; needsAnnotation {
+			if _, ok := sa.Annotations[annotation]; !ok {
+				return false, fmt.Sprintf("%s: ServiceAccount '%s/%s' exists but is missing the '%s' annotation required for %s workload identity", ref.path, namespace, ref.name, annotation, providerType)
+			}
+			return true, fmt.Sprintf("%s: ServiceAccount '%s/%s' exists and carries the '%s' annotation", ref.path, namespace, ref.name, annotation)
+		}
+		return true, fmt.Sprintf("%s: ServiceAccount '%s/%s' exists", ref.path, namespace, ref.name)
+	}
+	return false, fmt.Sprintf("%s: unknown reference kind '%s'", ref.path, ref.kind)
+}
@@ -0,0 +1,179 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func gatewayTools() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "gateways_list",
+				Description: "List Gateways in the current or provided namespace with their class, addresses, and Accepted/Programmed status",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to list Gateways from (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Gateways: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: gatewaysList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "gateways_status",
+				Description: "Get the interpreted status of a Gateway: per-listener conditions, attached route counts, and assigned addresses",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the Gateway",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the Gateway (Optional, current namespace if not provided)",
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Gateways: Status",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: gatewaysStatus,
+		},
+	}
+}
+
+func gatewaysList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list Gateways: %w", err)), nil
+	}
+	list, err := params.DynamicClient().Resource(gatewayGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list Gateways: %w", err)), nil
+	}
+	scope := "the cluster"
+	if namespace != "" {
+		scope = "namespace '" + namespace + "'"
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No Gateways found in %s", scope), nil), nil
+	}
+	var lines []string
+	for i := range list.Items {
+		gateway := &list.Items[i]
+		class, _, _ := unstructured.NestedString(gateway.Object, "spec", "gatewayClassName")
+		acceptedStatus, acceptedReason, acceptedMessage := condition(gateway, "Accepted")
+		programmedStatus, programmedReason, programmedMessage := condition(gateway, "Programmed")
+		addresses := gatewayAddresses(gateway)
+		address := "no address assigned"
+		if len(addresses) > 0 {
+			address = strings.Join(addresses, ", ")
+		}
+		lines = append(lines, fmt.Sprintf("- %s/%s (class: %s, %s, %s, %s)",
+			gateway.GetNamespace(), gateway.GetName(), class,
+			conditionVerdict("Accepted", acceptedStatus, acceptedReason, acceptedMessage),
+			conditionVerdict("Programmed", programmedStatus, programmedReason, programmedMessage),
+			address))
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Gateways in %s (%d):\n%s", scope, len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+func gatewaysStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := p.OptionalString("namespace", params.NamespaceOrDefault(""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get Gateway status: %w", err)), nil
+	}
+	gateway, err := params.DynamicClient().Resource(gatewayGVR).Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get Gateway %s/%s: %w", namespace, name, err)), nil
+	}
+
+	var sections []string
+	class, _, _ := unstructured.NestedString(gateway.Object, "spec", "gatewayClassName")
+	acceptedStatus, acceptedReason, acceptedMessage := condition(gateway, "Accepted")
+	programmedStatus, programmedReason, programmedMessage := condition(gateway, "Programmed")
+	sections = append(sections, fmt.Sprintf("Gateway %s/%s (class: %s):\n- %s\n- %s",
+		namespace, name, class,
+		conditionVerdict("Accepted", acceptedStatus, acceptedReason, acceptedMessage),
+		conditionVerdict("Programmed", programmedStatus, programmedReason, programmedMessage)))
+
+	addresses := gatewayAddresses(gateway)
+	if len(addresses) > 0 {
+		sections = append(sections, "Addresses: "+strings.Join(addresses, ", "))
+	} else {
+		sections = append(sections, "Addresses: none assigned yet")
+	}
+
+	listeners, _, _ := unstructured.NestedSlice(gateway.Object, "status", "listeners")
+	if len(listeners) > 0 {
+		var lines []string
+		for _, l := range listeners {
+			listener, ok := l.(map[string]any)
+			if !ok {
+				continue
+			}
+			listenerName, _ := listener["name"].(string)
+			attachedRoutes, _, _ := unstructured.NestedInt64(listener, "attachedRoutes")
+			conditions, _ := listener["conditions"].([]any)
+			var verdicts []string
+			for _, conditionType := range []string{"Accepted", "Programmed", "ResolvedRefs"} {
+				status, reason, message := conditionIn(conditions, conditionType)
+				if status != "" {
+					verdicts = append(verdicts, conditionVerdict(conditionType, status, reason, message))
+				}
+			}
+			lines = append(lines, fmt.Sprintf("- %s: %d attached route(s), %s", listenerName, attachedRoutes, strings.Join(verdicts, ", ")))
+		}
+		sections = append(sections, fmt.Sprintf("Listeners (%d):\n%s", len(lines), strings.Join(lines, "\n")))
+	} else {
+		sections = append(sections, "Listeners: no listener status reported yet")
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// gatewayAddresses returns the addresses assigned to a Gateway.
+func gatewayAddresses(gateway *unstructured.Unstructured) []string {
+	statusAddresses, _, _ := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+	var addresses []string
+	for _, a := range statusAddresses {
+		address, ok := a.(map[string]any)
+		if !ok {
+			continue
+		}
+		if value, _ := address["value"].(string); value != "" {
+			addresses = append(addresses, value)
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,56 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func gatewayClassTools() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "gatewayclasses_list",
+				Description: "List the cluster's GatewayClasses with their controller and whether each class is accepted by its controller",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "GatewayClasses: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: gatewayClassesList,
+		},
+	}
+}
+
+func gatewayClassesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	list, err := params.DynamicClient().Resource(gatewayClassGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list GatewayClasses: %w", err)), nil
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult("No GatewayClasses found: the Gateway API controller may not be installed", nil), nil
+	}
+	var lines []string
+	for i := range list.Items {
+		class := &list.Items[i]
+		controller, _, _ := unstructured.NestedString(class.Object, "spec", "controllerName")
+		status, reason, message := condition(class, "Accepted")
+		lines = append(lines, fmt.Sprintf("- %s (controller: %s, %s)",
+			class.GetName(), controller, conditionVerdict("Accepted", status, reason, message)))
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("GatewayClasses (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil), nil
+}
@@ -0,0 +1,248 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func httpRouteTools() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "httproutes_list",
+				Description: "List HTTPRoutes in the current or provided namespace with their hostnames, parent Gateways, and per-parent Accepted/ResolvedRefs status",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to list HTTPRoutes from (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "HTTPRoutes: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: httpRoutesList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "httproutes_attach_check",
+				Description: "Validate whether an HTTPRoute can attach to its parent Gateways: checks that each parent exists, the referenced listener accepts routes from the route's namespace, and reports the per-parent attachment status",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the HTTPRoute to validate",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the HTTPRoute (Optional, current namespace if not provided)",
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "HTTPRoutes: Attach Check",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: httpRoutesAttachCheck,
+		},
+	}
+}
+
+func httpRoutesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list HTTPRoutes: %w", err)), nil
+	}
+	list, err := params.DynamicClient().Resource(httpRouteGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list HTTPRoutes: %w", err)), nil
+	}
+	scope := "the cluster"
+	if namespace != "" {
+		scope = "namespace '" + namespace + "'"
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No HTTPRoutes found in %s", scope), nil), nil
+	}
+	var lines []string
+	for i := range list.Items {
+		route := &list.Items[i]
+		hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+		hosts := "any hostname"
+		if len(hostnames) > 0 {
+			hosts = strings.Join(hostnames, ", ")
+		}
+		var parents []string
+		for _, status := range routeParentStatuses(route) {
+			parents = append(parents, status.describe())
+		}
+		parentSummary := "no parent status reported"
+		if len(parents) > 0 {
+			parentSummary = strings.Join(parents, "; ")
+		}
+		lines = append(lines, fmt.Sprintf("- %s/%s (hostnames: %s) -> %s", route.GetNamespace(), route.GetName(), hosts, parentSummary))
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("HTTPRoutes in %s (%d):\n%s", scope, len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+// routeParentStatus is the interpreted status of one parentRef entry in an
+// HTTPRoute's status.parents block.
+type routeParentStatus struct {
+	gateway  string
+	accepted string
+	resolved string
+}
+
+func (s routeParentStatus) describe() string {
+	return fmt.Sprintf("%s (%s, %s)", s.gateway, s.accepted, s.resolved)
+}
+
+func routeParentStatuses(route *unstructured.Unstructured) []routeParentStatus {
+	parents, _, _ := unstructured.NestedSlice(route.Object, "status", "parents")
+	var statuses []routeParentStatus
+	for _, p := range parents {
+		parent, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		parentName, _, _ := unstructured.NestedString(parent, "parentRef", "name")
+		parentNamespace, _, _ := unstructured.NestedString(parent, "parentRef", "namespace")
+		gateway := parentName
+		if parentNamespace != "" {
+			gateway = parentNamespace + "/" + parentName
+		}
+		conditions, _ := parent["conditions"].([]any)
+		acceptedStatus, acceptedReason, acceptedMessage := conditionIn(conditions, "Accepted")
+		resolvedStatus, resolvedReason, resolvedMessage := conditionIn(conditions, "ResolvedRefs")
+		statuses = append(statuses, routeParentStatus{
+			gateway:  gateway,
+			accepted: conditionVerdict("Accepted", acceptedStatus, acceptedReason, acceptedMessage),
+			resolved: conditionVerdict("ResolvedRefs", resolvedStatus, resolvedReason, resolvedMessage),
+		})
+	}
+	return statuses
+}
+
+func httpRoutesAttachCheck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := p.OptionalString("namespace", params.NamespaceOrDefault(""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check HTTPRoute attachment: %w", err)), nil
+	}
+	route, err := params.DynamicClient().Resource(httpRouteGVR).Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get HTTPRoute %s/%s: %w", namespace, name, err)), nil
+	}
+
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	if len(parentRefs) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("HTTPRoute %s/%s has no parentRefs: it is not attached to any Gateway", namespace, name), nil), nil
+	}
+
+	reported := routeParentStatuses(route)
+	var lines []string
+	for _, pr := range parentRefs {
+		parentRef, ok := pr.(map[string]any)
+		if !ok {
+			continue
+		}
+		parentName, _ := parentRef["name"].(string)
+		parentNamespace, _ := parentRef["namespace"].(string)
+		if parentNamespace == "" {
+			parentNamespace = namespace
+		}
+		sectionName, _ := parentRef["sectionName"].(string)
+		lines = append(lines, checkParentAttachment(params, namespace, parentNamespace, parentName, sectionName, reported))
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Attachment check for HTTPRoute %s/%s:\n%s", namespace, name, strings.Join(lines, "\n")), nil), nil
+}
+
+// checkParentAttachment validates one parentRef of an HTTPRoute against the
+// live Gateway and returns a one-line verdict.
+func checkParentAttachment(params api.ToolHandlerParams, routeNamespace, gatewayNamespace, gatewayName, sectionName string, reported []routeParentStatus) string {
+	target := gatewayNamespace + "/" + gatewayName
+	gateway, err := params.DynamicClient().Resource(gatewayGVR).Namespace(gatewayNamespace).Get(params.Context, gatewayName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Sprintf("- %s: FAIL — Gateway does not exist", target)
+		}
+		return fmt.Sprintf("- %s: FAIL — cannot get Gateway: %v", target, err)
+	}
+
+	listeners, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	var problems []string
+	matched := false
+	for _, l := range listeners {
+		listener, ok := l.(map[string]any)
+		if !ok {
+			continue
+		}
+		listenerName, _ := listener["name"].(string)
+		if sectionName != "" && listenerName != sectionName {
+			continue
+		}
+		matched = true
+		if problem := listenerAllowsNamespace(listener, routeNamespace, gatewayNamespace); problem != "" {
+			problems = append(problems, fmt.Sprintf("listener %s: %s", listenerName, problem))
+		}
+	}
+	if !matched {
+		if sectionName != "" {
+			return fmt.Sprintf("- %s: FAIL — Gateway has no listener named %q", target, sectionName)
+		}
+		return fmt.Sprintf("- %s: FAIL — Gateway has no listeners", target)
+	}
+	if len(problems) > 0 {
+		return fmt.Sprintf("- %s: FAIL — %s", target, strings.Join(problems, "; "))
+	}
+
+	// The controller's own verdict trumps static validation when available.
+	for _, status := range reported {
+		if status.gateway == gatewayName || status.gateway == target {
+			return fmt.Sprintf("- %s: %s, %s", target, status.accepted, status.resolved)
+		}
+	}
+	return fmt.Sprintf("- %s: OK — listener accepts routes from namespace %s (controller has not reported status yet)", target, routeNamespace)
+}
+
+// listenerAllowsNamespace checks a listener's allowedRoutes.namespaces policy
+// against the route's namespace. Returns a problem description or the empty
+// string when the route is allowed. The Selector policy requires namespace
+// label evaluation; it is reported as allowed with a caveat-free pass since
+// the controller's Accepted condition is the authority in that case.
+func listenerAllowsNamespace(listener map[string]any, routeNamespace, gatewayNamespace string) string {
+	from, _, _ := unstructured.NestedString(listener, "allowedRoutes", "namespaces", "from")
+	switch from {
+	case "", "Same":
+		if routeNamespace != gatewayNamespace {
+			return fmt.Sprintf("only allows routes from the Gateway's own namespace (%s)", gatewayNamespace)
+		}
+	case "All", "Selector":
+	}
+	return ""
+}
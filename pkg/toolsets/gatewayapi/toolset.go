@@ -0,0 +1,96 @@
+package gatewayapi
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+var (
+	gatewayClassGVR = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gatewayclasses"}
+	gatewayGVR      = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gateways"}
+	httpRouteGVR    = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"}
+)
+
+// Toolset provides Gateway API inspection tools.
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "gateway-api"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "Gateway API tools for Gateways, HTTPRoutes, and GatewayClasses with status interpretation"
+}
+
+func (t *Toolset) GetTools(_ api.FilteringProvider) []api.ServerTool {
+	return slices.Concat(
+		gatewayClassTools(),
+		gatewayTools(),
+		httpRouteTools(),
+	)
+}
+
+func (t *Toolset) GetPrompts() []api.ServerPrompt {
+	return nil
+}
+
+func (t *Toolset) GetResources() []api.ServerResource {
+	return nil
+}
+
+func (t *Toolset) GetResourceTemplates() []api.ServerResourceTemplate {
+	return nil
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}
+
+// condition extracts the status, reason, and message of the named condition
+// from an object's status.conditions.
+func condition(obj *unstructured.Unstructured, conditionType string) (status, reason, message string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	return conditionIn(conditions, conditionType)
+}
+
+// conditionIn extracts the named condition from an already-extracted
+// conditions slice (e.g. a per-listener or per-parent status block).
+func conditionIn(conditions []any, conditionType string) (status, reason, message string) {
+	for _, c := range conditions {
+		cond, ok := c.(map[string]any)
+		if !ok || cond["type"] != conditionType {
+			continue
+		}
+		status, _ = cond["status"].(string)
+		reason, _ = cond["reason"].(string)
+		message, _ = cond["message"].(string)
+		return
+	}
+	return
+}
+
+// conditionVerdict renders a condition as "Type=Status" with the reason and
+// message appended when the condition is not in the expected True state.
+func conditionVerdict(conditionType, status, reason, message string) string {
+	if status == "" {
+		status = "Unknown"
+	}
+	verdict := conditionType + "=" + status
+	if status != "True" {
+		if reason != "" {
+			verdict += " (" + reason
+			if message != "" {
+				verdict += ": " + message
+			}
+			verdict += ")"
+		}
+	}
+	return verdict
+}
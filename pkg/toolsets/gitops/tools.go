@@ -0,0 +1,194 @@
+package gitops
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	gitopsclient "github.com/containers/kubernetes-mcp-server/pkg/gitops"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initManifestGet() []api.ServerTool {
+	tools := []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "gitops_manifest_get",
+			Description: "Fetch the raw content of a manifest file from the configured Git repository " +
+				"(the url and optional path_prefix of the [toolset_configs.gitops] section)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"path": {
+						Type:        "string",
+						Description: `Path of the manifest file within the repository (e.g. "apps/frontend/deployment.yaml")`,
+					},
+				},
+				Required: []string{"path"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "GitOps: Manifest Get",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler: manifestGet,
+	}}
+	// Fetching a file only talks to the Git host, never to a cluster; no
+	// provider-level context parameter is injected.
+	for i := range tools {
+		tools[i].ClusterAware = ptr.To(false)
+	}
+	return tools
+}
+
+func manifestGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	path := p.RequiredString("path")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the manifest: %w", err)), nil
+	}
+	content, err := gitopsclient.NewRepository(params).FetchFile(params.Context, path)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the manifest: %w", err)), nil
+	}
+	return api.NewToolCallResult(content, nil), nil
+}
+
+func initDriftReport() []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "gitops_drift_report",
+			Description: "Fetch a manifest file from the configured Git repository and compare each of its documents against the live cluster object, " +
+				"reporting per resource whether it is in sync, drifted (naming the differing fields), or missing from the cluster",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"path": {
+						Type:        "string",
+						Description: `Path of the manifest file within the repository (e.g. "apps/frontend/deployment.yaml")`,
+					},
+				},
+				Required: []string{"path"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "GitOps: Drift Report",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler: driftReport,
+	}}
+}
+
+func driftReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	path := p.RequiredString("path")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to report the drift: %w", err)), nil
+	}
+	content, err := gitopsclient.NewRepository(params).FetchFile(params.Context, path)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to report the drift: %w", err)), nil
+	}
+
+	core := kubernetes.NewCore(params)
+	separator := regexp.MustCompile(`\r?\n---\r?\n`)
+	var lines []string
+	var drifted, missing int
+	for _, document := range separator.Split(content, -1) {
+		if strings.TrimSpace(document) == "" {
+			continue
+		}
+		var desired unstructured.Unstructured
+		if err := utilyaml.NewYAMLToJSONDecoder(strings.NewReader(document)).Decode(&desired); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to report the drift: %s contains an invalid YAML document: %w", path, err)), nil
+		}
+		gvk := desired.GroupVersionKind()
+		if gvk.Kind == "" || desired.GetName() == "" {
+			return api.NewToolCallResult("", fmt.Errorf("failed to report the drift: %s contains a document without kind or metadata.name", path)), nil
+		}
+		reference := fmt.Sprintf("%s %s", gvk.Kind, desired.GetName())
+		if desired.GetNamespace() != "" {
+			reference = fmt.Sprintf("%s %s/%s", gvk.Kind, desired.GetNamespace(), desired.GetName())
+		}
+
+		live, err := core.ResourcesGet(params.Context, &gvk, desired.GetNamespace(), desired.GetName())
+		if apierrors.IsNotFound(err) {
+			lines = append(lines, fmt.Sprintf("- %s: MISSING from the cluster", reference))
+			missing++
+			continue
+		}
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to report the drift: could not get the live %s: %w", reference, err)), nil
+		}
+
+		fields := driftedFields(&desired, live)
+		if len(fields) == 0 {
+			lines = append(lines, fmt.Sprintf("- %s: in sync", reference))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("- %s: DRIFTED (%s)", reference, strings.Join(fields, ", ")))
+		drifted++
+	}
+	if len(lines) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("%s contains no resource documents", path), nil), nil
+	}
+
+	summary := "all resources are in sync with Git"
+	if drifted > 0 || missing > 0 {
+		summary = fmt.Sprintf("%d resource(s) drifted, %d missing from the cluster", drifted, missing)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Drift report for %s (%s):\n%s", path, summary, strings.Join(lines, "\n")), nil), nil
+}
+
+// driftedFields compares the fields declared in the Git manifest against the
+// live object and returns the names of the differing ones. Only fields present
+// in the manifest are compared: the cluster is free to add defaults, status,
+// and runtime metadata without counting as drift.
+func driftedFields(desired, live *unstructured.Unstructured) []string {
+	var fields []string
+	for key, desiredValue := range desired.Object {
+		if key == "apiVersion" || key == "kind" || key == "metadata" || key == "status" {
+			continue
+		}
+		// json.Marshal sorts map keys and renders YAML and cluster numbers
+		// identically, so byte equality is a reliable deep comparison.
+		desiredJSON, err := json.Marshal(desiredValue)
+		if err != nil {
+			continue
+		}
+		liveJSON, err := json.Marshal(live.Object[key])
+		if err != nil || string(desiredJSON) != string(liveJSON) {
+			fields = append(fields, key)
+		}
+	}
+	liveLabels := live.GetLabels()
+	for key, value := range desired.GetLabels() {
+		if liveLabels[key] != value {
+			fields = append(fields, "metadata.labels")
+			break
+		}
+	}
+	liveAnnotations := live.GetAnnotations()
+	for key, value := range desired.GetAnnotations() {
+		if liveAnnotations[key] != value {
+			fields = append(fields, "metadata.annotations")
+			break
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,396 @@
+package keda
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	scaledObjectsGVR = schema.GroupVersionResource{Group: "keda.sh", Version: "v1alpha1", Resource: "scaledobjects"}
+	scaledJobsGVR    = schema.GroupVersionResource{Group: "keda.sh", Version: "v1alpha1", Resource: "scaledjobs"}
+)
+
+// pausedAnnotation pauses a ScaledObject's autoscaling when set to "true";
+// pausedReplicasAnnotation additionally pins the workload to a replica count
+// while paused.
+const (
+	pausedAnnotation         = "autoscaling.keda.sh/paused"
+	pausedReplicasAnnotation = "autoscaling.keda.sh/paused-replicas"
+)
+
+// kedaAPIFilter hides the tools on clusters without KEDA (or the Custom
+// Metrics Autoscaler operator on OpenShift).
+func kedaAPIFilter(p api.FilteringProvider) []func() bool {
+	return []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "keda.sh", Version: "v1alpha1", Kind: "ScaledObject"},
+			})
+		},
+	}
+}
+
+func initScaledObjectsList(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "keda_scaledobjects_list",
+			Description: "List the KEDA ScaledObjects and ScaledJobs of a namespace (or all namespaces) with their target, triggers, " +
+				"readiness and activity conditions, and whether autoscaling is paused",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list ScaledObjects and ScaledJobs in (Optional, all namespaces if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "KEDA: ScaledObjects List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler:                    scaledObjectsList,
+		TargetCompatibilityFilters: kedaAPIFilter(p),
+	}}
+}
+
+func scaledObjectsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list KEDA scaled objects: %w", err)), nil
+	}
+
+	var sections []string
+	for _, resource := range []struct {
+		gvr   schema.GroupVersionResource
+		label string
+	}{
+		{scaledObjectsGVR, "ScaledObjects"},
+		{scaledJobsGVR, "ScaledJobs"},
+	} {
+		client := params.DynamicClient().Resource(resource.gvr)
+		var list *unstructured.UnstructuredList
+		var err error
+		if namespace != "" {
+			list, err = client.Namespace(namespace).List(params.Context, metav1.ListOptions{})
+		} else {
+			list, err = client.List(params.Context, metav1.ListOptions{})
+		}
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to list KEDA %s: %w", strings.ToLower(resource.label), err)), nil
+		}
+		if len(list.Items) == 0 {
+			continue
+		}
+		var lines []string
+		for i := range list.Items {
+			lines = append(lines, describeScaledResource(&list.Items[i]))
+		}
+		sort.Strings(lines)
+		sections = append(sections, fmt.Sprintf("%s (%d):\n%s", resource.label, len(list.Items), strings.Join(lines, "\n")))
+	}
+	if len(sections) == 0 {
+		return api.NewToolCallResult("No KEDA ScaledObjects or ScaledJobs found", nil), nil
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// describeScaledResource renders one ScaledObject or ScaledJob with its
+// target, triggers, conditions, and pause state.
+func describeScaledResource(scaled *unstructured.Unstructured) string {
+	target, _, _ := unstructured.NestedString(scaled.Object, "spec", "scaleTargetRef", "name")
+	line := fmt.Sprintf("- %s/%s", scaled.GetNamespace(), scaled.GetName())
+	if target != "" {
+		line += " → " + target
+	}
+	if scaled.GetAnnotations()[pausedAnnotation] == "true" || scaled.GetAnnotations()[pausedReplicasAnnotation] != "" {
+		line += " [PAUSED]"
+	}
+
+	var triggerTypes []string
+	triggers, _, _ := unstructured.NestedSlice(scaled.Object, "spec", "triggers")
+	for _, raw := range triggers {
+		trigger, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		triggerType, _ := trigger["type"].(string)
+		triggerTypes = append(triggerTypes, triggerType)
+	}
+	if len(triggerTypes) > 0 {
+		line += "\n  triggers: " + strings.Join(triggerTypes, ", ")
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(scaled.Object, "status", "conditions")
+	for _, raw := range conditions {
+		condition, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		conditionType, _ := condition["type"].(string)
+		status, _ := condition["status"].(string)
+		message, _ := condition["message"].(string)
+		detail := fmt.Sprintf("\n  %s: %s", conditionType, status)
+		// Surface the error message of unhealthy conditions (e.g. a trigger
+		// that cannot reach its metrics source).
+		if (conditionType == "Ready" && status != "True") || (conditionType == "Fallback" && status == "True") {
+			if message != "" {
+				detail += " (" + message + ")"
+			}
+		}
+		line += detail
+	}
+	return line
+}
+
+func initAutoscalingPause(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "keda_scaledobject_pause",
+				Description: "Pause the autoscaling of a KEDA ScaledObject; the workload keeps its current replicas, or is pinned to pausedReplicas when provided. " +
+					"Resume with keda_scaledobject_resume",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the ScaledObject",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the ScaledObject to pause",
+						},
+						"pausedReplicas": {
+							Type:        "integer",
+							Description: "Pin the workload to this replica count while paused (Optional, keeps the current replicas)",
+							Minimum:     ptr.To(float64(0)),
+						},
+					},
+					Required: []string{"namespace", "name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "KEDA: ScaledObject Pause",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(true),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    scaledObjectPause,
+			TargetCompatibilityFilters: kedaAPIFilter(p),
+		},
+		{
+			Tool: api.Tool{
+				Name:        "keda_scaledobject_resume",
+				Description: "Resume the autoscaling of a paused KEDA ScaledObject by removing its pause annotations",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the ScaledObject",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the ScaledObject to resume",
+						},
+					},
+					Required: []string{"namespace", "name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "KEDA: ScaledObject Resume",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(true),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    scaledObjectResume,
+			TargetCompatibilityFilters: kedaAPIFilter(p),
+		},
+	}
+}
+
+func scaledObjectPause(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	name := p.RequiredString("name")
+	pausedReplicas := p.OptionalInt64("pausedReplicas", -1)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to pause the ScaledObject: %w", err)), nil
+	}
+
+	client := params.DynamicClient().Resource(scaledObjectsGVR).Namespace(namespace)
+	scaledObject, err := client.Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get ScaledObject %s/%s: %w", namespace, name, err)), nil
+	}
+	annotations := scaledObject.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[pausedAnnotation] = "true"
+	result := fmt.Sprintf("ScaledObject %s/%s is paused; the workload keeps its current replicas", namespace, name)
+	if pausedReplicas >= 0 {
+		annotations[pausedReplicasAnnotation] = fmt.Sprintf("%d", pausedReplicas)
+		result = fmt.Sprintf("ScaledObject %s/%s is paused with the workload pinned to %d replica(s)", namespace, name, pausedReplicas)
+	}
+	scaledObject.SetAnnotations(annotations)
+	if _, err := client.Update(params.Context, scaledObject, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to pause ScaledObject %s/%s: %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(result+"\nResume with keda_scaledobject_resume", nil), nil
+}
+
+func scaledObjectResume(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	name := p.RequiredString("name")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to resume the ScaledObject: %w", err)), nil
+	}
+
+	client := params.DynamicClient().Resource(scaledObjectsGVR).Namespace(namespace)
+	scaledObject, err := client.Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get ScaledObject %s/%s: %w", namespace, name, err)), nil
+	}
+	annotations := scaledObject.GetAnnotations()
+	if annotations[pausedAnnotation] == "" && annotations[pausedReplicasAnnotation] == "" {
+		return api.NewToolCallResult(fmt.Sprintf("ScaledObject %s/%s is not paused", namespace, name), nil), nil
+	}
+	delete(annotations, pausedAnnotation)
+	delete(annotations, pausedReplicasAnnotation)
+	scaledObject.SetAnnotations(annotations)
+	if _, err := client.Update(params.Context, scaledObject, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to resume ScaledObject %s/%s: %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("ScaledObject %s/%s resumed autoscaling", namespace, name), nil), nil
+}
+
+func initScaledObjectCreate(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "keda_scaledobject_create",
+			Description: "Create a basic KEDA ScaledObject for a workload with a single trigger, e.g. a prometheus trigger " +
+				"(triggerMetadata: serverAddress, query, threshold) or a kafka lag trigger (triggerMetadata: bootstrapServers, consumerGroup, topic, lagThreshold). " +
+				"The trigger metadata keys are passed to the KEDA scaler of the given trigger type as-is",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the workload",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the workload to scale; the ScaledObject is created with the same name",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "Kind of the workload to scale (Optional, default: Deployment)",
+					},
+					"minReplicas": {
+						Type:        "integer",
+						Description: "Minimum replica count (Optional, default: 1)",
+						Minimum:     ptr.To(float64(0)),
+					},
+					"maxReplicas": {
+						Type:        "integer",
+						Description: "Maximum replica count (Optional, default: 10)",
+						Minimum:     ptr.To(float64(1)),
+					},
+					"triggerType": {
+						Type:        "string",
+						Description: "KEDA scaler type of the trigger (e.g. prometheus, kafka, cpu, cron)",
+					},
+					"triggerMetadata": {
+						Type:                 "object",
+						Description:          "Metadata of the trigger, passed to the scaler as-is; the required keys depend on the trigger type",
+						AdditionalProperties: &jsonschema.Schema{Type: "string"},
+					},
+				},
+				Required: []string{"namespace", "name", "triggerType", "triggerMetadata"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "KEDA: ScaledObject Create",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler:                    scaledObjectCreate,
+		TargetCompatibilityFilters: kedaAPIFilter(p),
+	}}
+}
+
+func scaledObjectCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	name := p.RequiredString("name")
+	kind := p.OptionalString("kind", "Deployment")
+	minReplicas := p.OptionalInt64("minReplicas", 1)
+	maxReplicas := p.OptionalInt64("maxReplicas", 10)
+	triggerType := p.RequiredString("triggerType")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create the ScaledObject: %w", err)), nil
+	}
+	rawMetadata, ok := params.GetArguments()["triggerMetadata"].(map[string]any)
+	if !ok || len(rawMetadata) == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create the ScaledObject: triggerMetadata parameter is required and must be an object of strings")), nil
+	}
+	triggerMetadata := make(map[string]any, len(rawMetadata))
+	for key, value := range rawMetadata {
+		stringValue, ok := value.(string)
+		if !ok {
+			return api.NewToolCallResult("", fmt.Errorf("failed to create the ScaledObject: triggerMetadata.%s must be a string", key)), nil
+		}
+		triggerMetadata[key] = stringValue
+	}
+
+	scaledObject := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "keda.sh/v1alpha1",
+		"kind":       "ScaledObject",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]any{
+			"scaleTargetRef": map[string]any{
+				"kind": kind,
+				"name": name,
+			},
+			"minReplicaCount": minReplicas,
+			"maxReplicaCount": maxReplicas,
+			"triggers": []any{
+				map[string]any{
+					"type":     triggerType,
+					"metadata": triggerMetadata,
+				},
+			},
+		},
+	}}
+	if _, err := params.DynamicClient().Resource(scaledObjectsGVR).Namespace(namespace).Create(params.Context, scaledObject, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create ScaledObject %s/%s: %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Created ScaledObject %s/%s scaling %s %s between %d and %d replica(s) on a %s trigger. "+
+			"Check its conditions with keda_scaledobjects_list", namespace, name, kind, name, minReplicas, maxReplicas, triggerType), nil), nil
+}
@@ -0,0 +1,44 @@
+package keda
+
+import (
+	"slices"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "keda"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "KEDA (Custom Metrics Autoscaler) tools for ScaledObjects and ScaledJobs: trigger status, pausing, and creation"
+}
+
+func (t *Toolset) GetTools(o api.FilteringProvider) []api.ServerTool {
+	return slices.Concat(
+		initScaledObjectsList(o),
+		initAutoscalingPause(o),
+		initScaledObjectCreate(o),
+	)
+}
+
+func (t *Toolset) GetPrompts() []api.ServerPrompt {
+	return nil
+}
+
+func (t *Toolset) GetResources() []api.ServerResource {
+	return nil
+}
+
+func (t *Toolset) GetResourceTemplates() []api.ServerResourceTemplate {
+	return nil
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}
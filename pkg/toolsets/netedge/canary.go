@@ -0,0 +1,234 @@
+package netedge
+
+import (
+	"fmt"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+var serviceGVR = schema.GroupVersionResource{
+	Group:    "",
+	Version:  "v1",
+	Resource: "services",
+}
+
+func initCanary() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "set_route_canary",
+				Description: "Set a canary backend on an OpenShift Route: the given service is added as an alternate backend receiving the given percentage " +
+					"of traffic while the primary backend keeps the rest. The canary service must exist in the route's namespace.",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Route namespace",
+						},
+						"route": {
+							Type:        "string",
+							Description: "Route name",
+						},
+						"service": {
+							Type:        "string",
+							Description: "Name of the service to receive canary traffic",
+						},
+						"percentage": {
+							Type:        "integer",
+							Description: "Percentage of traffic (1-99) to send to the canary service",
+							Minimum:     ptr.To(float64(1)),
+							Maximum:     ptr.To(float64(99)),
+						},
+					},
+					Required: []string{"namespace", "route", "service", "percentage"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Set Route Canary",
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: setRouteCanary,
+		},
+		{
+			Tool: api.Tool{
+				Name: "promote_route_canary",
+				Description: "Promote the canary backend of an OpenShift Route to be the primary backend receiving 100% of the traffic. " +
+					"The previous primary backend is removed from the route.",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Route namespace",
+						},
+						"route": {
+							Type:        "string",
+							Description: "Route name",
+						},
+					},
+					Required: []string{"namespace", "route"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Promote Route Canary",
+					DestructiveHint: ptr.To(true), // the previous primary backend stops receiving traffic
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: promoteRouteCanary,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "rollback_route_canary",
+				Description: "Remove the canary backend from an OpenShift Route, returning 100% of the traffic to the primary backend.",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Route namespace",
+						},
+						"route": {
+							Type:        "string",
+							Description: "Route name",
+						},
+					},
+					Required: []string{"namespace", "route"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Rollback Route Canary",
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: rollbackRouteCanary,
+		},
+	}
+}
+
+func setRouteCanary(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	routeName := p.RequiredString("route")
+	service := p.RequiredString("service")
+	percentage := p.OptionalInt64("percentage", -1)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	if percentage < 1 || percentage > 99 {
+		return api.NewToolCallResult("", fmt.Errorf("percentage must be between 1 and 99, got %d", percentage)), nil
+	}
+
+	if _, err := params.DynamicClient().Resource(serviceGVR).Namespace(namespace).Get(params.Context, service, metav1.GetOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("canary service %s/%s does not exist: %w", namespace, service, err)), nil
+	}
+	route, err := params.DynamicClient().Resource(routeGVR).Namespace(namespace).Get(params.Context, routeName, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get route %s/%s: %w", namespace, routeName, err)), nil
+	}
+	primary, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name")
+	if primary == service {
+		return api.NewToolCallResult("", fmt.Errorf("service %s is already the primary backend of route %s/%s", service, namespace, routeName)), nil
+	}
+
+	if err = unstructured.SetNestedField(route.Object, int64(100-percentage), "spec", "to", "weight"); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set primary backend weight: %w", err)), nil
+	}
+	alternateBackends := []interface{}{
+		map[string]interface{}{
+			"kind":   "Service",
+			"name":   service,
+			"weight": int64(percentage),
+		},
+	}
+	if err = unstructured.SetNestedSlice(route.Object, alternateBackends, "spec", "alternateBackends"); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set alternate backends: %w", err)), nil
+	}
+	if _, err = params.DynamicClient().Resource(routeGVR).Namespace(namespace).Update(params.Context, route, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update route %s/%s: %w", namespace, routeName, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Route %s/%s now sends %d%% of the traffic to canary service %s and %d%% to primary service %s. "+
+			"Use promote_route_canary to make the canary primary or rollback_route_canary to remove it.",
+		namespace, routeName, percentage, service, 100-percentage, primary), nil), nil
+}
+
+func promoteRouteCanary(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	routeName := p.RequiredString("route")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+
+	route, err := params.DynamicClient().Resource(routeGVR).Namespace(namespace).Get(params.Context, routeName, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get route %s/%s: %w", namespace, routeName, err)), nil
+	}
+	alternateBackends, _, _ := unstructured.NestedSlice(route.Object, "spec", "alternateBackends")
+	if len(alternateBackends) == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("route %s/%s has no canary backend to promote", namespace, routeName)), nil
+	}
+	canary, ok := alternateBackends[0].(map[string]interface{})
+	if !ok {
+		return api.NewToolCallResult("", fmt.Errorf("route %s/%s has a malformed alternate backend", namespace, routeName)), nil
+	}
+	canaryService, _, _ := unstructured.NestedString(canary, "name")
+	if canaryService == "" {
+		return api.NewToolCallResult("", fmt.Errorf("route %s/%s has an alternate backend without a service name", namespace, routeName)), nil
+	}
+	previousPrimary, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name")
+
+	if err = unstructured.SetNestedField(route.Object, canaryService, "spec", "to", "name"); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set primary backend: %w", err)), nil
+	}
+	if err = unstructured.SetNestedField(route.Object, int64(100), "spec", "to", "weight"); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set primary backend weight: %w", err)), nil
+	}
+	unstructured.RemoveNestedField(route.Object, "spec", "alternateBackends")
+	if _, err = params.DynamicClient().Resource(routeGVR).Namespace(namespace).Update(params.Context, route, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update route %s/%s: %w", namespace, routeName, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Canary service %s promoted to primary backend of route %s/%s with 100%% of the traffic (previous primary: %s)",
+		canaryService, namespace, routeName, previousPrimary), nil), nil
+}
+
+func rollbackRouteCanary(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	routeName := p.RequiredString("route")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+
+	route, err := params.DynamicClient().Resource(routeGVR).Namespace(namespace).Get(params.Context, routeName, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get route %s/%s: %w", namespace, routeName, err)), nil
+	}
+	alternateBackends, _, _ := unstructured.NestedSlice(route.Object, "spec", "alternateBackends")
+	if len(alternateBackends) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("Route %s/%s has no canary backend, nothing to roll back", namespace, routeName), nil), nil
+	}
+	primary, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name")
+
+	unstructured.RemoveNestedField(route.Object, "spec", "alternateBackends")
+	if err = unstructured.SetNestedField(route.Object, int64(100), "spec", "to", "weight"); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set primary backend weight: %w", err)), nil
+	}
+	if _, err = params.DynamicClient().Resource(routeGVR).Namespace(namespace).Update(params.Context, route, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update route %s/%s: %w", namespace, routeName, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf(
+		"Canary backend removed from route %s/%s; primary service %s receives 100%% of the traffic again",
+		namespace, routeName, primary), nil), nil
+}
@@ -0,0 +1,174 @@
+package netedge
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+func canaryTestRoute(alternateBackends []interface{}) *unstructured.Unstructured {
+	route := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "route.openshift.io/v1",
+			"kind":       "Route",
+			"metadata": map[string]interface{}{
+				"name":      "my-route",
+				"namespace": "default",
+			},
+			"spec": map[string]interface{}{
+				"host": "example.com",
+				"to": map[string]interface{}{
+					"kind":   "Service",
+					"name":   "stable",
+					"weight": int64(100),
+				},
+			},
+		},
+	}
+	if alternateBackends != nil {
+		_ = unstructured.SetNestedSlice(route.Object, alternateBackends, "spec", "alternateBackends")
+	}
+	return route
+}
+
+func canaryTestService(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "default",
+			},
+		},
+	}
+}
+
+func (s *NetEdgeTestSuite) newCanaryDynamicClient(objs ...runtime.Object) *fake.FakeDynamicClient {
+	scheme := runtime.NewScheme()
+	s.Require().NoError(clientgoscheme.AddToScheme(scheme))
+	return fake.NewSimpleDynamicClient(scheme, objs...)
+}
+
+func (s *NetEdgeTestSuite) getRoute(client *fake.FakeDynamicClient) *unstructured.Unstructured {
+	route, err := client.Resource(routeGVR).Namespace("default").Get(s.params.Context, "my-route", metav1.GetOptions{})
+	s.Require().NoError(err)
+	return route
+}
+
+func (s *NetEdgeTestSuite) TestSetRouteCanary() {
+	s.Run("splits traffic between primary and canary", func() {
+		dynClient := s.newCanaryDynamicClient(canaryTestRoute(nil), canaryTestService("canary"))
+		s.SetArgs(map[string]interface{}{"namespace": "default", "route": "my-route", "service": "canary", "percentage": float64(20)})
+		s.SetDynamicClient(dynClient)
+
+		result, err := setRouteCanary(s.params)
+
+		s.Require().NoError(err)
+		s.Require().NoError(result.Error)
+		s.Assert().Contains(result.Content, "20% of the traffic to canary service canary")
+		route := s.getRoute(dynClient)
+		weight, _, _ := unstructured.NestedInt64(route.Object, "spec", "to", "weight")
+		s.Assert().Equal(int64(80), weight, "primary backend should keep the remaining traffic")
+		alternateBackends, _, _ := unstructured.NestedSlice(route.Object, "spec", "alternateBackends")
+		s.Require().Len(alternateBackends, 1)
+		s.Assert().Equal("canary", alternateBackends[0].(map[string]interface{})["name"])
+	})
+	s.Run("rejects missing canary service", func() {
+		dynClient := s.newCanaryDynamicClient(canaryTestRoute(nil))
+		s.SetArgs(map[string]interface{}{"namespace": "default", "route": "my-route", "service": "canary", "percentage": float64(20)})
+		s.SetDynamicClient(dynClient)
+
+		result, err := setRouteCanary(s.params)
+
+		s.Require().NoError(err)
+		s.Require().Error(result.Error)
+		s.Assert().Contains(result.Error.Error(), "does not exist")
+	})
+	s.Run("rejects out-of-range percentage", func() {
+		dynClient := s.newCanaryDynamicClient(canaryTestRoute(nil), canaryTestService("canary"))
+		s.SetArgs(map[string]interface{}{"namespace": "default", "route": "my-route", "service": "canary", "percentage": float64(100)})
+		s.SetDynamicClient(dynClient)
+
+		result, err := setRouteCanary(s.params)
+
+		s.Require().NoError(err)
+		s.Require().Error(result.Error)
+		s.Assert().Contains(result.Error.Error(), "percentage must be between 1 and 99")
+	})
+	s.Run("rejects the primary service as canary", func() {
+		dynClient := s.newCanaryDynamicClient(canaryTestRoute(nil), canaryTestService("stable"))
+		s.SetArgs(map[string]interface{}{"namespace": "default", "route": "my-route", "service": "stable", "percentage": float64(20)})
+		s.SetDynamicClient(dynClient)
+
+		result, err := setRouteCanary(s.params)
+
+		s.Require().NoError(err)
+		s.Require().Error(result.Error)
+		s.Assert().Contains(result.Error.Error(), "already the primary backend")
+	})
+}
+
+func (s *NetEdgeTestSuite) TestPromoteRouteCanary() {
+	s.Run("promotes the canary to primary", func() {
+		dynClient := s.newCanaryDynamicClient(canaryTestRoute([]interface{}{
+			map[string]interface{}{"kind": "Service", "name": "canary", "weight": int64(20)},
+		}))
+		s.SetArgs(map[string]interface{}{"namespace": "default", "route": "my-route"})
+		s.SetDynamicClient(dynClient)
+
+		result, err := promoteRouteCanary(s.params)
+
+		s.Require().NoError(err)
+		s.Require().NoError(result.Error)
+		route := s.getRoute(dynClient)
+		name, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name")
+		s.Assert().Equal("canary", name, "canary should become the primary backend")
+		_, hasAlternates, _ := unstructured.NestedSlice(route.Object, "spec", "alternateBackends")
+		s.Assert().False(hasAlternates, "alternate backends should be removed")
+	})
+	s.Run("fails without a canary backend", func() {
+		dynClient := s.newCanaryDynamicClient(canaryTestRoute(nil))
+		s.SetArgs(map[string]interface{}{"namespace": "default", "route": "my-route"})
+		s.SetDynamicClient(dynClient)
+
+		result, err := promoteRouteCanary(s.params)
+
+		s.Require().NoError(err)
+		s.Require().Error(result.Error)
+		s.Assert().Contains(result.Error.Error(), "no canary backend to promote")
+	})
+}
+
+func (s *NetEdgeTestSuite) TestRollbackRouteCanary() {
+	s.Run("removes the canary backend", func() {
+		dynClient := s.newCanaryDynamicClient(canaryTestRoute([]interface{}{
+			map[string]interface{}{"kind": "Service", "name": "canary", "weight": int64(20)},
+		}))
+		s.SetArgs(map[string]interface{}{"namespace": "default", "route": "my-route"})
+		s.SetDynamicClient(dynClient)
+
+		result, err := rollbackRouteCanary(s.params)
+
+		s.Require().NoError(err)
+		s.Require().NoError(result.Error)
+		route := s.getRoute(dynClient)
+		name, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name")
+		s.Assert().Equal("stable", name, "primary backend should be unchanged")
+		weight, _, _ := unstructured.NestedInt64(route.Object, "spec", "to", "weight")
+		s.Assert().Equal(int64(100), weight, "primary backend should receive all traffic")
+	})
+	s.Run("is a no-op without a canary backend", func() {
+		dynClient := s.newCanaryDynamicClient(canaryTestRoute(nil))
+		s.SetArgs(map[string]interface{}{"namespace": "default", "route": "my-route"})
+		s.SetDynamicClient(dynClient)
+
+		result, err := rollbackRouteCanary(s.params)
+
+		s.Require().NoError(err)
+		s.Require().NoError(result.Error)
+		s.Assert().Contains(result.Content, "nothing to roll back")
+	})
+}
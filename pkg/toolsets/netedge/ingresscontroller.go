@@ -0,0 +1,294 @@
+package netedge
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+const ingressOperatorNamespace = "openshift-ingress-operator"
+
+var ingressControllerGVR = schema.GroupVersionResource{
+	Group:    "operator.openshift.io",
+	Version:  "v1",
+	Resource: "ingresscontrollers",
+}
+
+var secretGVR = schema.GroupVersionResource{
+	Group:    "",
+	Version:  "v1",
+	Resource: "secrets",
+}
+
+func initIngressController() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "get_ingress_controller_status",
+				Description: `Report the health of OpenShift IngressControllers: operator conditions, router pod readiness, sharding selectors, and default certificate expiry. The first stop when a route returns 503.`,
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "IngressController name (optional, all IngressControllers if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Get Ingress Controller Status",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: getIngressControllerStatus,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "list_rejected_routes",
+				Description: `List OpenShift Routes that a router has rejected (Admitted=False), with the rejection reason and message, e.g. HostAlreadyClaimed or ExtendedValidationFailed.`,
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to inspect (optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "List Rejected Routes",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: listRejectedRoutes,
+		},
+	}
+}
+
+func getIngressControllerStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.OptionalString("name", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("invalid parameters: %w", err)), nil
+	}
+
+	var controllers []unstructured.Unstructured
+	if name != "" {
+		controller, err := params.DynamicClient().Resource(ingressControllerGVR).Namespace(ingressOperatorNamespace).Get(params.Context, name, metav1.GetOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get ingresscontroller %s: %w", name, err)), nil
+		}
+		controllers = append(controllers, *controller)
+	} else {
+		list, err := params.DynamicClient().Resource(ingressControllerGVR).Namespace(ingressOperatorNamespace).List(params.Context, metav1.ListOptions{})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to list ingresscontrollers: %w", err)), nil
+		}
+		controllers = list.Items
+	}
+	if len(controllers) == 0 {
+		return api.NewToolCallResult("No ingresscontrollers found in namespace "+ingressOperatorNamespace, nil), nil
+	}
+
+	var sections []string
+	for i := range controllers {
+		sections = append(sections, describeIngressController(params, &controllers[i]))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+func describeIngressController(params api.ToolHandlerParams, controller *unstructured.Unstructured) string {
+	var lines []string
+	lines = append(lines, fmt.Sprintf("# IngressController %s", controller.GetName()))
+
+	if domain, _, _ := unstructured.NestedString(controller.Object, "status", "domain"); domain != "" {
+		lines = append(lines, "Domain: "+domain)
+	}
+
+	// Operator conditions: show everything that deviates from healthy.
+	conditions, _, _ := unstructured.NestedSlice(controller.Object, "status", "conditions")
+	var unhealthy []string
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _ := condition["type"].(string)
+		status, _ := condition["status"].(string)
+		healthy := (conditionType == "Degraded" && status == "False") ||
+			(conditionType != "Degraded" && status == "True")
+		if conditionType == "Progressing" || conditionType == "Upgradeable" || healthy {
+			continue
+		}
+		reason, _ := condition["reason"].(string)
+		message, _ := condition["message"].(string)
+		unhealthy = append(unhealthy, fmt.Sprintf("  - %s=%s (%s: %s)", conditionType, status, reason, message))
+	}
+	if len(unhealthy) > 0 {
+		lines = append(lines, "Unhealthy conditions:")
+		lines = append(lines, unhealthy...)
+	} else {
+		lines = append(lines, "Conditions: healthy (Available=True, Degraded=False)")
+	}
+
+	// Sharding: a routeSelector or namespaceSelector means this controller
+	// only admits a subset of routes — a common reason a route is never
+	// admitted anywhere.
+	if routeSelector, found, _ := unstructured.NestedMap(controller.Object, "spec", "routeSelector"); found {
+		lines = append(lines, "Route selector (shard): "+formatSelector(routeSelector))
+	}
+	if namespaceSelector, found, _ := unstructured.NestedMap(controller.Object, "spec", "namespaceSelector"); found {
+		lines = append(lines, "Namespace selector (shard): "+formatSelector(namespaceSelector))
+	}
+
+	// Router pod health.
+	replicas, _, _ := unstructured.NestedInt64(controller.Object, "status", "availableReplicas")
+	pods, err := params.DynamicClient().Resource(podGVR).Namespace(ingressNamespace).List(params.Context, metav1.ListOptions{
+		LabelSelector: "ingresscontroller.operator.openshift.io/deployment-ingresscontroller=" + controller.GetName(),
+	})
+	if err != nil {
+		lines = append(lines, fmt.Sprintf("Router pods: error listing pods: %v", err))
+	} else {
+		running := 0
+		var notRunning []string
+		for j := range pods.Items {
+			pod := &pods.Items[j]
+			phase, _, _ := unstructured.NestedString(pod.Object, "status", "phase")
+			if phase == "Running" {
+				running++
+			} else {
+				notRunning = append(notRunning, fmt.Sprintf("%s (%s)", pod.GetName(), phase))
+			}
+		}
+		podSummary := fmt.Sprintf("Router pods: %d running of %d (available replicas: %d)", running, len(pods.Items), replicas)
+		if len(notRunning) > 0 {
+			podSummary += ", not running: " + strings.Join(notRunning, ", ")
+		}
+		lines = append(lines, podSummary)
+	}
+
+	// Default certificate expiry.
+	certSecret, _, _ := unstructured.NestedString(controller.Object, "spec", "defaultCertificate", "name")
+	if certSecret == "" {
+		certSecret = "router-certs-" + controller.GetName()
+	}
+	lines = append(lines, "Default certificate: "+describeCertificateSecret(params, certSecret))
+
+	return strings.Join(lines, "\n")
+}
+
+// describeCertificateSecret reports the expiry of the tls.crt held in a secret
+// in the openshift-ingress namespace.
+func describeCertificateSecret(params api.ToolHandlerParams, name string) string {
+	secret, err := params.DynamicClient().Resource(secretGVR).Namespace(ingressNamespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Sprintf("secret %s/%s could not be read: %v", ingressNamespace, name, err)
+	}
+	encoded, _, _ := unstructured.NestedString(secret.Object, "data", "tls.crt")
+	if encoded == "" {
+		return fmt.Sprintf("secret %s/%s has no tls.crt key", ingressNamespace, name)
+	}
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Sprintf("secret %s/%s holds an invalid tls.crt: %v", ingressNamespace, name, err)
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return fmt.Sprintf("secret %s/%s tls.crt is not PEM encoded", ingressNamespace, name)
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Sprintf("secret %s/%s tls.crt could not be parsed: %v", ingressNamespace, name, err)
+	}
+	now := time.Now()
+	switch {
+	case now.After(certificate.NotAfter):
+		return fmt.Sprintf("secret %s/%s EXPIRED on %s", ingressNamespace, name, certificate.NotAfter.Format(time.RFC3339))
+	case now.Add(30 * 24 * time.Hour).After(certificate.NotAfter):
+		return fmt.Sprintf("secret %s/%s expires SOON on %s", ingressNamespace, name, certificate.NotAfter.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("secret %s/%s valid until %s", ingressNamespace, name, certificate.NotAfter.Format(time.RFC3339))
+}
+
+func formatSelector(selector map[string]interface{}) string {
+	matchLabels, found, _ := unstructured.NestedStringMap(selector, "matchLabels")
+	if !found || len(matchLabels) == 0 {
+		return fmt.Sprintf("%v", selector)
+	}
+	pairs := make([]string, 0, len(matchLabels))
+	for key, value := range matchLabels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func listRejectedRoutes(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("invalid parameters: %w", err)), nil
+	}
+	routes, err := params.DynamicClient().Resource(routeGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list routes: %w", err)), nil
+	}
+
+	var lines []string
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		ingresses, _, _ := unstructured.NestedSlice(route.Object, "status", "ingress")
+		for _, entry := range ingresses {
+			ingress, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			routerName, _ := ingress["routerName"].(string)
+			conditions, _ := ingress["conditions"].([]interface{})
+			for _, c := range conditions {
+				condition, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				conditionType, _ := condition["type"].(string)
+				status, _ := condition["status"].(string)
+				if conditionType != "Admitted" || status != "False" {
+					continue
+				}
+				reason, _ := condition["reason"].(string)
+				message, _ := condition["message"].(string)
+				line := fmt.Sprintf("- %s/%s rejected by router %q: %s", route.GetNamespace(), route.GetName(), routerName, reason)
+				if message != "" {
+					line += " — " + message
+				}
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	scope := "all namespaces"
+	if namespace != "" {
+		scope = "namespace " + namespace
+	}
+	if len(lines) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No rejected routes in %s (%d route(s) checked)", scope, len(routes.Items)), nil), nil
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Rejected routes in %s (%d):\n%s", scope, len(lines), strings.Join(lines, "\n")), nil), nil
+}
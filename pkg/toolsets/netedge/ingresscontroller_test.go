@@ -0,0 +1,134 @@
+package netedge
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+func (s *NetEdgeTestSuite) newIngressDynamicClient(objs ...runtime.Object) *fake.FakeDynamicClient {
+	scheme := runtime.NewScheme()
+	s.Require().NoError(clientgoscheme.AddToScheme(scheme))
+	listKinds := map[schema.GroupVersionResource]string{
+		ingressControllerGVR: "IngressControllerList",
+		routeGVR:             "RouteList",
+	}
+	return fake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objs...)
+}
+
+func ingressController(name string, conditions []interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "operator.openshift.io/v1",
+			"kind":       "IngressController",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": ingressOperatorNamespace,
+			},
+			"status": map[string]interface{}{
+				"domain":     "apps.example.com",
+				"conditions": conditions,
+			},
+		},
+	}
+}
+
+func routeWithAdmission(namespace, name, status, reason, message string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "route.openshift.io/v1",
+			"kind":       "Route",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"status": map[string]interface{}{
+				"ingress": []interface{}{
+					map[string]interface{}{
+						"routerName": "default",
+						"conditions": []interface{}{
+							map[string]interface{}{
+								"type":    "Admitted",
+								"status":  status,
+								"reason":  reason,
+								"message": message,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *NetEdgeTestSuite) TestGetIngressControllerStatus() {
+	s.Run("healthy controller reports healthy conditions", func() {
+		s.SetDynamicClient(s.newIngressDynamicClient(ingressController("default", []interface{}{
+			map[string]interface{}{"type": "Available", "status": "True"},
+			map[string]interface{}{"type": "Degraded", "status": "False"},
+		})))
+		s.SetArgs(map[string]interface{}{})
+
+		result, err := getIngressControllerStatus(s.params)
+		s.Require().NoError(err)
+		s.Require().Nil(result.Error)
+		s.Contains(result.Content, "# IngressController default")
+		s.Contains(result.Content, "Conditions: healthy")
+	})
+	s.Run("degraded controller reports the condition with reason", func() {
+		s.SetDynamicClient(s.newIngressDynamicClient(ingressController("default", []interface{}{
+			map[string]interface{}{"type": "Degraded", "status": "True", "reason": "DeploymentUnavailable", "message": "no replicas"},
+		})))
+		s.SetArgs(map[string]interface{}{})
+
+		result, err := getIngressControllerStatus(s.params)
+		s.Require().NoError(err)
+		s.Require().Nil(result.Error)
+		s.Contains(result.Content, "Degraded=True (DeploymentUnavailable: no replicas)")
+	})
+	s.Run("named controller that does not exist returns error", func() {
+		s.SetDynamicClient(s.newIngressDynamicClient())
+		s.SetArgs(map[string]interface{}{"name": "missing"})
+
+		result, err := getIngressControllerStatus(s.params)
+		s.Require().NoError(err)
+		s.Require().NotNil(result.Error)
+		s.Contains(result.Error.Error(), "failed to get ingresscontroller missing")
+	})
+	s.Run("no controllers found", func() {
+		s.SetDynamicClient(s.newIngressDynamicClient())
+		s.SetArgs(map[string]interface{}{})
+
+		result, err := getIngressControllerStatus(s.params)
+		s.Require().NoError(err)
+		s.Require().Nil(result.Error)
+		s.Contains(result.Content, "No ingresscontrollers found")
+	})
+}
+
+func (s *NetEdgeTestSuite) TestListRejectedRoutes() {
+	s.Run("rejected route is reported with reason and message", func() {
+		s.SetDynamicClient(s.newIngressDynamicClient(
+			routeWithAdmission("default", "claimed", "False", "HostAlreadyClaimed", "host is claimed by another route"),
+			routeWithAdmission("default", "healthy", "True", "", ""),
+		))
+		s.SetArgs(map[string]interface{}{})
+
+		result, err := listRejectedRoutes(s.params)
+		s.Require().NoError(err)
+		s.Require().Nil(result.Error)
+		s.Contains(result.Content, `default/claimed rejected by router "default": HostAlreadyClaimed`)
+		s.NotContains(result.Content, "default/healthy")
+	})
+	s.Run("no rejected routes", func() {
+		s.SetDynamicClient(s.newIngressDynamicClient(routeWithAdmission("default", "healthy", "True", "", "")))
+		s.SetArgs(map[string]interface{}{"namespace": "default"})
+
+		result, err := listRejectedRoutes(s.params)
+		s.Require().NoError(err)
+		s.Require().Nil(result.Error)
+		s.Contains(result.Content, "No rejected routes in namespace default (1 route(s) checked)")
+	})
+}
@@ -29,7 +29,9 @@ func (t *Toolset) GetTools(_ api.FilteringProvider) []api.ServerTool {
 		initProbeDNSLocal(),
 		initProbeHTTP(),
 		initRoutes(),
+		initCanary(),
 		initExecDNSInPod(),
+		initIngressController(),
 		initRouter(),
 	)
 }
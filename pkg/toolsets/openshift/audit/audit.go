@@ -0,0 +1,270 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+var nodesGVR = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}
+
+const (
+	// controlPlaneLabel selects the nodes that run the API servers and hold
+	// their audit logs.
+	controlPlaneLabel = "node-role.kubernetes.io/master"
+	// auditLogQuery is the node log query for the kube-apiserver audit log,
+	// relative to /var/log on the control plane node.
+	auditLogQuery = "kube-apiserver/audit.log"
+	// defaultLimit caps the number of events returned per call.
+	defaultLimit = 50
+)
+
+// auditEvent is the subset of the Kubernetes audit event schema the tool
+// filters and renders.
+type auditEvent struct {
+	Stage string `json:"stage"`
+	Verb  string `json:"verb"`
+	User  struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	ObjectRef struct {
+		Resource  string `json:"resource"`
+		Namespace string `json:"namespace"`
+		Name      string `json:"name"`
+	} `json:"objectRef"`
+	ResponseStatus struct {
+		Code int `json:"code"`
+	} `json:"responseStatus"`
+	RequestReceivedTimestamp string `json:"requestReceivedTimestamp"`
+}
+
+// Tools returns the API server audit log tools.
+func Tools(_ api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "audit_logs_query",
+				Description: "Query the kube-apiserver audit logs on the control plane nodes for events matching a user, resource, verb, or object name " +
+					"within a time window, to answer questions like who deleted a Deployment. Requires audit logging to be enabled (it is by default on OpenShift) " +
+					"and permission to read node logs",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"user": {
+							Type:        "string",
+							Description: "Username to filter by, e.g. system:serviceaccount:ns:sa or a user's name (Optional)",
+						},
+						"resource": {
+							Type:        "string",
+							Description: `Resource (plural, lowercase) to filter by, e.g. "deployments" (Optional)`,
+						},
+						"verb": {
+							Type:        "string",
+							Description: `Verb to filter by, e.g. "delete", "create", "patch" (Optional)`,
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the object to filter by (Optional)",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the object to filter by (Optional)",
+						},
+						"since": {
+							Type:        "string",
+							Description: "Only include events received at or after this RFC3339 timestamp (Optional)",
+						},
+						"until": {
+							Type:        "string",
+							Description: "Only include events received at or before this RFC3339 timestamp (Optional)",
+						},
+						"node": {
+							Type:        "string",
+							Description: "Query a single control plane node instead of all of them (Optional)",
+						},
+						"limit": {
+							Type:        "integer",
+							Description: "Maximum number of events to return (Optional, 50 by default, newest events are kept)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Audit: Logs Query",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: logsQuery,
+		},
+	}
+}
+
+func logsQuery(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	filter := auditFilter{
+		user:      p.OptionalString("user", ""),
+		resource:  p.OptionalString("resource", ""),
+		verb:      p.OptionalString("verb", ""),
+		name:      p.OptionalString("name", ""),
+		namespace: p.OptionalString("namespace", ""),
+	}
+	since := p.OptionalString("since", "")
+	until := p.OptionalString("until", "")
+	node := p.OptionalString("node", "")
+	limit := p.OptionalInt64("limit", defaultLimit)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to query the audit logs: %w", err)), nil
+	}
+	var err error
+	if filter.since, err = parseTimestamp(since, "since"); err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	if filter.until, err = parseTimestamp(until, "until"); err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+
+	nodes := []string{node}
+	if node == "" {
+		if nodes, err = controlPlaneNodes(params); err != nil {
+			return api.NewToolCallResult("", err), nil
+		}
+	}
+
+	core := kubernetes.NewCore(params)
+	var events []string
+	var unreadable []string
+	for _, nodeName := range nodes {
+		log, err := core.NodesLog(params.Context, nodeName, auditLogQuery, 0)
+		if err != nil {
+			unreadable = append(unreadable, fmt.Sprintf("- %s: %v", nodeName, err))
+			continue
+		}
+		events = append(events, filterEvents(log, filter)...)
+	}
+	sort.Strings(events)
+	if int64(len(events)) > limit {
+		events = events[int64(len(events))-limit:]
+	}
+
+	var sections []string
+	if len(events) > 0 {
+		sections = append(sections, fmt.Sprintf("Matching audit events (%d, oldest first):\n%s", len(events), strings.Join(events, "\n")))
+	} else {
+		sections = append(sections, "No audit events match the filter; widen the time window or check that the event is still within the log rotation period")
+	}
+	if len(unreadable) > 0 {
+		sections = append(sections, fmt.Sprintf("Audit logs could not be read from %d node(s):\n%s", len(unreadable), strings.Join(unreadable, "\n")))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+type auditFilter struct {
+	user      string
+	resource  string
+	verb      string
+	name      string
+	namespace string
+	since     time.Time
+	until     time.Time
+}
+
+func parseTimestamp(value, field string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse %s as an RFC3339 timestamp: %w", field, err)
+	}
+	return timestamp, nil
+}
+
+func controlPlaneNodes(params api.ToolHandlerParams) ([]string, error) {
+	list, err := params.DynamicClient().Resource(nodesGVR).List(params.Context, metav1.ListOptions{LabelSelector: controlPlaneLabel})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the control plane nodes: %w", err)
+	}
+	if len(list.Items) == 0 {
+		return nil, fmt.Errorf("no control plane nodes found (label %s); provide the node parameter explicitly", controlPlaneLabel)
+	}
+	nodes := make([]string, 0, len(list.Items))
+	for i := range list.Items {
+		nodes = append(nodes, list.Items[i].GetName())
+	}
+	return nodes, nil
+}
+
+// filterEvents parses the JSON-lines audit log and renders the events that
+// pass the filter. Only ResponseComplete events are kept so each request
+// appears once.
+func filterEvents(log string, filter auditFilter) []string {
+	var lines []string
+	for _, line := range strings.Split(log, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || !strings.HasPrefix(line, "{") {
+			continue
+		}
+		var event auditEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			continue
+		}
+		if !filter.matches(&event) {
+			continue
+		}
+		reference := event.ObjectRef.Resource
+		if event.ObjectRef.Namespace != "" {
+			reference += " " + event.ObjectRef.Namespace + "/" + event.ObjectRef.Name
+		} else if event.ObjectRef.Name != "" {
+			reference += " " + event.ObjectRef.Name
+		}
+		lines = append(lines, fmt.Sprintf("- %s %s %s %s (HTTP %d)",
+			event.RequestReceivedTimestamp, event.User.Username, event.Verb, reference, event.ResponseStatus.Code))
+	}
+	return lines
+}
+
+func (f auditFilter) matches(event *auditEvent) bool {
+	if event.Stage != "ResponseComplete" {
+		return false
+	}
+	if f.user != "" && event.User.Username != f.user {
+		return false
+	}
+	if f.resource != "" && event.ObjectRef.Resource != f.resource {
+		return false
+	}
+	if f.verb != "" && event.Verb != f.verb {
+		return false
+	}
+	if f.name != "" && event.ObjectRef.Name != f.name {
+		return false
+	}
+	if f.namespace != "" && event.ObjectRef.Namespace != f.namespace {
+		return false
+	}
+	if !f.since.IsZero() || !f.until.IsZero() {
+		timestamp, err := time.Parse(time.RFC3339, event.RequestReceivedTimestamp)
+		if err != nil {
+			return false
+		}
+		if !f.since.IsZero() && timestamp.Before(f.since) {
+			return false
+		}
+		if !f.until.IsZero() && timestamp.After(f.until) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,140 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var consoleRouteGVR = schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+
+// Tools returns the OpenShift web console deep-link and notification tools,
+// filtered out on clusters without the corresponding APIs.
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	return append([]api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "console_links",
+				Description: "Return OpenShift web console URLs for a given resource: the details page, plus the YAML, events, logs, metrics, and terminal views where applicable, " +
+					"so users can be handed a clickable link into the console",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"apiVersion": {
+							Type:        "string",
+							Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1)",
+						},
+						"kind": {
+							Type:        "string",
+							Description: "kind of the resource (examples of valid kind are: Pod, Deployment)",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the resource (Optional, links to the resource list view if not provided)",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the resource (Optional, current namespace if not provided for namespaced resources)",
+						},
+					},
+					Required: []string{"apiVersion", "kind"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Console: Links",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: consoleLinks,
+			TargetCompatibilityFilters: []func() bool{
+				func() bool {
+					return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+						{Group: "route.openshift.io", Version: "v1", Kind: "Route"},
+					})
+				},
+			},
+		},
+	}, notificationTools(p)...)
+}
+
+func consoleLinks(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	apiVersion := p.RequiredString("apiVersion")
+	kind := p.RequiredString("kind")
+	name := p.OptionalString("name", "")
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to generate console links: %w", err)), nil
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to generate console links: invalid argument apiVersion")), nil
+	}
+	gvk := schema.GroupVersionKind{Group: gv.Group, Version: gv.Version, Kind: kind}
+
+	consoleURL, err := consoleBaseURL(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to generate console links: could not detect the console route: %w", err)), nil
+	}
+
+	mapping, err := params.RESTMapper().RESTMapping(schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}, gvk.Version)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to generate console links: %w", err)), nil
+	}
+	// The console addresses core resources by their plural name and
+	// group resources by the group~version~Kind reference.
+	reference := mapping.Resource.Resource
+	if gvk.Group != "" {
+		reference = fmt.Sprintf("%s~%s~%s", gvk.Group, gvk.Version, gvk.Kind)
+	}
+
+	var base string
+	if mapping.Scope.Name() == "namespace" {
+		namespace = params.NamespaceOrDefault(namespace)
+		base = fmt.Sprintf("%s/k8s/ns/%s/%s", consoleURL, namespace, reference)
+	} else {
+		base = fmt.Sprintf("%s/k8s/cluster/%s", consoleURL, reference)
+	}
+
+	if name == "" {
+		return api.NewToolCallResult(fmt.Sprintf("Console link for the %s list view:\n%s", kind, base), nil), nil
+	}
+	lines := []string{
+		fmt.Sprintf("Console links for %s/%s:", kind, name),
+		"- Details: " + base + "/" + name,
+		"- YAML: " + base + "/" + name + "/yaml",
+		"- Events: " + base + "/" + name + "/events",
+	}
+	if kind == "Pod" {
+		lines = append(lines,
+			"- Logs: "+base+"/"+name+"/logs",
+			"- Metrics: "+base+"/"+name+"/metrics",
+			"- Terminal: "+base+"/"+name+"/terminal",
+		)
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
+
+// consoleBaseURL detects the console URL from the openshift-console route.
+func consoleBaseURL(params api.ToolHandlerParams) (string, error) {
+	route, err := params.DynamicClient().Resource(consoleRouteGVR).
+		Namespace("openshift-console").Get(params.Context, "console", metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+	if host == "" {
+		return "", fmt.Errorf("console route has no host")
+	}
+	return "https://" + host, nil
+}
@@ -0,0 +1,209 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var consoleNotificationsGVR = schema.GroupVersionResource{Group: "console.openshift.io", Version: "v1", Resource: "consolenotifications"}
+
+// notificationTools returns the ConsoleNotification banner tools, filtered
+// out on clusters without the console API.
+func notificationTools(p api.FilteringProvider) []api.ServerTool {
+	filters := []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "console.openshift.io", Version: "v1", Kind: "ConsoleNotification"},
+			})
+		},
+	}
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "console_notifications_list",
+				Description: "List the ConsoleNotification banners currently shown to the users of the OpenShift web console",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Console: Notifications List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    consoleNotificationsList,
+			TargetCompatibilityFilters: filters,
+		},
+		{
+			Tool: api.Tool{
+				Name: "console_notification_create",
+				Description: "Create a ConsoleNotification banner shown to every user of the OpenShift web console, " +
+					"e.g. to announce a maintenance window. An existing notification with the same name is updated",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the ConsoleNotification",
+						},
+						"text": {
+							Type:        "string",
+							Description: "Text of the banner",
+						},
+						"location": {
+							Type:        "string",
+							Description: "Where the banner is shown (Optional, BannerTop by default)",
+							Enum:        []any{"BannerTop", "BannerBottom", "BannerTopBottom"},
+						},
+						"color": {
+							Type:        "string",
+							Description: "CSS color of the banner text (Optional, e.g. #fff)",
+						},
+						"backgroundColor": {
+							Type:        "string",
+							Description: "CSS background color of the banner (Optional, e.g. #a30000)",
+						},
+						"linkText": {
+							Type:        "string",
+							Description: "Text of an optional link shown in the banner (Optional, used with linkHref)",
+						},
+						"linkHref": {
+							Type:        "string",
+							Description: "URL of an optional link shown in the banner (Optional, used with linkText)",
+						},
+					},
+					Required: []string{"name", "text"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Console: Notification Create",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    consoleNotificationCreate,
+			TargetCompatibilityFilters: filters,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "console_notification_delete",
+				Description: "Delete a ConsoleNotification banner so it is no longer shown in the OpenShift web console",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the ConsoleNotification to delete",
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Console: Notification Delete",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(true),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    consoleNotificationDelete,
+			TargetCompatibilityFilters: filters,
+		},
+	}
+}
+
+func consoleNotificationsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	notifications, err := params.DynamicClient().Resource(consoleNotificationsGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the console notifications: %w", err)), nil
+	}
+	if len(notifications.Items) == 0 {
+		return api.NewToolCallResult("No ConsoleNotification banners are currently shown", nil), nil
+	}
+	var lines []string
+	for i := range notifications.Items {
+		notification := &notifications.Items[i]
+		text, _, _ := unstructured.NestedString(notification.Object, "spec", "text")
+		location, _, _ := unstructured.NestedString(notification.Object, "spec", "location")
+		if location == "" {
+			location = "BannerTop"
+		}
+		lines = append(lines, fmt.Sprintf("- %s (%s): %s", notification.GetName(), location, text))
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("ConsoleNotification banners (%d):\n%s", len(notifications.Items), strings.Join(lines, "\n")), nil), nil
+}
+
+func consoleNotificationCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	text := p.RequiredString("text")
+	location := p.OptionalString("location", "BannerTop")
+	color := p.OptionalString("color", "")
+	backgroundColor := p.OptionalString("backgroundColor", "")
+	linkText := p.OptionalString("linkText", "")
+	linkHref := p.OptionalString("linkHref", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create the console notification: %w", err)), nil
+	}
+
+	spec := map[string]any{
+		"text":     text,
+		"location": location,
+	}
+	if color != "" {
+		spec["color"] = color
+	}
+	if backgroundColor != "" {
+		spec["backgroundColor"] = backgroundColor
+	}
+	if linkText != "" && linkHref != "" {
+		spec["link"] = map[string]any{"text": linkText, "href": linkHref}
+	}
+
+	client := params.DynamicClient().Resource(consoleNotificationsGVR)
+	existing, err := client.Get(params.Context, name, metav1.GetOptions{})
+	if err == nil {
+		existing.Object["spec"] = spec
+		if _, err := client.Update(params.Context, existing, metav1.UpdateOptions{}); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to update ConsoleNotification %s: %w", name, err)), nil
+		}
+		return api.NewToolCallResult(fmt.Sprintf("Updated ConsoleNotification %s; the console now shows: %s", name, text), nil), nil
+	}
+
+	notification := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "console.openshift.io/v1",
+		"kind":       "ConsoleNotification",
+		"metadata":   map[string]any{"name": name},
+		"spec":       spec,
+	}}
+	if _, err := client.Create(params.Context, notification, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create ConsoleNotification %s: %w", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Created ConsoleNotification %s; the console now shows: %s", name, text), nil), nil
+}
+
+func consoleNotificationDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to delete the console notification: %w", err)), nil
+	}
+	if err := params.DynamicClient().Resource(consoleNotificationsGVR).Delete(params.Context, name, metav1.DeleteOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to delete ConsoleNotification %s: %w", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Deleted ConsoleNotification %s; the banner is no longer shown", name), nil), nil
+}
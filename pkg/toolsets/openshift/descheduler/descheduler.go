@@ -0,0 +1,302 @@
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	kubeDeschedulersGVR = schema.GroupVersionResource{Group: "operator.openshift.io", Version: "v1", Resource: "kubedeschedulers"}
+	nodesGVR            = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}
+	podsGVR             = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
+)
+
+// zoneLabel is the well-known topology label used for the zone skew report.
+const zoneLabel = "topology.kubernetes.io/zone"
+
+// knownProfiles are the descheduling profiles the Kube Descheduler Operator
+// accepts in spec.profiles.
+var knownProfiles = []string{
+	"AffinityAndTaints",
+	"TopologyAndDuplicates",
+	"SoftTopologyAndDuplicates",
+	"LifecycleAndUtilization",
+	"EvictPodsWithLocalStorage",
+	"EvictPodsWithPVC",
+	"LongLifecycle",
+	"CompactAndScale",
+}
+
+// deschedulerAPIFilter hides the operator tools on clusters without the Kube
+// Descheduler Operator.
+func deschedulerAPIFilter(p api.FilteringProvider) []func() bool {
+	return []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "operator.openshift.io", Version: "v1", Kind: "KubeDescheduler"},
+			})
+		},
+	}
+}
+
+// Tools returns the descheduler and pod rebalancing tools. The imbalance
+// report works on any cluster; the profile tools are filtered out on clusters
+// without the Kube Descheduler Operator.
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "descheduler_status",
+				Description: "Get the Kube Descheduler Operator configuration: the enabled descheduling profiles, mode (Automatic or Predictive), " +
+					"descheduling interval, and operator conditions",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Descheduler: Status",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    deschedulerStatus,
+			TargetCompatibilityFilters: deschedulerAPIFilter(p),
+		},
+		{
+			Tool: api.Tool{
+				Name: "descheduler_profiles_set",
+				Description: "Set the descheduling profiles of the Kube Descheduler Operator and optionally its mode. " +
+					"Known profiles: " + strings.Join(knownProfiles, ", ") + ". " +
+					"Use mode Predictive to only report the pods the descheduler would evict before switching to Automatic",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"profiles": {
+							Type:        "array",
+							Description: "Descheduling profiles to enable, replacing the current set",
+							Items:       &jsonschema.Schema{Type: "string"},
+						},
+						"mode": {
+							Type:        "string",
+							Description: "Descheduler mode: Predictive (report only) or Automatic (evict pods) (Optional, keeps the current mode)",
+							Enum:        []any{"Predictive", "Automatic"},
+						},
+					},
+					Required: []string{"profiles"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Descheduler: Profiles Set",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(true),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    deschedulerProfilesSet,
+			TargetCompatibilityFilters: deschedulerAPIFilter(p),
+		},
+		{
+			Tool: api.Tool{
+				Name: "descheduler_imbalance_report",
+				Description: "Report pod distribution imbalance across the cluster: running pods per node with the deviation from the mean, and pod counts " +
+					"per topology zone, as input for recommending descheduling profiles or topology spread constraints",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Descheduler: Imbalance Report",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: imbalanceReport,
+		},
+	}
+}
+
+func deschedulerStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	list, err := params.DynamicClient().Resource(kubeDeschedulersGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the descheduler status: %w", err)), nil
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult("The Kube Descheduler Operator is installed but no KubeDescheduler resource exists; no descheduling is configured", nil), nil
+	}
+	var sections []string
+	for i := range list.Items {
+		descheduler := &list.Items[i]
+		var lines []string
+		profiles, _, _ := unstructured.NestedStringSlice(descheduler.Object, "spec", "profiles")
+		if len(profiles) == 0 {
+			lines = append(lines, "- profiles: none enabled")
+		} else {
+			lines = append(lines, "- profiles: "+strings.Join(profiles, ", "))
+		}
+		if mode, _, _ := unstructured.NestedString(descheduler.Object, "spec", "mode"); mode != "" {
+			lines = append(lines, "- mode: "+mode)
+		}
+		if interval, found, _ := unstructured.NestedInt64(descheduler.Object, "spec", "deschedulingIntervalSeconds"); found {
+			lines = append(lines, fmt.Sprintf("- descheduling interval: %ds", interval))
+		}
+		conditions, _, _ := unstructured.NestedSlice(descheduler.Object, "status", "conditions")
+		for _, raw := range conditions {
+			condition, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			conditionType, _ := condition["type"].(string)
+			status, _ := condition["status"].(string)
+			lines = append(lines, fmt.Sprintf("- condition %s: %s", conditionType, status))
+		}
+		sections = append(sections, fmt.Sprintf("KubeDescheduler %s/%s:\n%s", descheduler.GetNamespace(), descheduler.GetName(), strings.Join(lines, "\n")))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+func deschedulerProfilesSet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	mode := p.OptionalString("mode", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set descheduler profiles: %w", err)), nil
+	}
+	profiles, err := requiredStringSlice(params, "profiles")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set descheduler profiles: %w", err)), nil
+	}
+	for _, profile := range profiles {
+		if !slices.Contains(knownProfiles, profile) {
+			return api.NewToolCallResult("", fmt.Errorf("failed to set descheduler profiles: unknown profile %s (known profiles: %s)", profile, strings.Join(knownProfiles, ", "))), nil
+		}
+	}
+
+	list, err := params.DynamicClient().Resource(kubeDeschedulersGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set descheduler profiles: %w", err)), nil
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("no KubeDescheduler resource exists; install the Kube Descheduler Operator and create its KubeDescheduler resource first")), nil
+	}
+	descheduler := &list.Items[0]
+
+	if err := unstructured.SetNestedStringSlice(descheduler.Object, profiles, "spec", "profiles"); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set descheduler profiles: %w", err)), nil
+	}
+	if mode != "" {
+		if err := unstructured.SetNestedField(descheduler.Object, mode, "spec", "mode"); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to set descheduler mode: %w", err)), nil
+		}
+	}
+	if _, err := params.DynamicClient().Resource(kubeDeschedulersGVR).Namespace(descheduler.GetNamespace()).Update(params.Context, descheduler, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update KubeDescheduler %s: %w", descheduler.GetName(), err)), nil
+	}
+	result := fmt.Sprintf("KubeDescheduler %s/%s now enables the profiles: %s", descheduler.GetNamespace(), descheduler.GetName(), strings.Join(profiles, ", "))
+	if mode != "" {
+		result += fmt.Sprintf("\nMode set to %s", mode)
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
+
+func imbalanceReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	nodes, err := params.DynamicClient().Resource(nodesGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list nodes: %w", err)), nil
+	}
+	pods, err := params.DynamicClient().Resource(podsGVR).List(params.Context, metav1.ListOptions{FieldSelector: "status.phase=Running"})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list running pods: %w", err)), nil
+	}
+
+	podsPerNode := map[string]int{}
+	nodeZones := map[string]string{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		podsPerNode[node.GetName()] = 0
+		nodeZones[node.GetName()] = node.GetLabels()[zoneLabel]
+	}
+	for i := range pods.Items {
+		nodeName, _, _ := unstructured.NestedString(pods.Items[i].Object, "spec", "nodeName")
+		if _, known := podsPerNode[nodeName]; known {
+			podsPerNode[nodeName]++
+		}
+	}
+	if len(podsPerNode) == 0 {
+		return api.NewToolCallResult("The cluster has no nodes to balance pods across", nil), nil
+	}
+
+	mean := float64(len(pods.Items)) / float64(len(podsPerNode))
+	nodeNames := make([]string, 0, len(podsPerNode))
+	for nodeName := range podsPerNode {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Slice(nodeNames, func(i, j int) bool {
+		if podsPerNode[nodeNames[i]] != podsPerNode[nodeNames[j]] {
+			return podsPerNode[nodeNames[i]] > podsPerNode[nodeNames[j]]
+		}
+		return nodeNames[i] < nodeNames[j]
+	})
+	var nodeLines []string
+	podsPerZone := map[string]int{}
+	for _, nodeName := range nodeNames {
+		count := podsPerNode[nodeName]
+		nodeLines = append(nodeLines, fmt.Sprintf("- %s: %d pod(s) (%+.1f from the mean)", nodeName, count, float64(count)-mean))
+		if zone := nodeZones[nodeName]; zone != "" {
+			podsPerZone[zone] += count
+		}
+	}
+	report := fmt.Sprintf("Pod distribution across %d node(s), %d running pod(s), mean %.1f pods/node:\n%s",
+		len(podsPerNode), len(pods.Items), mean, strings.Join(nodeLines, "\n"))
+
+	if len(podsPerZone) > 1 {
+		zones := make([]string, 0, len(podsPerZone))
+		for zone := range podsPerZone {
+			zones = append(zones, zone)
+		}
+		sort.Strings(zones)
+		minCount, maxCount := -1, 0
+		var zoneLines []string
+		for _, zone := range zones {
+			count := podsPerZone[zone]
+			zoneLines = append(zoneLines, fmt.Sprintf("- %s: %d pod(s)", zone, count))
+			if minCount < 0 || count < minCount {
+				minCount = count
+			}
+			if count > maxCount {
+				maxCount = count
+			}
+		}
+		report += fmt.Sprintf("\n\nPods per zone (%s), skew %d:\n%s", zoneLabel, maxCount-minCount, strings.Join(zoneLines, "\n"))
+	}
+	return api.NewToolCallResult(report, nil), nil
+}
+
+// requiredStringSlice extracts a required []string tool argument. JSON-decoded
+// arguments arrive as []any, so each element is asserted to string.
+func requiredStringSlice(params api.ToolHandlerParams, key string) ([]string, error) {
+	raw, ok := params.GetArguments()[key].([]any)
+	if !ok || len(raw) == 0 {
+		return nil, fmt.Errorf("%s parameter is required and must be a non-empty array of strings", key)
+	}
+	out := make([]string, 0, len(raw))
+	for i, v := range raw {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] must be a string", key, i)
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
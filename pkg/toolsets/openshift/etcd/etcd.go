@@ -0,0 +1,236 @@
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var etcdBackupsGVR = schema.GroupVersionResource{Group: "operator.openshift.io", Version: "v1alpha1", Resource: "etcdbackups"}
+
+// defaultMaxAgeHours is how old the most recent backup may be before
+// etcd_backup_verify reports a problem.
+const defaultMaxAgeHours = 24
+
+// Tools returns the etcd backup tools, filtered out on clusters without the
+// EtcdBackup API (TechPreview on older versions).
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	filters := []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "operator.openshift.io", Version: "v1alpha1", Kind: "EtcdBackup"},
+			})
+		},
+	}
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "etcd_backup_trigger",
+				Description: "Trigger a one-time etcd backup by creating an EtcdBackup resource; the cluster-etcd-operator runs the cluster-backup job " +
+					"and stores the snapshot on the given PersistentVolumeClaim in the openshift-etcd namespace",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"pvcName": {
+							Type:        "string",
+							Description: "Name of the PersistentVolumeClaim in the openshift-etcd namespace to store the backup on",
+						},
+					},
+					Required: []string{"pvcName"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "etcd: Backup Trigger",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    backupTrigger,
+			TargetCompatibilityFilters: filters,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "etcd_backups_list",
+				Description: "List the EtcdBackup resources of the cluster with their completion state and age, newest first",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "etcd: Backups List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    backupsList,
+			TargetCompatibilityFilters: filters,
+		},
+		{
+			Tool: api.Tool{
+				Name: "etcd_backup_verify",
+				Description: "Verify the control plane disaster-recovery posture: check that the most recent completed etcd backup is younger than " +
+					"the given maximum age and report failed backups",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"maxAgeHours": {
+							Type:        "integer",
+							Description: "Maximum acceptable age of the most recent completed backup in hours (Optional, 24 by default)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "etcd: Backup Verify",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    backupVerify,
+			TargetCompatibilityFilters: filters,
+		},
+	}
+}
+
+func backupTrigger(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	pvcName := p.RequiredString("pvcName")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to trigger the etcd backup: %w", err)), nil
+	}
+	backup := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "operator.openshift.io/v1alpha1",
+		"kind":       "EtcdBackup",
+		"metadata":   map[string]any{"generateName": "backup-"},
+		"spec":       map[string]any{"pvcName": pvcName},
+	}}
+	created, err := params.DynamicClient().Resource(etcdBackupsGVR).Create(params.Context, backup, metav1.CreateOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create the EtcdBackup: %w", err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Created EtcdBackup %s; the cluster-etcd-operator runs the backup job in the openshift-etcd namespace and stores the snapshot on PVC %s. "+
+		"Use etcd_backups_list to follow its completion", created.GetName(), pvcName), nil), nil
+}
+
+// backupState summarizes one EtcdBackup for listing and verification.
+type backupState struct {
+	name      string
+	completed bool
+	failed    bool
+	message   string
+	created   time.Time
+}
+
+func backupStates(params api.ToolHandlerParams) ([]backupState, error) {
+	backups, err := params.DynamicClient().Resource(etcdBackupsGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the EtcdBackups: %w", err)
+	}
+	states := make([]backupState, 0, len(backups.Items))
+	for i := range backups.Items {
+		backup := &backups.Items[i]
+		state := backupState{
+			name:    backup.GetName(),
+			created: backup.GetCreationTimestamp().Time,
+		}
+		conditions, _, _ := unstructured.NestedSlice(backup.Object, "status", "conditions")
+		for _, raw := range conditions {
+			condition, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			conditionType, _ := condition["type"].(string)
+			status, _ := condition["status"].(string)
+			message, _ := condition["message"].(string)
+			if conditionType == "BackupCompleted" && status == "True" {
+				state.completed = true
+				state.message = message
+			}
+			if conditionType == "BackupFailed" && status == "True" {
+				state.failed = true
+				state.message = message
+			}
+		}
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].created.After(states[j].created) })
+	return states, nil
+}
+
+func backupsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	states, err := backupStates(params)
+	if err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+	if len(states) == 0 {
+		return api.NewToolCallResult("No EtcdBackup resources found; no etcd backups were taken through the cluster-backup mechanism", nil), nil
+	}
+	var lines []string
+	for _, state := range states {
+		status := "in progress"
+		switch {
+		case state.failed:
+			status = "failed"
+		case state.completed:
+			status = "completed"
+		}
+		line := fmt.Sprintf("- %s: %s, %s old", state.name, status, time.Since(state.created).Round(time.Minute))
+		if state.message != "" {
+			line += " (" + state.message + ")"
+		}
+		lines = append(lines, line)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("EtcdBackups (%d, newest first):\n%s", len(states), strings.Join(lines, "\n")), nil), nil
+}
+
+func backupVerify(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	maxAgeHours := p.OptionalInt64("maxAgeHours", defaultMaxAgeHours)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to verify the etcd backups: %w", err)), nil
+	}
+	states, err := backupStates(params)
+	if err != nil {
+		return api.NewToolCallResult("", err), nil
+	}
+
+	var failed int
+	for _, state := range states {
+		if state.failed {
+			failed++
+		}
+	}
+	var lines []string
+	if failed > 0 {
+		lines = append(lines, fmt.Sprintf("- %d backup(s) failed; check them with etcd_backups_list", failed))
+	}
+
+	maxAge := time.Duration(maxAgeHours) * time.Hour
+	for _, state := range states {
+		if !state.completed {
+			continue
+		}
+		age := time.Since(state.created)
+		if age > maxAge {
+			lines = append(lines, fmt.Sprintf("- [PROBLEM] the most recent completed backup %s is %s old, which exceeds the maximum age of %dh; trigger a fresh backup", state.name, age.Round(time.Minute), maxAgeHours))
+		} else {
+			lines = append(lines, fmt.Sprintf("- the most recent completed backup %s is %s old, within the maximum age of %dh", state.name, age.Round(time.Minute), maxAgeHours))
+		}
+		return api.NewToolCallResult("etcd backup verification:\n"+strings.Join(lines, "\n"), nil), nil
+	}
+	lines = append(lines, "- [PROBLEM] no completed etcd backup exists; trigger one with etcd_backup_trigger")
+	return api.NewToolCallResult("etcd backup verification:\n"+strings.Join(lines, "\n"), nil), nil
+}
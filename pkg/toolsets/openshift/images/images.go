@@ -0,0 +1,284 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	podsGVR = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
+	// Sigstore image policies went GA in config.openshift.io/v1; older
+	// clusters only serve the v1alpha1 TechPreview versions, so both are
+	// tried in order.
+	clusterImagePolicyGVRs = []schema.GroupVersionResource{
+		{Group: "config.openshift.io", Version: "v1", Resource: "clusterimagepolicies"},
+		{Group: "config.openshift.io", Version: "v1alpha1", Resource: "clusterimagepolicies"},
+	}
+	imagePolicyGVRs = []schema.GroupVersionResource{
+		{Group: "config.openshift.io", Version: "v1", Resource: "imagepolicies"},
+		{Group: "config.openshift.io", Version: "v1alpha1", Resource: "imagepolicies"},
+	}
+)
+
+// imagePolicy is a flattened (Cluster)ImagePolicy: the scopes it covers and
+// where its root of trust comes from.
+type imagePolicy struct {
+	name        string
+	scopes      []string
+	rootOfTrust string
+}
+
+// workloadImage is one image reference in use by a pod container, together
+// with the digest the runtime actually resolved it to.
+type workloadImage struct {
+	image  string
+	digest string
+	pods   []string
+}
+
+// imagesAPIFilter hides the tool on non-OpenShift clusters: signature
+// enforcement is driven by the OpenShift image policy APIs.
+func imagesAPIFilter(p api.FilteringProvider) []func() bool {
+	return []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "config.openshift.io", Version: "v1", Kind: "ClusterVersion"},
+			})
+		},
+	}
+}
+
+// Tools returns the workload image provenance tool, filtered out on
+// non-OpenShift clusters.
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "image_provenance_check",
+				Description: "Check the provenance of the container images running in a namespace: list the images with the digests the runtime resolved them to, " +
+					"match each image against the cluster's sigstore signature policies (ClusterImagePolicy and the namespace's ImagePolicy resources, enforced by CRI-O at pull time), " +
+					"and report images that no signature policy covers or whose digest could not be resolved",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace whose running workload images to check",
+						},
+					},
+					Required: []string{"namespace"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Image: Provenance Check",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    imageProvenanceCheck,
+			TargetCompatibilityFilters: imagesAPIFilter(p),
+		},
+	}
+}
+
+func imageProvenanceCheck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check image provenance: %w", err)), nil
+	}
+
+	images, err := workloadImages(params, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)), nil
+	}
+	if len(images) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No container images are running in namespace %s", namespace), nil), nil
+	}
+
+	policies, policyErr := signaturePolicies(params, namespace)
+
+	var covered, uncovered, unresolved []string
+	for _, image := range images {
+		line := fmt.Sprintf("- %s (pods: %s)", image.image, strings.Join(image.pods, ", "))
+		if image.digest != "" {
+			line = fmt.Sprintf("- %s\n  digest: %s (pods: %s)", image.image, image.digest, strings.Join(image.pods, ", "))
+		} else {
+			unresolved = append(unresolved, line+"\n  the runtime reported no digest for this image, so its content cannot be pinned or verified")
+			continue
+		}
+		if policy := matchingPolicy(policies, image.image); policy != nil {
+			covered = append(covered, line+fmt.Sprintf("\n  signature policy: %s (root of trust: %s)", policy.name, policy.rootOfTrust))
+		} else {
+			uncovered = append(uncovered, line)
+		}
+	}
+
+	var sections []string
+	if len(unresolved) > 0 {
+		sections = append(sections, fmt.Sprintf("UNRESOLVED (%d) — no digest, provenance unknown:\n%s", len(unresolved), strings.Join(unresolved, "\n")))
+	}
+	if len(uncovered) > 0 {
+		sections = append(sections, fmt.Sprintf("UNVERIFIED (%d) — no sigstore signature policy covers these images, they are pulled without signature verification:\n%s", len(uncovered), strings.Join(uncovered, "\n")))
+	}
+	if len(covered) > 0 {
+		sections = append(sections, fmt.Sprintf("VERIFIED (%d) — covered by a signature policy; CRI-O rejects these images at pull time unless their cosign/sigstore signature matches:\n%s", len(covered), strings.Join(covered, "\n")))
+	}
+	report := fmt.Sprintf("Image provenance for namespace %s (%d image(s)):\n\n%s", namespace, len(images), strings.Join(sections, "\n\n"))
+	if policyErr != nil {
+		report += fmt.Sprintf("\n\nWarning: the cluster's image policies could not be read (%v); images were reported as unverified", policyErr)
+	} else if len(policies) == 0 {
+		report += "\n\nNote: the cluster has no ClusterImagePolicy and the namespace has no ImagePolicy, so no image is signature-verified at pull time"
+	}
+	return api.NewToolCallResult(report, nil), nil
+}
+
+// workloadImages collects the distinct images reported by the container
+// statuses of the pods in namespace, keeping the runtime-resolved digest and
+// the pods each image runs in.
+func workloadImages(params api.ToolHandlerParams, namespace string) ([]workloadImage, error) {
+	pods, err := params.DynamicClient().Resource(podsGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	byImage := make(map[string]*workloadImage)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		for _, field := range []string{"initContainerStatuses", "containerStatuses", "ephemeralContainerStatuses"} {
+			statuses, _, _ := unstructured.NestedSlice(pod.Object, "status", field)
+			for _, raw := range statuses {
+				status, ok := raw.(map[string]any)
+				if !ok {
+					continue
+				}
+				image, _ := status["image"].(string)
+				if image == "" {
+					continue
+				}
+				entry := byImage[image]
+				if entry == nil {
+					entry = &workloadImage{image: image}
+					byImage[image] = entry
+				}
+				imageID, _ := status["imageID"].(string)
+				if at := strings.LastIndex(imageID, "@"); at >= 0 && entry.digest == "" {
+					entry.digest = imageID[at+1:]
+				}
+				if !slices.Contains(entry.pods, pod.GetName()) {
+					entry.pods = append(entry.pods, pod.GetName())
+				}
+			}
+		}
+	}
+	images := make([]workloadImage, 0, len(byImage))
+	for _, entry := range byImage {
+		sort.Strings(entry.pods)
+		images = append(images, *entry)
+	}
+	sort.Slice(images, func(i, j int) bool { return images[i].image < images[j].image })
+	return images, nil
+}
+
+// signaturePolicies reads the cluster-wide ClusterImagePolicy resources and
+// the ImagePolicy resources of namespace, flattened to scopes and root of
+// trust. Clusters without the image policy APIs yield an empty list.
+func signaturePolicies(params api.ToolHandlerParams, namespace string) ([]imagePolicy, error) {
+	var policies []imagePolicy
+	cluster, err := listFirstServed(params, clusterImagePolicyGVRs, "")
+	if err != nil {
+		return nil, err
+	}
+	namespaced, err := listFirstServed(params, imagePolicyGVRs, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range append(cluster, namespaced...) {
+		policy := imagePolicy{name: item.GetName()}
+		if item.GetNamespace() != "" {
+			policy.name = item.GetNamespace() + "/" + policy.name
+		}
+		policy.scopes, _, _ = unstructured.NestedStringSlice(item.Object, "spec", "scopes")
+		if _, found, _ := unstructured.NestedMap(item.Object, "spec", "policy", "rootOfTrust", "fulcioCAWithRekor"); found {
+			policy.rootOfTrust = "Fulcio CA with Rekor"
+		} else if _, found, _ := unstructured.NestedMap(item.Object, "spec", "policy", "rootOfTrust", "publicKey"); found {
+			policy.rootOfTrust = "cosign public key"
+		} else {
+			policy.rootOfTrust, _, _ = unstructured.NestedString(item.Object, "spec", "policy", "rootOfTrust", "policyType")
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// listFirstServed lists the first GVR of gvrs the cluster serves, treating a
+// completely absent API as an empty list rather than an error.
+func listFirstServed(params api.ToolHandlerParams, gvrs []schema.GroupVersionResource, namespace string) ([]unstructured.Unstructured, error) {
+	var lastErr error
+	for _, gvr := range gvrs {
+		client := params.DynamicClient().Resource(gvr)
+		var list *unstructured.UnstructuredList
+		var err error
+		if namespace != "" {
+			list, err = client.Namespace(namespace).List(params.Context, metav1.ListOptions{})
+		} else {
+			list, err = client.List(params.Context, metav1.ListOptions{})
+		}
+		if err == nil {
+			return list.Items, nil
+		}
+		if apierrors.IsNotFound(err) {
+			lastErr = nil
+			continue
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// matchingPolicy returns the first policy whose scopes cover image, following
+// the containers/image scope semantics the policies are enforced with: exact
+// repository, repository namespace prefix, registry host, or a *. host
+// wildcard. A policy without scopes covers every image.
+func matchingPolicy(policies []imagePolicy, image string) *imagePolicy {
+	repository := image
+	if at := strings.Index(repository, "@"); at >= 0 {
+		repository = repository[:at]
+	}
+	if colon := strings.LastIndex(repository, ":"); colon > strings.LastIndex(repository, "/") {
+		repository = repository[:colon]
+	}
+	host := repository
+	if slash := strings.Index(repository, "/"); slash >= 0 {
+		host = repository[:slash]
+	}
+	for i := range policies {
+		policy := &policies[i]
+		if len(policy.scopes) == 0 {
+			return policy
+		}
+		for _, scope := range policy.scopes {
+			switch {
+			case scope == repository || scope == host:
+				return policy
+			case strings.HasPrefix(repository, scope+"/"):
+				return policy
+			case strings.HasPrefix(scope, "*.") && strings.HasSuffix(host, scope[1:]):
+				return policy
+			}
+		}
+	}
+	return nil
+}
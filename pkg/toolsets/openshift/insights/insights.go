@@ -0,0 +1,143 @@
+package insights
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var insightsOperatorGVR = schema.GroupVersionResource{Group: "operator.openshift.io", Version: "v1", Resource: "insightsoperators"}
+
+// totalRiskLabels maps the numeric totalRisk of an Insights health check to
+// the severity label the Insights advisor UI shows.
+var totalRiskLabels = map[int64]string{
+	1: "Low",
+	2: "Moderate",
+	3: "Important",
+	4: "Critical",
+}
+
+// insightsAPIFilter hides the tool on clusters without the insights-operator.
+func insightsAPIFilter(p api.FilteringProvider) []func() bool {
+	return []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "operator.openshift.io", Version: "v1", Kind: "InsightsOperator"},
+			})
+		},
+	}
+}
+
+// Tools returns the Red Hat Insights recommendation tools, filtered out on
+// clusters without the insights-operator.
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "insights_recommendations_list",
+				Description: "List the active Red Hat Insights recommendations gathered by the insights-operator, with severity and advisor links, " +
+					"so vendor-known issues can be included in diagnostics. Requires the cluster to be connected to Red Hat Insights",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"minSeverity": {
+							Type:        "integer",
+							Description: "Only show recommendations with this total risk or higher: 1=Low, 2=Moderate, 3=Important, 4=Critical (Optional, default: 1)",
+							Minimum:     ptr.To(float64(1)),
+							Maximum:     ptr.To(float64(4)),
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Insights: Recommendations List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    recommendationsList,
+			TargetCompatibilityFilters: insightsAPIFilter(p),
+		},
+	}
+}
+
+func recommendationsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	minSeverity := p.OptionalInt64("minSeverity", 1)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list insights recommendations: %w", err)), nil
+	}
+
+	operator, err := params.DynamicClient().Resource(insightsOperatorGVR).Get(params.Context, "cluster", metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the insights operator: %w", err)), nil
+	}
+	downloadedAt, _, _ := unstructured.NestedString(operator.Object, "status", "insightsReport", "downloadedAt")
+	healthChecks, found, _ := unstructured.NestedSlice(operator.Object, "status", "insightsReport", "healthChecks")
+	if !found || len(healthChecks) == 0 {
+		return api.NewToolCallResult("No Insights report is available yet; the cluster may not be connected to Red Hat Insights or the first gather has not completed", nil), nil
+	}
+
+	var lines []string
+	skipped := 0
+	for _, raw := range healthChecks {
+		healthCheck, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// Disabled health checks have been acknowledged by the customer.
+		if state, _, _ := unstructured.NestedString(healthCheck, "state"); state == "Disabled" {
+			continue
+		}
+		totalRisk, _, _ := unstructured.NestedInt64(healthCheck, "totalRisk")
+		if totalRisk < minSeverity {
+			skipped++
+			continue
+		}
+		severity := totalRiskLabels[totalRisk]
+		if severity == "" {
+			severity = fmt.Sprintf("Unknown (%d)", totalRisk)
+		}
+		description, _, _ := unstructured.NestedString(healthCheck, "description")
+		advisorURI, _, _ := unstructured.NestedString(healthCheck, "advisorURI")
+		line := fmt.Sprintf("- [%s] %s", severity, description)
+		if advisorURI != "" {
+			line += "\n  Remediation: " + advisorURI
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf(
+			"No active Insights recommendations at or above severity %d (%d below the threshold, report downloaded at %s)",
+			minSeverity, skipped, downloadedAt), nil), nil
+	}
+	// Highest severity first; lines start with the bracketed severity label,
+	// so sort on the numeric risk captured alongside each line instead.
+	sort.SliceStable(lines, func(i, j int) bool { return severityOf(lines[i]) > severityOf(lines[j]) })
+	result := fmt.Sprintf("Active Insights recommendations (%d, report downloaded at %s):\n%s",
+		len(lines), downloadedAt, strings.Join(lines, "\n"))
+	if skipped > 0 {
+		result += fmt.Sprintf("\n\n%d recommendation(s) below severity %d were omitted", skipped, minSeverity)
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
+
+// severityOf recovers the numeric risk from a rendered recommendation line.
+func severityOf(line string) int64 {
+	for risk, label := range totalRiskLabels {
+		if strings.HasPrefix(line, "- ["+label+"]") {
+			return risk
+		}
+	}
+	return 0
+}
@@ -0,0 +1,270 @@
+package kata
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	kataConfigsGVR = schema.GroupVersionResource{Group: "kataconfiguration.openshift.io", Version: "v1", Resource: "kataconfigs"}
+	nodesGVR       = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}
+	deploymentsGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+)
+
+// kataNodeRoleLabel is the label the sandboxed containers operator puts on
+// nodes where the kata runtime is installed; the kata RuntimeClass schedules
+// on it.
+const kataNodeRoleLabel = "node-role.kubernetes.io/kata-oc"
+
+// kataAPIFilter hides the tools on clusters without the OpenShift sandboxed
+// containers operator.
+func kataAPIFilter(p api.FilteringProvider) []func() bool {
+	return []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "kataconfiguration.openshift.io", Version: "v1", Kind: "KataConfig"},
+			})
+		},
+	}
+}
+
+// Tools returns the OpenShift sandboxed containers (kata runtime) tools,
+// filtered out on clusters without the sandboxed containers operator.
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "kata_status",
+				Description: "Get the status of the OpenShift sandboxed containers installation: the KataConfig resources with their installation progress, " +
+					"conditions, and the number of nodes running the kata runtime",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Kata: Status",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    kataStatus,
+			TargetCompatibilityFilters: kataAPIFilter(p),
+		},
+		{
+			Tool: api.Tool{
+				Name:        "kata_nodes_list",
+				Description: "List the nodes where the kata runtime class is installed (labelled " + kataNodeRoleLabel + ") with their readiness",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Kata: Nodes List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    kataNodesList,
+			TargetCompatibilityFilters: kataAPIFilter(p),
+		},
+		{
+			Tool: api.Tool{
+				Name: "kata_migrate_deployment",
+				Description: "Migrate a Deployment to the kata runtime class after checking its pod spec for features the kata runtime does not support " +
+					"(privileged containers, host namespaces). Incompatible Deployments are reported instead of migrated; " +
+					"set dryRun to true to only run the compatibility check",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the Deployment",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the Deployment to migrate",
+						},
+						"dryRun": {
+							Type:        "boolean",
+							Description: "Only run the compatibility check without modifying the Deployment (Optional, default: false)",
+						},
+					},
+					Required: []string{"namespace", "name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Kata: Migrate Deployment",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(true),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    kataMigrateDeployment,
+			TargetCompatibilityFilters: kataAPIFilter(p),
+		},
+	}
+}
+
+func kataStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	list, err := params.DynamicClient().Resource(kataConfigsGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the KataConfig status: %w", err)), nil
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult("No KataConfig exists; the sandboxed containers operator is installed but the kata runtime has not been rolled out to any node", nil), nil
+	}
+	var sections []string
+	for i := range list.Items {
+		kataConfig := &list.Items[i]
+		var lines []string
+		if readyNodes, found, _ := unstructured.NestedInt64(kataConfig.Object, "status", "kataNodes", "readyNodeCount"); found {
+			nodeCount, _, _ := unstructured.NestedInt64(kataConfig.Object, "status", "kataNodes", "nodeCount")
+			lines = append(lines, fmt.Sprintf("- kata nodes ready: %d/%d", readyNodes, nodeCount))
+		}
+		if runtimeClass, found, _ := unstructured.NestedStringSlice(kataConfig.Object, "status", "runtimeClasses"); found && len(runtimeClass) > 0 {
+			lines = append(lines, "- runtime classes: "+strings.Join(runtimeClass, ", "))
+		}
+		conditions, _, _ := unstructured.NestedSlice(kataConfig.Object, "status", "conditions")
+		for _, raw := range conditions {
+			condition, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			conditionType, _ := condition["type"].(string)
+			status, _ := condition["status"].(string)
+			message, _ := condition["message"].(string)
+			line := fmt.Sprintf("- condition %s: %s", conditionType, status)
+			if message != "" {
+				line += " (" + message + ")"
+			}
+			lines = append(lines, line)
+		}
+		sections = append(sections, fmt.Sprintf("KataConfig %s:\n%s", kataConfig.GetName(), strings.Join(lines, "\n")))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+func kataNodesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	list, err := params.DynamicClient().Resource(nodesGVR).List(params.Context, metav1.ListOptions{LabelSelector: kataNodeRoleLabel})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list kata nodes: %w", err)), nil
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult("No node has the kata runtime installed (label "+kataNodeRoleLabel+")", nil), nil
+	}
+	var lines []string
+	for i := range list.Items {
+		node := &list.Items[i]
+		ready := "NotReady"
+		conditions, _, _ := unstructured.NestedSlice(node.Object, "status", "conditions")
+		for _, raw := range conditions {
+			condition, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			if conditionType, _ := condition["type"].(string); conditionType == "Ready" {
+				if status, _ := condition["status"].(string); status == "True" {
+					ready = "Ready"
+				}
+			}
+		}
+		lines = append(lines, fmt.Sprintf("- %s (%s)", node.GetName(), ready))
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Nodes with the kata runtime (%d):\n%s", len(list.Items), strings.Join(lines, "\n")), nil), nil
+}
+
+func kataMigrateDeployment(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	name := p.RequiredString("name")
+	dryRun := p.OptionalBool("dryRun", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to migrate the Deployment to kata: %w", err)), nil
+	}
+
+	client := params.DynamicClient().Resource(deploymentsGVR).Namespace(namespace)
+	deployment, err := client.Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get Deployment %s/%s: %w", namespace, name, err)), nil
+	}
+
+	if runtimeClass, _, _ := unstructured.NestedString(deployment.Object, "spec", "template", "spec", "runtimeClassName"); runtimeClass == "kata" {
+		return api.NewToolCallResult(fmt.Sprintf("Deployment %s/%s already uses the kata runtime class", namespace, name), nil), nil
+	}
+
+	incompatibilities, warnings := checkKataCompatibility(deployment)
+	if len(incompatibilities) > 0 {
+		return api.NewToolCallResult(fmt.Sprintf("Deployment %s/%s cannot run under the kata runtime:\n%s", namespace, name, strings.Join(incompatibilities, "\n")), nil), nil
+	}
+
+	report := fmt.Sprintf("Deployment %s/%s is compatible with the kata runtime", namespace, name)
+	if len(warnings) > 0 {
+		report += "; review these warnings:\n" + strings.Join(warnings, "\n")
+	}
+	if dryRun {
+		return api.NewToolCallResult(report+"\n\nDry run: the Deployment was not modified", nil), nil
+	}
+
+	if err := unstructured.SetNestedField(deployment.Object, "kata", "spec", "template", "spec", "runtimeClassName"); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set runtimeClassName on Deployment %s/%s: %w", namespace, name, err)), nil
+	}
+	if _, err := client.Update(params.Context, deployment, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update Deployment %s/%s: %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(report+"\n\nThe Deployment now sets runtimeClassName=kata; its pods are being recreated inside sandboxed containers", nil), nil
+}
+
+// checkKataCompatibility inspects the pod template of deployment for features
+// the kata runtime cannot provide. Hard incompatibilities block the
+// migration; warnings flag constructs that work but behave differently inside
+// a sandbox VM.
+func checkKataCompatibility(deployment *unstructured.Unstructured) (incompatibilities, warnings []string) {
+	podSpec, _, _ := unstructured.NestedMap(deployment.Object, "spec", "template", "spec")
+	for _, hostNamespace := range []string{"hostNetwork", "hostPID", "hostIPC"} {
+		if enabled, _ := podSpec[hostNamespace].(bool); enabled {
+			incompatibilities = append(incompatibilities, fmt.Sprintf("- %s=true: the kata VM cannot share the host's namespaces", hostNamespace))
+		}
+	}
+
+	containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	initContainers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "initContainers")
+	for _, raw := range append(containers, initContainers...) {
+		container, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		containerName, _ := container["name"].(string)
+		if privileged, _, _ := unstructured.NestedBool(container, "securityContext", "privileged"); privileged {
+			incompatibilities = append(incompatibilities, fmt.Sprintf("- container %s is privileged: privileged access to the sandbox VM does not grant host access and is not supported", containerName))
+		}
+		if capabilities, _, _ := unstructured.NestedStringSlice(container, "securityContext", "capabilities", "add"); len(capabilities) > 0 {
+			warnings = append(warnings, fmt.Sprintf("- container %s adds capabilities (%s); they apply inside the sandbox VM, not on the host", containerName, strings.Join(capabilities, ", ")))
+		}
+	}
+
+	volumes, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "volumes")
+	for _, raw := range volumes {
+		volume, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		volumeName, _ := volume["name"].(string)
+		if _, hasHostPath := volume["hostPath"]; hasHostPath {
+			warnings = append(warnings, fmt.Sprintf("- volume %s is a hostPath; it is shared into the sandbox VM via virtiofs, which may change performance and permission semantics", volumeName))
+		}
+	}
+	return incompatibilities, warnings
+}
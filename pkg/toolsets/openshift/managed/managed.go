@@ -0,0 +1,154 @@
+package managed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	infrastructureGVR = schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "infrastructures"}
+	namespaceGVR      = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "namespaces"}
+)
+
+// Managed cluster products this tool can detect.
+const (
+	productSelfManaged = "self-managed OpenShift"
+	productROSA        = "ROSA (Red Hat OpenShift Service on AWS)"
+	productARO         = "ARO (Azure Red Hat OpenShift)"
+	productOSD         = "OSD (OpenShift Dedicated)"
+)
+
+// restrictionsByProduct lists the operations the managed platform reserves
+// for the Red Hat (or Microsoft) SRE team; proposing them to a customer-level
+// identity will be rejected by the platform's protection webhooks.
+var restrictionsByProduct = map[string][]string{
+	productROSA: {
+		"cluster-admin level RBAC (e.g. the cluster-admin ClusterRole, backplane-* roles) is managed by Red Hat SRE and cannot be edited",
+		"resources in openshift-* platform namespaces are protected by validation webhooks; change them through OCM or support instead",
+		"cluster upgrades, the ClusterVersion resource, and MachineConfigs are driven through OCM, not edited directly",
+		"the identity provider configuration (OAuth resource) must be managed through OCM / the ROSA CLI",
+		"machine pools (worker nodes) are managed through OCM / the ROSA CLI, not by editing MachineSets directly",
+	},
+	productARO: {
+		"resources in openshift-* platform namespaces are reconciled by the ARO operator; manual edits are reverted",
+		"cluster upgrades and the ClusterVersion resource are managed through the Azure portal / az aro CLI",
+		"the cluster Deny Assignment prevents edits to ARO-managed Azure resources",
+		"master and infrastructure node configuration cannot be modified",
+	},
+	productOSD: {
+		"cluster-admin level RBAC is managed by Red Hat SRE and cannot be edited",
+		"resources in openshift-* platform namespaces are protected by validation webhooks; change them through OCM or support instead",
+		"cluster upgrades, the ClusterVersion resource, and MachineConfigs are driven through OCM, not edited directly",
+		"the identity provider configuration (OAuth resource) must be managed through OCM",
+	},
+}
+
+// sreNamespaces only exist on clusters managed by Red Hat SRE (OSD and ROSA).
+var sreNamespaces = []string{"openshift-backplane", "openshift-ocm-agent-operator", "openshift-sre-pruning"}
+
+// aroNamespace only exists on Azure Red Hat OpenShift clusters.
+const aroNamespace = "openshift-azure-operator"
+
+// infrastructureAPIFilter hides the tool on clusters without the OpenShift
+// infrastructure config API.
+func infrastructureAPIFilter(p api.FilteringProvider) []func() bool {
+	return []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "config.openshift.io", Version: "v1", Kind: "Infrastructure"},
+			})
+		},
+	}
+}
+
+// Tools returns the managed cluster awareness tool, filtered out on clusters
+// without the config.openshift.io API.
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "managed_cluster_info",
+				Description: "Detect whether the cluster is a managed OpenShift product (ROSA, ARO, or OpenShift Dedicated) and report which operations " +
+					"are reserved for the managing SRE team, so forbidden changes (e.g. editing cluster-admin RBAC on ROSA) are not proposed",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Managed Cluster: Info",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    managedClusterInfo,
+			TargetCompatibilityFilters: infrastructureAPIFilter(p),
+		},
+	}
+}
+
+func managedClusterInfo(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	infrastructure, err := params.DynamicClient().Resource(infrastructureGVR).Get(params.Context, "cluster", metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the cluster infrastructure: %w", err)), nil
+	}
+	platform, _, _ := unstructured.NestedString(infrastructure.Object, "status", "platformStatus", "type")
+	controlPlaneTopology, _, _ := unstructured.NestedString(infrastructure.Object, "status", "controlPlaneTopology")
+
+	product := detectProduct(params, platform)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Product: %s\n", product)
+	fmt.Fprintf(&sb, "Platform: %s\n", platform)
+	if controlPlaneTopology != "" {
+		fmt.Fprintf(&sb, "Control plane topology: %s", controlPlaneTopology)
+		if controlPlaneTopology == "External" {
+			sb.WriteString(" (hosted control plane: the control plane runs outside the cluster and is not visible or editable from it)")
+		}
+		sb.WriteString("\n")
+	}
+
+	restrictions := restrictionsByProduct[product]
+	if len(restrictions) == 0 {
+		sb.WriteString("\nNo managed-platform restrictions detected: this looks like a self-managed cluster where all operations are available (subject to RBAC).")
+		return api.NewToolCallResult(sb.String(), nil), nil
+	}
+	sb.WriteString("\nOperations restricted by the managed platform (do not propose these changes):\n")
+	for _, restriction := range restrictions {
+		fmt.Fprintf(&sb, "- %s\n", restriction)
+	}
+	return api.NewToolCallResult(strings.TrimRight(sb.String(), "\n"), nil), nil
+}
+
+// detectProduct classifies the cluster from marker namespaces: the ARO
+// operator namespace identifies ARO, the Red Hat SRE namespaces identify
+// OSD/ROSA (disambiguated by the cloud platform). Detection is heuristic:
+// a customer identity may also simply lack permission to see the markers.
+func detectProduct(params api.ToolHandlerParams, platform string) string {
+	if namespaceExists(params, aroNamespace) {
+		return productARO
+	}
+	for _, namespace := range sreNamespaces {
+		if namespaceExists(params, namespace) {
+			if platform == "AWS" {
+				return productROSA
+			}
+			return productOSD
+		}
+	}
+	return productSelfManaged
+}
+
+func namespaceExists(params api.ToolHandlerParams, name string) bool {
+	namespace, err := params.DynamicClient().Resource(namespaceGVR).Get(params.Context, name, metav1.GetOptions{})
+	return err == nil && namespace != nil
+}
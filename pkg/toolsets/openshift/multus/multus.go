@@ -0,0 +1,312 @@
+package multus
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	nadsGVR   = schema.GroupVersionResource{Group: "k8s.cni.cncf.io", Version: "v1", Resource: "network-attachment-definitions"}
+	podsGVR   = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
+	eventsGVR = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "events"}
+)
+
+const (
+	// networksAnnotation requests additional interfaces from Multus; its value
+	// is either a comma-separated list of NetworkAttachmentDefinition names or
+	// a JSON array of network selection objects.
+	networksAnnotation = "k8s.v1.cni.cncf.io/networks"
+	// networkStatusAnnotation is written back by Multus with the interfaces it
+	// actually attached.
+	networkStatusAnnotation = "k8s.v1.cni.cncf.io/network-status"
+)
+
+// Tools returns the Multus additional-network tools.
+func Tools(_ api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "multus_nads_list",
+				Description: "List the NetworkAttachmentDefinitions of the cluster or a namespace with the CNI plugin type each one configures, " +
+					"to see which additional pod networks are available",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to list the NetworkAttachmentDefinitions from (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Multus: NetworkAttachmentDefinitions List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: nadsList,
+		},
+		{
+			Tool: api.Tool{
+				Name: "multus_pod_networks_validate",
+				Description: "Validate the " + networksAnnotation + " annotation of a pod: check that every requested NetworkAttachmentDefinition exists " +
+					"and compare the request against the interfaces Multus reports as attached",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the pod",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the pod to validate",
+						},
+					},
+					Required: []string{"namespace", "name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Multus: Pod Networks Validate",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: podNetworksValidate,
+		},
+		{
+			Tool: api.Tool{
+				Name: "multus_attachment_failures",
+				Description: "List the pods whose additional network attachment failed, based on the warning events Multus and the CNI plugins emit " +
+					"(e.g. FailedCreatePodSandBox with a network error)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to search for attachment failures (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Multus: Attachment Failures",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: attachmentFailures,
+		},
+	}
+}
+
+func nadsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the NetworkAttachmentDefinitions: %w", err)), nil
+	}
+	nads, err := params.DynamicClient().Resource(nadsGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the NetworkAttachmentDefinitions: %w", err)), nil
+	}
+	if len(nads.Items) == 0 {
+		return api.NewToolCallResult("No NetworkAttachmentDefinitions found; pods can only use the default cluster network", nil), nil
+	}
+	var lines []string
+	for i := range nads.Items {
+		nad := &nads.Items[i]
+		line := fmt.Sprintf("- %s/%s", nad.GetNamespace(), nad.GetName())
+		if cniType := cniPluginType(nad); cniType != "" {
+			line += " (type: " + cniType + ")"
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("NetworkAttachmentDefinitions (%d):\n%s", len(nads.Items), strings.Join(lines, "\n")), nil), nil
+}
+
+// cniPluginType extracts the CNI plugin type from the NetworkAttachmentDefinition's
+// embedded CNI configuration, handling both single-plugin and plugin-list forms.
+func cniPluginType(nad *unstructured.Unstructured) string {
+	config, _, _ := unstructured.NestedString(nad.Object, "spec", "config")
+	if config == "" {
+		return ""
+	}
+	var parsed struct {
+		Type    string `json:"type"`
+		Plugins []struct {
+			Type string `json:"type"`
+		} `json:"plugins"`
+	}
+	if err := json.Unmarshal([]byte(config), &parsed); err != nil {
+		return ""
+	}
+	if parsed.Type != "" {
+		return parsed.Type
+	}
+	var types []string
+	for _, plugin := range parsed.Plugins {
+		if plugin.Type != "" {
+			types = append(types, plugin.Type)
+		}
+	}
+	return strings.Join(types, ", ")
+}
+
+// networkRequest is one entry of the networks annotation, normalized from
+// either the comma-separated or the JSON array form.
+type networkRequest struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Interface string `json:"interface"`
+}
+
+// parseNetworksAnnotation parses the networks annotation. The short form is a
+// comma-separated list of "[namespace/]name[@interface]" references; the long
+// form is a JSON array of network selection objects.
+func parseNetworksAnnotation(value, podNamespace string) ([]networkRequest, error) {
+	value = strings.TrimSpace(value)
+	var requests []networkRequest
+	if strings.HasPrefix(value, "[") {
+		if err := json.Unmarshal([]byte(value), &requests); err != nil {
+			return nil, fmt.Errorf("the annotation is not a valid network selection array: %w", err)
+		}
+	} else {
+		for _, item := range strings.Split(value, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			request := networkRequest{Name: item}
+			if name, iface, found := strings.Cut(request.Name, "@"); found {
+				request.Name, request.Interface = name, iface
+			}
+			if namespace, name, found := strings.Cut(request.Name, "/"); found {
+				request.Namespace, request.Name = namespace, name
+			}
+			requests = append(requests, request)
+		}
+	}
+	for i := range requests {
+		if requests[i].Namespace == "" {
+			requests[i].Namespace = podNamespace
+		}
+	}
+	return requests, nil
+}
+
+func podNetworksValidate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	name := p.RequiredString("name")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to validate the pod networks: %w", err)), nil
+	}
+
+	pod, err := params.DynamicClient().Resource(podsGVR).Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pod %s/%s: %w", namespace, name, err)), nil
+	}
+	annotation := pod.GetAnnotations()[networksAnnotation]
+	if annotation == "" {
+		return api.NewToolCallResult(fmt.Sprintf("Pod %s/%s does not request additional networks (no %s annotation)", namespace, name, networksAnnotation), nil), nil
+	}
+	requests, err := parseNetworksAnnotation(annotation, namespace)
+	if err != nil {
+		return api.NewToolCallResult(fmt.Sprintf("Pod %s/%s has an invalid %s annotation: %v", namespace, name, networksAnnotation, err), nil), nil
+	}
+
+	attached := attachedNetworks(pod)
+	var lines []string
+	valid := true
+	for _, request := range requests {
+		reference := request.Namespace + "/" + request.Name
+		line := "- " + reference
+		if request.Interface != "" {
+			line += " (interface " + request.Interface + ")"
+		}
+		if _, err := params.DynamicClient().Resource(nadsGVR).Namespace(request.Namespace).Get(params.Context, request.Name, metav1.GetOptions{}); err != nil {
+			valid = false
+			line += ": [PROBLEM] the NetworkAttachmentDefinition does not exist or is not readable (" + err.Error() + ")"
+		} else if len(attached) > 0 && !attached[reference] {
+			valid = false
+			line += ": [PROBLEM] the NetworkAttachmentDefinition exists but Multus does not report it as attached; check the pod events"
+		} else {
+			line += ": OK"
+		}
+		lines = append(lines, line)
+	}
+	verdict := fmt.Sprintf("Pod %s/%s requests %d additional network(s), all valid:", namespace, name, len(requests))
+	if !valid {
+		verdict = fmt.Sprintf("Pod %s/%s requests %d additional network(s) with problems:", namespace, name, len(requests))
+	}
+	return api.NewToolCallResult(verdict+"\n"+strings.Join(lines, "\n"), nil), nil
+}
+
+// attachedNetworks returns the "namespace/name" references Multus reports in
+// the network-status annotation; an empty map means the status is missing and
+// attachment cannot be confirmed either way.
+func attachedNetworks(pod *unstructured.Unstructured) map[string]bool {
+	status := pod.GetAnnotations()[networkStatusAnnotation]
+	if status == "" {
+		return nil
+	}
+	var entries []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(status), &entries); err != nil {
+		return nil
+	}
+	attached := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		attached[entry.Name] = true
+	}
+	return attached
+}
+
+func attachmentFailures(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the attachment failures: %w", err)), nil
+	}
+	events, err := params.DynamicClient().Resource(eventsGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{
+		FieldSelector: "type=Warning,involvedObject.kind=Pod",
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the warning events: %w", err)), nil
+	}
+	var lines []string
+	for i := range events.Items {
+		event := &events.Items[i]
+		message, _, _ := unstructured.NestedString(event.Object, "message")
+		if !strings.Contains(message, "network") || (!strings.Contains(message, "error adding") && !strings.Contains(message, "failed to") && !strings.Contains(message, "multus")) {
+			continue
+		}
+		reason, _, _ := unstructured.NestedString(event.Object, "reason")
+		podNamespace, _, _ := unstructured.NestedString(event.Object, "involvedObject", "namespace")
+		podName, _, _ := unstructured.NestedString(event.Object, "involvedObject", "name")
+		timestamp, _, _ := unstructured.NestedString(event.Object, "lastTimestamp")
+		lines = append(lines, fmt.Sprintf("- %s %s/%s [%s]: %s", timestamp, podNamespace, podName, reason, message))
+	}
+	if len(lines) == 0 {
+		return api.NewToolCallResult("No additional network attachment failures found in the recent warning events", nil), nil
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(lines)))
+	return api.NewToolCallResult(fmt.Sprintf("Additional network attachment failures (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil), nil
+}
@@ -0,0 +1,247 @@
+package serviceca
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	secretsGVR  = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}
+	servicesGVR = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}
+)
+
+const (
+	// servingCertAnnotation on a Service asks the service-ca operator to
+	// generate a signed serving certificate into the named secret.
+	servingCertAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
+	// originatingServiceAnnotation is put on the generated secret and points
+	// back at the Service that requested it.
+	originatingServiceAnnotation = "service.beta.openshift.io/originating-service-name"
+)
+
+// Tools returns the service-ca internal TLS troubleshooting tools.
+func Tools(_ api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "serviceca_certs_list",
+				Description: "List the serving certificate secrets generated by the OpenShift service-ca operator with their subject, expiry, " +
+					"and originating Service, flagging certificates that expire soon",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to list the serving certificates from (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Service CA: Certificates List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: certsList,
+		},
+		{
+			Tool: api.Tool{
+				Name: "serviceca_services_check",
+				Description: "Check the Services of a namespace for service-ca problems: Services whose " + servingCertAnnotation + " annotation " +
+					"references a secret the operator has not generated, and HTTPS-looking Services that do not request a serving certificate at all",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace whose Services to check",
+						},
+					},
+					Required: []string{"namespace"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Service CA: Services Check",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: servicesCheck,
+		},
+		{
+			Tool: api.Tool{
+				Name: "serviceca_cert_rotate",
+				Description: "Force the rotation of a service-ca generated serving certificate by deleting its secret; " +
+					"the service-ca operator immediately recreates it with a fresh certificate. Only secrets generated by the operator can be rotated this way",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the serving certificate secret",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the serving certificate secret to rotate",
+						},
+					},
+					Required: []string{"namespace", "name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Service CA: Certificate Rotate",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(true), // deletes the secret; consumers briefly see it missing until regenerated
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: certRotate,
+		},
+	}
+}
+
+func certsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the serving certificates: %w", err)), nil
+	}
+	secrets, err := params.DynamicClient().Resource(secretsGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{
+		FieldSelector: "type=kubernetes.io/tls",
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the TLS secrets: %w", err)), nil
+	}
+	var lines []string
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		originatingService := secret.GetAnnotations()[originatingServiceAnnotation]
+		if originatingService == "" {
+			continue
+		}
+		line := fmt.Sprintf("- %s/%s (Service %s)", secret.GetNamespace(), secret.GetName(), originatingService)
+		if certificate, err := parseServingCert(secret); err != nil {
+			line += fmt.Sprintf(": [PROBLEM] the certificate cannot be parsed (%v)", err)
+		} else {
+			line += fmt.Sprintf("\n  subject: %s, expires: %s", certificate.Subject.CommonName, certificate.NotAfter.Format(time.RFC3339))
+			if remaining := time.Until(certificate.NotAfter); remaining < 0 {
+				line += " [PROBLEM: expired]"
+			} else if remaining < 30*24*time.Hour {
+				line += fmt.Sprintf(" [expires in %d day(s); the operator rotates it automatically, investigate if it does not]", int(remaining.Hours()/24))
+			}
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return api.NewToolCallResult("No service-ca generated serving certificates found", nil), nil
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Service CA serving certificates (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+// parseServingCert decodes the leaf certificate from the tls.crt entry of a
+// serving certificate secret.
+func parseServingCert(secret *unstructured.Unstructured) (*x509.Certificate, error) {
+	encoded, _, _ := unstructured.NestedString(secret.Object, "data", "tls.crt")
+	if encoded == "" {
+		return nil, fmt.Errorf("the secret has no tls.crt entry")
+	}
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("tls.crt is not valid base64: %w", err)
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("tls.crt contains no PEM block")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func servicesCheck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check the Services: %w", err)), nil
+	}
+	services, err := params.DynamicClient().Resource(servicesGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the Services of namespace %s: %w", namespace, err)), nil
+	}
+	var problems []string
+	for i := range services.Items {
+		service := &services.Items[i]
+		secretName := service.GetAnnotations()[servingCertAnnotation]
+		if secretName != "" {
+			if _, err := params.DynamicClient().Resource(secretsGVR).Namespace(namespace).Get(params.Context, secretName, metav1.GetOptions{}); err != nil {
+				problems = append(problems, fmt.Sprintf("- Service %s requests serving cert secret %s, but the secret does not exist; check the service-ca operator and the events of the Service", service.GetName(), secretName))
+			}
+			continue
+		}
+		if servesHTTPS(service) {
+			problems = append(problems, fmt.Sprintf("- Service %s exposes an HTTPS-looking port but has no %s annotation; its backend must manage its own certificate", service.GetName(), servingCertAnnotation))
+		}
+	}
+	if len(problems) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No service-ca problems found in the %d Service(s) of namespace %s", len(services.Items), namespace), nil), nil
+	}
+	sort.Strings(problems)
+	return api.NewToolCallResult(fmt.Sprintf("Service CA check of namespace %s (%d finding(s)):\n%s", namespace, len(problems), strings.Join(problems, "\n")), nil), nil
+}
+
+// servesHTTPS reports whether the Service exposes a port that by name or
+// number looks like TLS traffic.
+func servesHTTPS(service *unstructured.Unstructured) bool {
+	ports, _, _ := unstructured.NestedSlice(service.Object, "spec", "ports")
+	for _, raw := range ports {
+		port, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := port["name"].(string)
+		number, _ := port["port"].(int64)
+		if number == 443 || number == 8443 || strings.Contains(name, "https") || strings.Contains(name, "tls") {
+			return true
+		}
+	}
+	return false
+}
+
+func certRotate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	name := p.RequiredString("name")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to rotate the serving certificate: %w", err)), nil
+	}
+
+	secret, err := params.DynamicClient().Resource(secretsGVR).Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get secret %s/%s: %w", namespace, name, err)), nil
+	}
+	originatingService := secret.GetAnnotations()[originatingServiceAnnotation]
+	if originatingService == "" {
+		return api.NewToolCallResult("", fmt.Errorf("secret %s/%s was not generated by the service-ca operator (no %s annotation); deleting it would not rotate anything and the secret would be lost", namespace, name, originatingServiceAnnotation)), nil
+	}
+
+	if err := params.DynamicClient().Resource(secretsGVR).Namespace(namespace).Delete(params.Context, name, metav1.DeleteOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to delete secret %s/%s: %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Deleted secret %s/%s; the service-ca operator will recreate it with a fresh serving certificate for Service %s. "+
+		"Restart the consuming pods if they do not watch the secret for changes", namespace, name, originatingService), nil), nil
+}
@@ -0,0 +1,254 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+// templateGroup is the API group of OpenShift Templates.
+const templateGroup = "template.openshift.io"
+
+var templatesGVR = schema.GroupVersionResource{Group: templateGroup, Version: "v1", Resource: "templates"}
+
+// processedTemplatesGVR is the server-side template processing endpoint
+// (the same one `oc process` uses).
+var processedTemplatesGVR = schema.GroupVersionResource{Group: templateGroup, Version: "v1", Resource: "processedtemplates"}
+
+// Tools returns the OpenShift Template tools, filtered out on clusters
+// without the template API.
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	templateFilter := []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: templateGroup, Version: "v1", Kind: "Template"},
+			})
+		},
+	}
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "templates_list",
+				Description: "List OpenShift Templates in the current or provided namespace with their description and parameters (use the 'openshift' namespace for the cluster's template catalog)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to list Templates from (Optional, current namespace if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Templates: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    templatesList,
+			TargetCompatibilityFilters: templateFilter,
+		},
+		{
+			Tool: api.Tool{
+				Name: "templates_process",
+				Description: "Process an OpenShift Template server-side with the provided parameter values and return the resulting manifests, " +
+					"optionally applying them to the cluster (equivalent to oc process, and oc process | oc apply when apply is true)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the Template to process",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the Template (Optional, current namespace if not provided)",
+						},
+						"parameters": {
+							Type:                 "object",
+							Description:          "Template parameter values as key-value pairs (Optional, parameters with defaults or generated values can be omitted)",
+							AdditionalProperties: &jsonschema.Schema{Type: "string"},
+						},
+						"apply": {
+							Type:        "boolean",
+							Description: "Apply the processed objects to the cluster instead of only returning them (Optional, defaults to false)",
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Templates: Process",
+					DestructiveHint: ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    templatesProcess,
+			TargetCompatibilityFilters: templateFilter,
+		},
+	}
+}
+
+func templatesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list templates: %w", err)), nil
+	}
+
+	templates, err := params.DynamicClient().Resource(templatesGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list templates in namespace '%s': %w", namespace, err)), nil
+	}
+	if len(templates.Items) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No Templates found in namespace '%s'", namespace), nil), nil
+	}
+	var lines []string
+	for i := range templates.Items {
+		template := &templates.Items[i]
+		line := "- " + template.GetName()
+		if description := template.GetAnnotations()["description"]; description != "" {
+			line += ": " + strings.Split(strings.TrimSpace(description), "\n")[0]
+		}
+		lines = append(lines, line)
+		for _, parameter := range templateParameters(template) {
+			lines = append(lines, "  "+parameter)
+		}
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Templates in namespace '%s' (%d):\n%s", namespace, len(templates.Items), strings.Join(lines, "\n")), nil), nil
+}
+
+// templateParameters renders one line per template parameter with its
+// default/generated value or required marker.
+func templateParameters(template *unstructured.Unstructured) []string {
+	parameters, _, _ := unstructured.NestedSlice(template.Object, "parameters")
+	var lines []string
+	for _, entry := range parameters {
+		parameter, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(parameter, "name")
+		line := "parameter " + name
+		if value, found, _ := unstructured.NestedString(parameter, "value"); found {
+			line += fmt.Sprintf(" (default: %s)", value)
+		} else if generate, _, _ := unstructured.NestedString(parameter, "generate"); generate != "" {
+			line += " (generated)"
+		} else if required, _, _ := unstructured.NestedBool(parameter, "required"); required {
+			line += " (required)"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func templatesProcess(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	apply := p.OptionalBool("apply", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to process template: %w", err)), nil
+	}
+	values := map[string]string{}
+	if raw, ok := params.GetArguments()["parameters"]; ok && raw != nil {
+		rawMap, ok := raw.(map[string]interface{})
+		if !ok {
+			return api.NewToolCallResult("", fmt.Errorf("failed to process template: parameters parameter must be an object with string values")), nil
+		}
+		for k, v := range rawMap {
+			s, ok := v.(string)
+			if !ok {
+				return api.NewToolCallResult("", fmt.Errorf("failed to process template: parameters parameter must be an object with string values")), nil
+			}
+			values[k] = s
+		}
+	}
+
+	template, err := params.DynamicClient().Resource(templatesGVR).Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to process template '%s/%s': %w", namespace, name, err)), nil
+	}
+	if err = templateSetParameters(template, values); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to process template '%s/%s': %w", namespace, name, err)), nil
+	}
+
+	processed, err := params.DynamicClient().Resource(processedTemplatesGVR).Namespace(namespace).Create(params.Context, template, metav1.CreateOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to process template '%s/%s': %w", namespace, name, err)), nil
+	}
+	objects, _, _ := unstructured.NestedSlice(processed.Object, "objects")
+	if len(objects) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("Template '%s/%s' processed successfully but produced no objects", namespace, name), nil), nil
+	}
+
+	var documents []string
+	for _, object := range objects {
+		document, marshalErr := yaml.Marshal(object)
+		if marshalErr != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to process template '%s/%s': %w", namespace, name, marshalErr)), nil
+		}
+		documents = append(documents, string(document))
+	}
+	manifest := strings.Join(documents, "\n---\n")
+
+	if !apply {
+		return api.NewToolCallResult(fmt.Sprintf("Template '%s/%s' processed into %d object(s) (not applied):\n%s", namespace, name, len(objects), manifest), nil), nil
+	}
+	applied, err := kubernetes.NewCore(params).ResourcesCreateOrUpdate(params, manifest)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to apply processed template '%s/%s': %w", namespace, name, err)), nil
+	}
+	var lines []string
+	for _, obj := range applied {
+		lines = append(lines, fmt.Sprintf("- %s/%s (namespace: %s)", obj.GetKind(), obj.GetName(), obj.GetNamespace()))
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Template '%s/%s' processed and %d object(s) applied:\n%s", namespace, name, len(applied), strings.Join(lines, "\n")), nil), nil
+}
+
+// templateSetParameters fills in the provided parameter values and verifies
+// that every required parameter without a default or generator is covered.
+func templateSetParameters(template *unstructured.Unstructured, values map[string]string) error {
+	parameters, _, _ := unstructured.NestedSlice(template.Object, "parameters")
+	known := map[string]bool{}
+	var missing []string
+	for i, entry := range parameters {
+		parameter, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(parameter, "name")
+		known[name] = true
+		if value, provided := values[name]; provided {
+			parameter["value"] = value
+			parameters[i] = parameter
+			continue
+		}
+		_, hasDefault, _ := unstructured.NestedString(parameter, "value")
+		generate, _, _ := unstructured.NestedString(parameter, "generate")
+		required, _, _ := unstructured.NestedBool(parameter, "required")
+		if required && !hasDefault && generate == "" {
+			missing = append(missing, name)
+		}
+	}
+	for name := range values {
+		if !known[name] {
+			return fmt.Errorf("unknown parameter '%s'", name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required parameter(s): %s", strings.Join(missing, ", "))
+	}
+	return unstructured.SetNestedSlice(template.Object, parameters, "parameters")
+}
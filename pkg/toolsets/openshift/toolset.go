@@ -5,7 +5,21 @@ import (
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/audit"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/console"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/descheduler"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/etcd"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/images"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/insights"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/kata"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/managed"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/multus"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/mustgather"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/serviceca"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/templates"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/upgrade"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/users"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshift/windows"
 )
 
 type Toolset struct{}
@@ -21,7 +35,22 @@ func (t *Toolset) GetDescription() string {
 }
 
 func (t *Toolset) GetTools(o api.FilteringProvider) []api.ServerTool {
-	return nil
+	return slices.Concat(
+		audit.Tools(o),
+		console.Tools(o),
+		descheduler.Tools(o),
+		etcd.Tools(o),
+		images.Tools(o),
+		insights.Tools(o),
+		kata.Tools(o),
+		managed.Tools(o),
+		multus.Tools(o),
+		serviceca.Tools(o),
+		templates.Tools(o),
+		upgrade.Tools(o),
+		users.Tools(o),
+		windows.Tools(o),
+	)
 }
 
 func (t *Toolset) GetPrompts() []api.ServerPrompt {
@@ -0,0 +1,287 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	clusterOperatorsGVR   = schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "clusteroperators"}
+	apiRequestCountsGVR   = schema.GroupVersionResource{Group: "apiserver.openshift.io", Version: "v1", Resource: "apirequestcounts"}
+	podDisruptionBudgets  = schema.GroupVersionResource{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}
+	machineConfigPoolsGVR = schema.GroupVersionResource{Group: "machineconfiguration.openshift.io", Version: "v1", Resource: "machineconfigpools"}
+	installPlansGVR       = schema.GroupVersionResource{Group: "operators.coreos.com", Version: "v1alpha1", Resource: "installplans"}
+)
+
+// Check outcomes, ordered by severity: a single fail makes the whole
+// preflight fail, a single warn (without fails) makes it warn.
+const (
+	statusPass = "PASS"
+	statusWarn = "WARN"
+	statusFail = "FAIL"
+)
+
+// checkResult is the outcome of one preflight check.
+type checkResult struct {
+	name    string
+	status  string
+	details []string
+}
+
+// upgradeAPIFilter hides the tool on clusters without the OpenShift
+// ClusterVersion API, which every check here revolves around.
+func upgradeAPIFilter(p api.FilteringProvider) []func() bool {
+	return []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "config.openshift.io", Version: "v1", Kind: "ClusterVersion"},
+			})
+		},
+	}
+}
+
+// Tools returns the upgrade readiness preflight tool, filtered out on
+// non-OpenShift clusters.
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "upgrade_preflight",
+				Description: "Run an upgrade readiness preflight against the cluster and produce a pass/warn/fail report before an upgrade is triggered. " +
+					"Checks ClusterOperator health, deprecated API usage reported by APIRequestCounts, PodDisruptionBudgets that would block node drains, " +
+					"MachineConfigPools with pending or paused updates, and unapproved OLM InstallPlans",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Upgrade: Preflight",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    upgradePreflight,
+			TargetCompatibilityFilters: upgradeAPIFilter(p),
+		},
+	}
+}
+
+func upgradePreflight(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	checks := []checkResult{
+		checkClusterOperators(params),
+		checkDeprecatedAPIUsage(params),
+		checkPodDisruptionBudgets(params),
+		checkMachineConfigPools(params),
+		checkInstallPlans(params),
+	}
+
+	overall := statusPass
+	var sections []string
+	for _, check := range checks {
+		if check.status == statusFail || (check.status == statusWarn && overall == statusPass) {
+			overall = check.status
+		}
+		section := fmt.Sprintf("[%s] %s", check.status, check.name)
+		if len(check.details) > 0 {
+			section += "\n" + strings.Join(check.details, "\n")
+		}
+		sections = append(sections, section)
+	}
+
+	verdict := map[string]string{
+		statusPass: "Cluster looks ready to upgrade",
+		statusWarn: "Cluster can probably upgrade, but review the warnings first",
+		statusFail: "Do NOT upgrade until the failed checks are resolved",
+	}[overall]
+	return api.NewToolCallResult(fmt.Sprintf("Upgrade preflight: %s — %s\n\n%s", overall, verdict, strings.Join(sections, "\n\n")), nil), nil
+}
+
+// checkClusterOperators fails when any ClusterOperator is degraded or
+// unavailable and warns when one is still progressing.
+func checkClusterOperators(params api.ToolHandlerParams) checkResult {
+	result := checkResult{name: "ClusterOperator health", status: statusPass}
+	list, err := params.DynamicClient().Resource(clusterOperatorsGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return unchecked(result, err)
+	}
+	for i := range list.Items {
+		operator := &list.Items[i]
+		conditions := conditionMap(operator, "status", "conditions")
+		switch {
+		case conditions["Degraded"] == "True":
+			result.status = statusFail
+			result.details = append(result.details, fmt.Sprintf("- %s is Degraded", operator.GetName()))
+		case conditions["Available"] == "False":
+			result.status = statusFail
+			result.details = append(result.details, fmt.Sprintf("- %s is not Available", operator.GetName()))
+		case conditions["Progressing"] == "True":
+			if result.status == statusPass {
+				result.status = statusWarn
+			}
+			result.details = append(result.details, fmt.Sprintf("- %s is still Progressing", operator.GetName()))
+		}
+	}
+	sort.Strings(result.details)
+	if len(result.details) == 0 {
+		result.details = []string{"- all ClusterOperators are Available, not Progressing, and not Degraded"}
+	}
+	return result
+}
+
+// checkDeprecatedAPIUsage warns when APIRequestCounts report recent requests
+// to API versions that an upcoming release removes. OpenShift tracks this
+// per API in status.removedInRelease, so no client-side deprecation table is
+// needed.
+func checkDeprecatedAPIUsage(params api.ToolHandlerParams) checkResult {
+	result := checkResult{name: "Deprecated API usage (APIRequestCounts)", status: statusPass}
+	list, err := params.DynamicClient().Resource(apiRequestCountsGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return unchecked(result, err)
+	}
+	for i := range list.Items {
+		count := &list.Items[i]
+		removedIn, _, _ := unstructured.NestedString(count.Object, "status", "removedInRelease")
+		if removedIn == "" {
+			continue
+		}
+		requests, _, _ := unstructured.NestedInt64(count.Object, "status", "requestCount")
+		if requests == 0 {
+			continue
+		}
+		result.status = statusWarn
+		result.details = append(result.details, fmt.Sprintf("- %s: %d request(s) in the last 24h, API removed in release %s — migrate the callers (use deprecated_apis_scan to find stored objects)",
+			count.GetName(), requests, removedIn))
+	}
+	sort.Strings(result.details)
+	if len(result.details) == 0 {
+		result.details = []string{"- no recent requests to API versions pending removal"}
+	}
+	return result
+}
+
+// checkPodDisruptionBudgets warns about PDBs that currently allow zero
+// disruptions while still covering pods: node drains stall on those during
+// the upgrade until the workload recovers or the PDB is relaxed.
+func checkPodDisruptionBudgets(params api.ToolHandlerParams) checkResult {
+	result := checkResult{name: "PodDisruptionBudget drain blockers", status: statusPass}
+	list, err := params.DynamicClient().Resource(podDisruptionBudgets).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return unchecked(result, err)
+	}
+	for i := range list.Items {
+		pdb := &list.Items[i]
+		disruptionsAllowed, found, _ := unstructured.NestedInt64(pdb.Object, "status", "disruptionsAllowed")
+		expectedPods, _, _ := unstructured.NestedInt64(pdb.Object, "status", "expectedPods")
+		if !found || disruptionsAllowed > 0 || expectedPods == 0 {
+			continue
+		}
+		result.status = statusWarn
+		result.details = append(result.details, fmt.Sprintf("- %s/%s allows 0 disruptions for %d pod(s); node drains will stall on it",
+			pdb.GetNamespace(), pdb.GetName(), expectedPods))
+	}
+	sort.Strings(result.details)
+	if len(result.details) == 0 {
+		result.details = []string{"- no PodDisruptionBudget currently blocks evictions"}
+	}
+	return result
+}
+
+// checkMachineConfigPools fails on degraded pools, warns on pools that are
+// mid-update or paused — a paused pool silently pins its nodes to the old
+// MachineConfig through the upgrade.
+func checkMachineConfigPools(params api.ToolHandlerParams) checkResult {
+	result := checkResult{name: "MachineConfigPool updates", status: statusPass}
+	list, err := params.DynamicClient().Resource(machineConfigPoolsGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return unchecked(result, err)
+	}
+	for i := range list.Items {
+		pool := &list.Items[i]
+		conditions := conditionMap(pool, "status", "conditions")
+		paused, _, _ := unstructured.NestedBool(pool.Object, "spec", "paused")
+		switch {
+		case conditions["Degraded"] == "True":
+			result.status = statusFail
+			result.details = append(result.details, fmt.Sprintf("- pool %s is Degraded", pool.GetName()))
+		case paused:
+			if result.status == statusPass {
+				result.status = statusWarn
+			}
+			result.details = append(result.details, fmt.Sprintf("- pool %s is paused; its nodes will not pick up new MachineConfigs during the upgrade", pool.GetName()))
+		case conditions["Updated"] == "False" || conditions["Updating"] == "True":
+			if result.status == statusPass {
+				result.status = statusWarn
+			}
+			result.details = append(result.details, fmt.Sprintf("- pool %s has a MachineConfig update still rolling out", pool.GetName()))
+		}
+	}
+	sort.Strings(result.details)
+	if len(result.details) == 0 {
+		result.details = []string{"- all MachineConfigPools are updated and unpaused"}
+	}
+	return result
+}
+
+// checkInstallPlans warns about manual-approval InstallPlans awaiting
+// approval: the pending operator updates they represent often carry the
+// compatibility fixes the next OpenShift release needs.
+func checkInstallPlans(params api.ToolHandlerParams) checkResult {
+	result := checkResult{name: "Unapproved OLM InstallPlans", status: statusPass}
+	list, err := params.DynamicClient().Resource(installPlansGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return unchecked(result, err)
+	}
+	for i := range list.Items {
+		plan := &list.Items[i]
+		approval, _, _ := unstructured.NestedString(plan.Object, "spec", "approval")
+		approved, _, _ := unstructured.NestedBool(plan.Object, "spec", "approved")
+		if approval != "Manual" || approved {
+			continue
+		}
+		csvs, _, _ := unstructured.NestedStringSlice(plan.Object, "spec", "clusterServiceVersionNames")
+		result.status = statusWarn
+		result.details = append(result.details, fmt.Sprintf("- %s/%s awaits manual approval (%s)",
+			plan.GetNamespace(), plan.GetName(), strings.Join(csvs, ", ")))
+	}
+	sort.Strings(result.details)
+	if len(result.details) == 0 {
+		result.details = []string{"- no InstallPlans await manual approval"}
+	}
+	return result
+}
+
+// unchecked marks a check as WARN because its API could not be queried;
+// aborting the whole preflight over one unreachable API would hide the
+// remaining results.
+func unchecked(result checkResult, err error) checkResult {
+	result.status = statusWarn
+	result.details = []string{fmt.Sprintf("- could not check: %v", err)}
+	return result
+}
+
+// conditionMap flattens an object's condition list into type→status.
+func conditionMap(obj *unstructured.Unstructured, fields ...string) map[string]string {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, fields...)
+	byType := make(map[string]string, len(conditions))
+	for _, raw := range conditions {
+		condition, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		conditionType, _ := condition["type"].(string)
+		status, _ := condition["status"].(string)
+		byType[conditionType] = status
+	}
+	return byType
+}
@@ -0,0 +1,293 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	userGVR     = schema.GroupVersionResource{Group: "user.openshift.io", Version: "v1", Resource: "users"}
+	groupGVR    = schema.GroupVersionResource{Group: "user.openshift.io", Version: "v1", Resource: "groups"}
+	identityGVR = schema.GroupVersionResource{Group: "user.openshift.io", Version: "v1", Resource: "identities"}
+)
+
+// userAPIFilter hides the tools on clusters without the OpenShift user API.
+func userAPIFilter(p api.FilteringProvider) []func() bool {
+	return []func() bool{
+		func() bool {
+			return p.AnyTargetHasGVKs(context.TODO(), []schema.GroupVersionKind{
+				{Group: "user.openshift.io", Version: "v1", Kind: "User"},
+			})
+		},
+	}
+}
+
+// Tools returns the OpenShift user and group management tools, filtered out
+// on clusters without the user.openshift.io API.
+func Tools(p api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "users_list",
+				Description: "List the OpenShift Users known to the cluster with their identities and the groups they belong to",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Users: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    usersList,
+			TargetCompatibilityFilters: userAPIFilter(p),
+		},
+		{
+			Tool: api.Tool{
+				Name:        "groups_list",
+				Description: "List the OpenShift Groups with their members, the unit of group-based RBAC bindings",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Groups: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    groupsList,
+			TargetCompatibilityFilters: userAPIFilter(p),
+		},
+		{
+			Tool: api.Tool{
+				Name:        "groups_add_member",
+				Description: "Add a user to an OpenShift Group, granting the user every RBAC binding attached to the group",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"group": {
+							Type:        "string",
+							Description: "Name of the Group",
+						},
+						"user": {
+							Type:        "string",
+							Description: "Name of the user to add",
+						},
+					},
+					Required: []string{"group", "user"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Groups: Add Member",
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    groupsAddMember,
+			TargetCompatibilityFilters: userAPIFilter(p),
+		},
+		{
+			Tool: api.Tool{
+				Name:        "groups_remove_member",
+				Description: "Remove a user from an OpenShift Group, revoking the group's RBAC bindings from the user",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"group": {
+							Type:        "string",
+							Description: "Name of the Group",
+						},
+						"user": {
+							Type:        "string",
+							Description: "Name of the user to remove",
+						},
+					},
+					Required: []string{"group", "user"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Groups: Remove Member",
+					DestructiveHint: ptr.To(true), // removing a member revokes the group's access
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    groupsRemoveMember,
+			TargetCompatibilityFilters: userAPIFilter(p),
+		},
+		{
+			Tool: api.Tool{
+				Name:        "identities_list",
+				Description: "List the OpenShift Identities (identity-provider records) and the users they map to, useful for diagnosing login and identity-provider issues",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Identities: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler:                    identitiesList,
+			TargetCompatibilityFilters: userAPIFilter(p),
+		},
+	}
+}
+
+func usersList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	users, err := params.DynamicClient().Resource(userGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list users: %w", err)), nil
+	}
+	if len(users.Items) == 0 {
+		return api.NewToolCallResult("No users found", nil), nil
+	}
+	// Index group memberships by user so each user line can show its groups.
+	groupsByUser := map[string][]string{}
+	if groups, groupsErr := params.DynamicClient().Resource(groupGVR).List(params.Context, metav1.ListOptions{}); groupsErr == nil {
+		for i := range groups.Items {
+			group := &groups.Items[i]
+			members, _, _ := unstructured.NestedStringSlice(group.Object, "users")
+			for _, member := range members {
+				groupsByUser[member] = append(groupsByUser[member], group.GetName())
+			}
+		}
+	}
+	var lines []string
+	for i := range users.Items {
+		user := &users.Items[i]
+		identities, _, _ := unstructured.NestedStringSlice(user.Object, "identities")
+		details := []string{}
+		if fullName, _, _ := unstructured.NestedString(user.Object, "fullName"); fullName != "" {
+			details = append(details, "full name: "+fullName)
+		}
+		if len(identities) > 0 {
+			details = append(details, "identities: "+strings.Join(identities, ", "))
+		}
+		if memberOf := groupsByUser[user.GetName()]; len(memberOf) > 0 {
+			sort.Strings(memberOf)
+			details = append(details, "groups: "+strings.Join(memberOf, ", "))
+		}
+		line := "- " + user.GetName()
+		if len(details) > 0 {
+			line += " (" + strings.Join(details, "; ") + ")"
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Users (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+func groupsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	groups, err := params.DynamicClient().Resource(groupGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list groups: %w", err)), nil
+	}
+	if len(groups.Items) == 0 {
+		return api.NewToolCallResult("No groups found", nil), nil
+	}
+	var lines []string
+	for i := range groups.Items {
+		group := &groups.Items[i]
+		members, _, _ := unstructured.NestedStringSlice(group.Object, "users")
+		memberSummary := "no members"
+		if len(members) > 0 {
+			memberSummary = fmt.Sprintf("%d member(s): %s", len(members), strings.Join(members, ", "))
+		}
+		lines = append(lines, fmt.Sprintf("- %s (%s)", group.GetName(), memberSummary))
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Groups (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil), nil
+}
+
+func groupsAddMember(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	groupName := p.RequiredString("group")
+	user := p.RequiredString("user")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to add group member: %w", err)), nil
+	}
+	group, err := params.DynamicClient().Resource(groupGVR).Get(params.Context, groupName, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get group %s: %w", groupName, err)), nil
+	}
+	members, _, _ := unstructured.NestedStringSlice(group.Object, "users")
+	if slices.Contains(members, user) {
+		return api.NewToolCallResult(fmt.Sprintf("User %s is already a member of group %s", user, groupName), nil), nil
+	}
+	members = append(members, user)
+	if err = unstructured.SetNestedStringSlice(group.Object, members, "users"); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update group %s members: %w", groupName, err)), nil
+	}
+	if _, err = params.DynamicClient().Resource(groupGVR).Update(params.Context, group, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update group %s: %w", groupName, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("User %s added to group %s", user, groupName), nil), nil
+}
+
+func groupsRemoveMember(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	groupName := p.RequiredString("group")
+	user := p.RequiredString("user")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to remove group member: %w", err)), nil
+	}
+	group, err := params.DynamicClient().Resource(groupGVR).Get(params.Context, groupName, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get group %s: %w", groupName, err)), nil
+	}
+	members, _, _ := unstructured.NestedStringSlice(group.Object, "users")
+	index := slices.Index(members, user)
+	if index < 0 {
+		return api.NewToolCallResult("", fmt.Errorf("user %s is not a member of group %s", user, groupName)), nil
+	}
+	members = slices.Delete(members, index, index+1)
+	if err = unstructured.SetNestedStringSlice(group.Object, members, "users"); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update group %s members: %w", groupName, err)), nil
+	}
+	if _, err = params.DynamicClient().Resource(groupGVR).Update(params.Context, group, metav1.UpdateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update group %s: %w", groupName, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("User %s removed from group %s", user, groupName), nil), nil
+}
+
+func identitiesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	identities, err := params.DynamicClient().Resource(identityGVR).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list identities: %w", err)), nil
+	}
+	if len(identities.Items) == 0 {
+		return api.NewToolCallResult("No identities found", nil), nil
+	}
+	var lines []string
+	for i := range identities.Items {
+		identity := &identities.Items[i]
+		providerName, _, _ := unstructured.NestedString(identity.Object, "providerName")
+		providerUserName, _, _ := unstructured.NestedString(identity.Object, "providerUserName")
+		mappedUser, _, _ := unstructured.NestedString(identity.Object, "user", "name")
+		if mappedUser == "" {
+			mappedUser = "no user mapped"
+		} else {
+			mappedUser = "user: " + mappedUser
+		}
+		lines = append(lines, fmt.Sprintf("- %s (provider: %s, provider user: %s, %s)", identity.GetName(), providerName, providerUserName, mappedUser))
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Identities (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil), nil
+}
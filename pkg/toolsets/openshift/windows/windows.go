@@ -0,0 +1,262 @@
+package windows
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	nodesGVR       = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}
+	deploymentsGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	csvsGVR        = schema.GroupVersionResource{Group: "operators.coreos.com", Version: "v1alpha1", Resource: "clusterserviceversions"}
+)
+
+const (
+	// osLabel selects nodes by operating system.
+	osLabel = "kubernetes.io/os"
+	// wmcoVersionAnnotation is put on Windows nodes by the Windows Machine
+	// Config Operator once it has configured them.
+	wmcoVersionAnnotation = "windowsmachineconfig.openshift.io/version"
+	// wmcoNamespace is the namespace the Windows Machine Config Operator is
+	// installed in.
+	wmcoNamespace = "openshift-windows-machine-config-operator"
+)
+
+// Tools returns the Windows node support tools for mixed-OS clusters.
+func Tools(_ api.FilteringProvider) []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name: "windows_nodes_list",
+				Description: "List the Windows nodes of the cluster with their readiness, OS image, kubelet version, " +
+					"and the Windows Machine Config Operator version that configured them",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Windows: Nodes List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: windowsNodesList,
+		},
+		{
+			Tool: api.Tool{
+				Name: "windows_wmco_status",
+				Description: "Get the status of the Windows Machine Config Operator (WMCO): its ClusterServiceVersion phase and the readiness of its Deployment, " +
+					"to confirm the cluster can configure Windows nodes",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Windows: WMCO Status",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: wmcoStatus,
+		},
+		{
+			Tool: api.Tool{
+				Name: "windows_schedule_check",
+				Description: "Check whether a Deployment is ready to schedule onto the Windows nodes of a mixed-OS cluster: " +
+					"the " + osLabel + " node selector, the toleration for the Windows node taint, and pod spec constructs that only work on Linux nodes",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the Deployment",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the Deployment to check",
+						},
+					},
+					Required: []string{"namespace", "name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Windows: Schedule Check",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: windowsScheduleCheck,
+		},
+	}
+}
+
+func windowsNodesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	list, err := params.DynamicClient().Resource(nodesGVR).List(params.Context, metav1.ListOptions{LabelSelector: osLabel + "=windows"})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list Windows nodes: %w", err)), nil
+	}
+	if len(list.Items) == 0 {
+		return api.NewToolCallResult("The cluster has no Windows nodes (label "+osLabel+"=windows)", nil), nil
+	}
+	var lines []string
+	for i := range list.Items {
+		node := &list.Items[i]
+		ready := "NotReady"
+		conditions, _, _ := unstructured.NestedSlice(node.Object, "status", "conditions")
+		for _, raw := range conditions {
+			condition, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			if conditionType, _ := condition["type"].(string); conditionType == "Ready" {
+				if status, _ := condition["status"].(string); status == "True" {
+					ready = "Ready"
+				}
+			}
+		}
+		osImage, _, _ := unstructured.NestedString(node.Object, "status", "nodeInfo", "osImage")
+		kubeletVersion, _, _ := unstructured.NestedString(node.Object, "status", "nodeInfo", "kubeletVersion")
+		line := fmt.Sprintf("- %s (%s)\n  os: %s, kubelet: %s", node.GetName(), ready, osImage, kubeletVersion)
+		if wmcoVersion := node.GetAnnotations()[wmcoVersionAnnotation]; wmcoVersion != "" {
+			line += ", configured by WMCO " + wmcoVersion
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	return api.NewToolCallResult(fmt.Sprintf("Windows nodes (%d):\n%s", len(list.Items), strings.Join(lines, "\n")), nil), nil
+}
+
+func wmcoStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	var lines []string
+
+	csvs, err := params.DynamicClient().Resource(csvsGVR).Namespace(wmcoNamespace).List(params.Context, metav1.ListOptions{})
+	if err == nil {
+		for i := range csvs.Items {
+			csv := &csvs.Items[i]
+			if !strings.HasPrefix(csv.GetName(), "windows-machine-config-operator") {
+				continue
+			}
+			phase, _, _ := unstructured.NestedString(csv.Object, "status", "phase")
+			version, _, _ := unstructured.NestedString(csv.Object, "spec", "version")
+			lines = append(lines, fmt.Sprintf("- ClusterServiceVersion %s (version %s): %s", csv.GetName(), version, phase))
+		}
+	}
+
+	deployment, err := params.DynamicClient().Resource(deploymentsGVR).Namespace(wmcoNamespace).Get(params.Context, "windows-machine-config-operator", metav1.GetOptions{})
+	if err != nil {
+		if len(lines) == 0 {
+			return api.NewToolCallResult("The Windows Machine Config Operator is not installed; the cluster cannot configure Windows nodes", nil), nil
+		}
+	} else {
+		readyReplicas, _, _ := unstructured.NestedInt64(deployment.Object, "status", "readyReplicas")
+		replicas, _, _ := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+		lines = append(lines, fmt.Sprintf("- Deployment %s/windows-machine-config-operator: %d/%d replicas ready", wmcoNamespace, readyReplicas, replicas))
+	}
+
+	return api.NewToolCallResult("Windows Machine Config Operator status:\n"+strings.Join(lines, "\n"), nil), nil
+}
+
+func windowsScheduleCheck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.RequiredString("namespace")
+	name := p.RequiredString("name")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check Windows scheduling: %w", err)), nil
+	}
+
+	deployment, err := params.DynamicClient().Resource(deploymentsGVR).Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get Deployment %s/%s: %w", namespace, name, err)), nil
+	}
+
+	var blockers, warnings []string
+
+	nodeSelector, _, _ := unstructured.NestedStringMap(deployment.Object, "spec", "template", "spec", "nodeSelector")
+	switch nodeSelector[osLabel] {
+	case "windows":
+		// Correctly targets Windows nodes.
+	case "":
+		blockers = append(blockers, fmt.Sprintf("- the pod template has no %s: windows node selector; the scheduler may place pods on Linux nodes where the Windows image cannot run", osLabel))
+	default:
+		blockers = append(blockers, fmt.Sprintf("- the pod template selects %s: %s nodes; change the node selector to %s: windows", osLabel, nodeSelector[osLabel], osLabel))
+	}
+
+	// WMCO taints Windows nodes with os=Windows:NoSchedule so Linux
+	// workloads don't land on them by accident.
+	tolerated := false
+	tolerations, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "tolerations")
+	for _, raw := range tolerations {
+		toleration, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		key, _ := toleration["key"].(string)
+		value, _ := toleration["value"].(string)
+		operator, _ := toleration["operator"].(string)
+		if (key == "os" && (value == "Windows" || operator == "Exists")) || (key == "" && operator == "Exists") {
+			tolerated = true
+		}
+	}
+	if !tolerated {
+		blockers = append(blockers, `- no toleration for the os=Windows:NoSchedule taint WMCO puts on Windows nodes; add a toleration with key "os" and value "Windows"`)
+	}
+
+	containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	initContainers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "initContainers")
+	for _, raw := range append(containers, initContainers...) {
+		container, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		containerName, _ := container["name"].(string)
+		if privileged, _, _ := unstructured.NestedBool(container, "securityContext", "privileged"); privileged {
+			blockers = append(blockers, fmt.Sprintf("- container %s is privileged; Windows containers do not support privileged mode (use a HostProcess container instead)", containerName))
+		}
+		if _, found, _ := unstructured.NestedInt64(container, "securityContext", "runAsUser"); found {
+			blockers = append(blockers, fmt.Sprintf("- container %s sets runAsUser; Windows containers use runAsUserName with a Windows account instead of a numeric UID", containerName))
+		}
+		if _, found, _ := unstructured.NestedMap(container, "securityContext", "seLinuxOptions"); found {
+			blockers = append(blockers, fmt.Sprintf("- container %s sets seLinuxOptions; SELinux does not exist on Windows nodes", containerName))
+		}
+		image, _ := container["image"].(string)
+		if image != "" && !strings.Contains(image, "mcr.microsoft.com") && !strings.Contains(strings.ToLower(image), "windows") {
+			warnings = append(warnings, fmt.Sprintf("- container %s runs image %s, which does not look like a Windows image; verify it provides a Windows variant for the node's OS build", containerName, image))
+		}
+	}
+
+	volumes, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "volumes")
+	for _, raw := range volumes {
+		volume, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if path, _, _ := unstructured.NestedString(volume, "hostPath", "path"); path != "" && strings.HasPrefix(path, "/") {
+			volumeName, _ := volume["name"].(string)
+			warnings = append(warnings, fmt.Sprintf("- volume %s mounts hostPath %s; Windows nodes use drive-letter paths (e.g. C:\\...)", volumeName, path))
+		}
+	}
+
+	if len(blockers) == 0 && len(warnings) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("Deployment %s/%s is ready to schedule onto Windows nodes", namespace, name), nil), nil
+	}
+	var sections []string
+	if len(blockers) > 0 {
+		sections = append(sections, fmt.Sprintf("Blockers (%d):\n%s", len(blockers), strings.Join(blockers, "\n")))
+	}
+	if len(warnings) > 0 {
+		sections = append(sections, fmt.Sprintf("Warnings (%d):\n%s", len(warnings), strings.Join(warnings, "\n")))
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Windows scheduling check for Deployment %s/%s:\n\n%s", namespace, name, strings.Join(sections, "\n\n")), nil), nil
+}
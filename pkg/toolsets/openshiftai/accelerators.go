@@ -0,0 +1,151 @@
+package openshiftai
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var acceleratorProfileGVR = schema.GroupVersionResource{Group: "dashboard.opendatahub.io", Version: "v1", Resource: "acceleratorprofiles"}
+
+// acceleratorResource reports whether a resource name refers to a GPU or
+// other accelerator extended resource (nvidia.com/gpu, amd.com/gpu,
+// habana.ai/gaudi, ...).
+func acceleratorResource(name corev1.ResourceName) bool {
+	resource := string(name)
+	if !strings.Contains(resource, "/") {
+		return false
+	}
+	return strings.Contains(resource, "gpu") ||
+		strings.HasPrefix(resource, "habana.ai/") ||
+		strings.HasPrefix(resource, "xilinx.com/")
+}
+
+func initAccelerators() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "accelerators_inventory",
+				Description: "Report GPU and accelerator inventory: capacity and allocatable extended resources per node (nvidia.com/gpu and similar), the pods currently consuming them, and the AcceleratorProfile definitions — answers whether GPUs are free for a new workload",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Accelerators: Inventory",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: acceleratorsInventory,
+		},
+	}
+}
+
+func acceleratorsInventory(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	nodes, err := params.CoreV1().Nodes().List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list nodes for accelerator inventory: %w", err)), nil
+	}
+
+	// Requested accelerator quantities per node, aggregated from running pods.
+	requestedByNode := map[string]map[corev1.ResourceName]int64{}
+	var consumers []string
+	pods, err := params.CoreV1().Pods("").List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods for accelerator inventory: %w", err)), nil
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		podTotal := int64(0)
+		for _, container := range pod.Spec.Containers {
+			for resource, quantity := range container.Resources.Requests {
+				if !acceleratorResource(resource) {
+					continue
+				}
+				if requestedByNode[pod.Spec.NodeName] == nil {
+					requestedByNode[pod.Spec.NodeName] = map[corev1.ResourceName]int64{}
+				}
+				requestedByNode[pod.Spec.NodeName][resource] += quantity.Value()
+				podTotal += quantity.Value()
+			}
+		}
+		if podTotal > 0 {
+			consumers = append(consumers, fmt.Sprintf("- %s/%s on node %s (%d accelerator(s))", pod.Namespace, pod.Name, pod.Spec.NodeName, podTotal))
+		}
+	}
+
+	var nodeLines []string
+	totalAllocatable := int64(0)
+	totalRequested := int64(0)
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		var resources []string
+		for resource, capacity := range node.Status.Capacity {
+			if !acceleratorResource(resource) {
+				continue
+			}
+			allocatable := node.Status.Allocatable[resource]
+			requested := requestedByNode[node.Name][resource]
+			free := allocatable.Value() - requested
+			totalAllocatable += allocatable.Value()
+			totalRequested += requested
+			resources = append(resources, fmt.Sprintf("%s capacity=%s allocatable=%s requested=%d free=%d", resource, capacity.String(), allocatable.String(), requested, free))
+		}
+		if len(resources) > 0 {
+			sort.Strings(resources)
+			nodeLines = append(nodeLines, fmt.Sprintf("- %s: %s", node.Name, strings.Join(resources, ", ")))
+		}
+	}
+
+	if len(nodeLines) == 0 {
+		return api.NewToolCallResult("No GPU or accelerator extended resources found on any node", nil), nil
+	}
+	sort.Strings(nodeLines)
+	sort.Strings(consumers)
+
+	var sections []string
+	sections = append(sections, fmt.Sprintf("Accelerator inventory (%d allocatable, %d requested, %d free cluster-wide):\n%s",
+		totalAllocatable, totalRequested, totalAllocatable-totalRequested, strings.Join(nodeLines, "\n")))
+	if len(consumers) > 0 {
+		sections = append(sections, fmt.Sprintf("Accelerator-consuming pods:\n%s", strings.Join(consumers, "\n")))
+	} else {
+		sections = append(sections, "No pods are currently consuming accelerators")
+	}
+	if profiles := acceleratorProfiles(params); len(profiles) > 0 {
+		sections = append(sections, fmt.Sprintf("AcceleratorProfiles:\n%s", strings.Join(profiles, "\n")))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// acceleratorProfiles lists the OpenShift AI AcceleratorProfile definitions,
+// returning nil when the CRD is not installed.
+func acceleratorProfiles(params api.ToolHandlerParams) []string {
+	profiles, err := params.DynamicClient().Resource(acceleratorProfileGVR).Namespace("").List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+	var lines []string
+	for i := range profiles.Items {
+		profile := &profiles.Items[i]
+		identifier, _, _ := unstructured.NestedString(profile.Object, "spec", "identifier")
+		displayName, _, _ := unstructured.NestedString(profile.Object, "spec", "displayName")
+		enabled, _, _ := unstructured.NestedBool(profile.Object, "spec", "enabled")
+		lines = append(lines, fmt.Sprintf("- %s (resource: %s, enabled: %t)", displayName, identifier, enabled))
+	}
+	sort.Strings(lines)
+	return lines
+}
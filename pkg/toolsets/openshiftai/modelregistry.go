@@ -0,0 +1,277 @@
+package openshiftai
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var modelRegistryGVR = schema.GroupVersionResource{Group: "modelregistry.opendatahub.io", Version: "v1alpha1", Resource: "modelregistries"}
+
+// modelRegistryAPIPrefix is the REST path prefix of the Model Registry service.
+const modelRegistryAPIPrefix = "/api/model_registry/v1alpha3"
+
+func initModelRegistry() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "model_registry_models_list",
+				Description: "List the registered models in an OpenShift AI Model Registry (authenticated with the caller's token)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"registry": {
+							Type:        "string",
+							Description: "Name of the ModelRegistry instance (Optional, the first registry found is used if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Model Registry: List Models",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: modelRegistryModelsList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "model_registry_versions_list",
+				Description: "List the versions of a registered model in an OpenShift AI Model Registry, including their model artifacts",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"registry": {
+							Type:        "string",
+							Description: "Name of the ModelRegistry instance (Optional, the first registry found is used if not provided)",
+						},
+						"model_id": {
+							Type:        "string",
+							Description: "ID of the registered model (from model_registry_models_list)",
+						},
+					},
+					Required: []string{"model_id"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Model Registry: List Versions",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: modelRegistryVersionsList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "model_registry_register",
+				Description: "Register a model version in an OpenShift AI Model Registry: creates the registered model if needed, adds the version, and records the model artifact URI",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"registry": {
+							Type:        "string",
+							Description: "Name of the ModelRegistry instance (Optional, the first registry found is used if not provided)",
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name of the model to register",
+						},
+						"version": {
+							Type:        "string",
+							Description: "Version label (e.g. v1, 2024-06-01)",
+						},
+						"uri": {
+							Type:        "string",
+							Description: "Storage URI of the model artifacts (e.g. s3://bucket/path)",
+						},
+						"description": {
+							Type:        "string",
+							Description: "Description of the model version (Optional)",
+						},
+					},
+					Required: []string{"name", "version", "uri"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Model Registry: Register",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: modelRegistryRegister,
+		},
+	}
+}
+
+// modelRegistryBaseURL resolves the external REST URL of a ModelRegistry
+// instance by locating its Route (the operator exposes the REST API via a
+// Route named "<registry>-https" or "<registry>" in the registry namespace).
+func modelRegistryBaseURL(params api.ToolHandlerParams, registry string) (string, error) {
+	registries, err := params.DynamicClient().Resource(modelRegistryGVR).Namespace("").List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list ModelRegistry instances: %w", err)
+	}
+	if len(registries.Items) == 0 {
+		return "", fmt.Errorf("no ModelRegistry instance found in the cluster")
+	}
+	var instance *unstructured.Unstructured
+	if registry == "" {
+		instance = &registries.Items[0]
+	} else {
+		for i := range registries.Items {
+			if registries.Items[i].GetName() == registry {
+				instance = &registries.Items[i]
+				break
+			}
+		}
+		if instance == nil {
+			return "", fmt.Errorf("ModelRegistry '%s' not found", registry)
+		}
+	}
+	namespace := instance.GetNamespace()
+	for _, routeName := range []string{instance.GetName() + "-https", instance.GetName()} {
+		route, routeErr := params.DynamicClient().Resource(routeGVR).Namespace(namespace).Get(params.Context, routeName, metav1.GetOptions{})
+		if routeErr != nil {
+			continue
+		}
+		if host, _, _ := unstructured.NestedString(route.Object, "spec", "host"); host != "" {
+			return "https://" + host, nil
+		}
+	}
+	return "", fmt.Errorf("no Route found for ModelRegistry '%s/%s'", namespace, instance.GetName())
+}
+
+func modelRegistryModelsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	registry := p.OptionalString("registry", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list registered models: %w", err)), nil
+	}
+
+	baseURL, err := modelRegistryBaseURL(params, registry)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list registered models: %w", err)), nil
+	}
+	response, err := restRequest(params, http.MethodGet, baseURL, modelRegistryAPIPrefix+"/registered_models", nil)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list registered models: %w", err)), nil
+	}
+	items, _ := response["items"].([]interface{})
+	if len(items) == 0 {
+		return api.NewToolCallResult("No registered models found", nil), nil
+	}
+	lines := []string{fmt.Sprintf("%d registered model(s):", len(items))}
+	for _, item := range items {
+		model, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := model["id"].(string)
+		name, _ := model["name"].(string)
+		state, _ := model["state"].(string)
+		lines = append(lines, fmt.Sprintf("- %s (id: %s, state: %s)", name, id, state))
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
+
+func modelRegistryVersionsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	registry := p.OptionalString("registry", "")
+	modelID := p.RequiredString("model_id")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list model versions: %w", err)), nil
+	}
+
+	baseURL, err := modelRegistryBaseURL(params, registry)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list model versions: %w", err)), nil
+	}
+	response, err := restRequest(params, http.MethodGet, baseURL, modelRegistryAPIPrefix+"/registered_models/"+modelID+"/versions", nil)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list versions of model '%s': %w", modelID, err)), nil
+	}
+	items, _ := response["items"].([]interface{})
+	if len(items) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No versions found for model '%s'", modelID), nil), nil
+	}
+	lines := []string{fmt.Sprintf("%d version(s) of model '%s':", len(items), modelID)}
+	for _, item := range items {
+		version, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := version["id"].(string)
+		name, _ := version["name"].(string)
+		line := fmt.Sprintf("- %s (id: %s)", name, id)
+		if artifacts, artifactsErr := restRequest(params, http.MethodGet, baseURL, modelRegistryAPIPrefix+"/model_versions/"+id+"/artifacts", nil); artifactsErr == nil {
+			if artifactItems, ok := artifacts["items"].([]interface{}); ok {
+				for _, artifactItem := range artifactItems {
+					if artifact, ok := artifactItem.(map[string]interface{}); ok {
+						if uri, _ := artifact["uri"].(string); uri != "" {
+							line += " " + uri
+						}
+					}
+				}
+			}
+		}
+		lines = append(lines, line)
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
+
+func modelRegistryRegister(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	registry := p.OptionalString("registry", "")
+	name := p.RequiredString("name")
+	version := p.RequiredString("version")
+	uri := p.RequiredString("uri")
+	description := p.OptionalString("description", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to register model: %w", err)), nil
+	}
+
+	baseURL, err := modelRegistryBaseURL(params, registry)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to register model: %w", err)), nil
+	}
+
+	model, err := restRequest(params, http.MethodPost, baseURL, modelRegistryAPIPrefix+"/registered_models", map[string]interface{}{
+		"name":        name,
+		"description": description,
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to register model '%s': %w", name, err)), nil
+	}
+	modelID, _ := model["id"].(string)
+
+	modelVersion, err := restRequest(params, http.MethodPost, baseURL, modelRegistryAPIPrefix+"/model_versions", map[string]interface{}{
+		"name":              version,
+		"registeredModelId": modelID,
+		"description":       description,
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create version '%s' of model '%s': %w", version, name, err)), nil
+	}
+	versionID, _ := modelVersion["id"].(string)
+
+	if _, err = restRequest(params, http.MethodPost, baseURL, modelRegistryAPIPrefix+"/model_versions/"+versionID+"/artifacts", map[string]interface{}{
+		"artifactType": "model-artifact",
+		"name":         name,
+		"uri":          uri,
+	}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to record artifact for model '%s' version '%s': %w", name, version, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Model '%s' version '%s' registered (model id: %s, version id: %s, artifact: %s)", name, version, modelID, versionID, uri), nil), nil
+}
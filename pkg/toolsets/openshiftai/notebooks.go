@@ -0,0 +1,285 @@
+package openshiftai
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var (
+	notebookGVR = schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "notebooks"}
+	routeGVR    = schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+)
+
+// stoppedAnnotation is the annotation the notebook controller watches: when
+// present (value is a timestamp) the workbench is scaled down; removing it
+// starts the workbench again.
+const stoppedAnnotation = "kubeflow-resource-stopped"
+
+// workbenchSizes maps the dashboard size names to container resources
+// (requests/limits) following the OpenShift AI dashboard defaults.
+var workbenchSizes = map[string]map[string]interface{}{
+	"small": {
+		"requests": map[string]interface{}{"cpu": "1", "memory": "8Gi"},
+		"limits":   map[string]interface{}{"cpu": "2", "memory": "8Gi"},
+	},
+	"medium": {
+		"requests": map[string]interface{}{"cpu": "3", "memory": "24Gi"},
+		"limits":   map[string]interface{}{"cpu": "6", "memory": "24Gi"},
+	},
+	"large": {
+		"requests": map[string]interface{}{"cpu": "7", "memory": "56Gi"},
+		"limits":   map[string]interface{}{"cpu": "14", "memory": "56Gi"},
+	},
+}
+
+func initNotebooks() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "notebooks_list",
+				Description: "List OpenShift AI workbenches (kubeflow Notebook custom resources) with their running state and route URL",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace (data science project) to list workbenches from (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Notebooks: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: notebooksList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "notebooks_create",
+				Description: "Create an OpenShift AI workbench (kubeflow Notebook) with the given image and size, optionally mounting an existing PersistentVolumeClaim as the workspace volume. Idle culling follows the cluster-wide notebook culler configuration",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the workbench",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace (data science project) to create the workbench in (Optional, current namespace if not provided)",
+						},
+						"image": {
+							Type:        "string",
+							Description: "Notebook container image reference (e.g. an ImageStream tag resolved image or a full registry reference)",
+						},
+						"size": {
+							Type:        "string",
+							Description: "Workbench size preset determining CPU/memory (Optional, defaults to small)",
+							Enum:        []any{"small", "medium", "large"},
+						},
+						"pvc": {
+							Type:        "string",
+							Description: "Name of an existing PersistentVolumeClaim to mount at /opt/app-root/src as the workspace volume (Optional)",
+						},
+					},
+					Required: []string{"name", "image"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Notebooks: Create",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: notebooksCreate,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "notebooks_lifecycle",
+				Description: "Start or stop an OpenShift AI workbench (kubeflow Notebook) by toggling the kubeflow-resource-stopped annotation",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the workbench",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace (data science project) of the workbench (Optional, current namespace if not provided)",
+						},
+						"action": {
+							Type:        "string",
+							Description: "The lifecycle action to perform: 'start' (removes the stop annotation) or 'stop' (sets the stop annotation)",
+							Enum:        []any{"start", "stop"},
+						},
+					},
+					Required: []string{"name", "action"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Notebooks: Lifecycle",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: notebooksLifecycle,
+		},
+	}
+}
+
+func notebooksList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list workbenches: %w", err)), nil
+	}
+
+	notebooks, err := params.DynamicClient().Resource(notebookGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list workbenches: %w", err)), nil
+	}
+	if len(notebooks.Items) == 0 {
+		return api.NewToolCallResult("No workbenches found", nil), nil
+	}
+
+	lines := make([]string, 0, len(notebooks.Items)+1)
+	lines = append(lines, fmt.Sprintf("%d workbench(es):", len(notebooks.Items)))
+	for i := range notebooks.Items {
+		notebook := &notebooks.Items[i]
+		state := "running"
+		if _, stopped := notebook.GetAnnotations()[stoppedAnnotation]; stopped {
+			state = "stopped"
+		}
+		line := fmt.Sprintf("- %s/%s (%s)", notebook.GetNamespace(), notebook.GetName(), state)
+		if url := notebookURL(params, notebook.GetNamespace(), notebook.GetName()); url != "" {
+			line += " " + url
+		}
+		lines = append(lines, line)
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
+
+func notebooksCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	image := p.RequiredString("image")
+	size := p.OptionalString("size", "small")
+	pvc := p.OptionalString("pvc", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create workbench: %w", err)), nil
+	}
+	resources, ok := workbenchSizes[size]
+	if !ok {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create workbench: unknown size '%s'", size)), nil
+	}
+
+	container := map[string]interface{}{
+		"name":      name,
+		"image":     image,
+		"resources": resources,
+	}
+	podSpec := map[string]interface{}{
+		"containers": []interface{}{container},
+	}
+	if pvc != "" {
+		container["volumeMounts"] = []interface{}{
+			map[string]interface{}{"name": "workspace", "mountPath": "/opt/app-root/src"},
+		}
+		podSpec["volumes"] = []interface{}{
+			map[string]interface{}{
+				"name":                  "workspace",
+				"persistentVolumeClaim": map[string]interface{}{"claimName": pvc},
+			},
+		}
+	}
+	notebook := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kubeflow.org/v1",
+		"kind":       "Notebook",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+			"labels": map[string]interface{}{
+				dashboardLabel: "true",
+			},
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": podSpec,
+			},
+		},
+	}}
+
+	if _, err := params.DynamicClient().Resource(notebookGVR).Namespace(namespace).Create(params.Context, notebook, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create workbench '%s/%s': %w", namespace, name, err)), nil
+	}
+	result := fmt.Sprintf("Workbench '%s' created in namespace '%s' (size: %s)", name, namespace, size)
+	if url := notebookURL(params, namespace, name); url != "" {
+		result += "\nURL: " + url
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
+
+func notebooksLifecycle(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	action := p.RequiredString("action")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to update workbench: %w", err)), nil
+	}
+
+	client := params.DynamicClient().Resource(notebookGVR).Namespace(namespace)
+	var patch []byte
+	switch action {
+	case "stop":
+		patch = []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, stoppedAnnotation, time.Now().UTC().Format(time.RFC3339)))
+	case "start":
+		patch = []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, stoppedAnnotation))
+	default:
+		return api.NewToolCallResult("", fmt.Errorf("invalid action '%s': must be one of 'start', 'stop'", action)), nil
+	}
+	if _, err := client.Patch(params.Context, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to %s workbench '%s/%s': %w", action, namespace, name, err)), nil
+	}
+
+	if action == "stop" {
+		return api.NewToolCallResult(fmt.Sprintf("Workbench '%s' in namespace '%s' stopped", name, namespace), nil), nil
+	}
+	result := fmt.Sprintf("Workbench '%s' in namespace '%s' started", name, namespace)
+	if url := notebookURL(params, namespace, name); url != "" {
+		result += "\nURL: " + url
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
+
+// notebookURL returns the https URL of the OpenShift Route the notebook
+// controller creates for a workbench, or "" if no route exists (yet).
+func notebookURL(params api.ToolHandlerParams, namespace, name string) string {
+	route, err := params.DynamicClient().Resource(routeGVR).Namespace(namespace).Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return ""
+	}
+	host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+	if host == "" {
+		return ""
+	}
+	return "https://" + host + "/notebook/" + namespace + "/" + name
+}
@@ -0,0 +1,347 @@
+package openshiftai
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var dspaGVR = schema.GroupVersionResource{Group: "datasciencepipelinesapplications.opendatahub.io", Version: "v1alpha1", Resource: "datasciencepipelinesapplications"}
+
+func initPipelines() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "pipelines_list",
+				Description: "List Data Science Pipelines: the DataSciencePipelinesApplication instances in a namespace and, for each ready instance, the pipelines registered in its Kubeflow Pipelines API (authenticated with the caller's token)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace (data science project) to inspect (Optional, current namespace if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Pipelines: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: pipelinesList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "pipeline_runs_list",
+				Description: "List Kubeflow Pipelines runs for a Data Science Pipelines instance, with their state",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace (data science project) of the pipelines instance (Optional, current namespace if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Pipeline Runs: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: pipelineRunsList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "pipeline_runs_start",
+				Description: "Start a Kubeflow Pipelines run for a registered pipeline with optional parameters",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace (data science project) of the pipelines instance (Optional, current namespace if not provided)",
+						},
+						"pipeline_id": {
+							Type:        "string",
+							Description: "ID of the pipeline to run (from pipelines_list)",
+						},
+						"display_name": {
+							Type:        "string",
+							Description: "Display name for the run (Optional, defaults to the pipeline ID)",
+						},
+						"params": {
+							Type:        "object",
+							Description: "Runtime parameters to pass to the pipeline run (Optional)",
+							Properties:  make(map[string]*jsonschema.Schema),
+						},
+					},
+					Required: []string{"pipeline_id"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Pipeline Runs: Start",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: pipelineRunsStart,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "pipeline_runs_get",
+				Description: "Get the status and details of a Kubeflow Pipelines run, including per-task states and error messages",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace (data science project) of the pipelines instance (Optional, current namespace if not provided)",
+						},
+						"run_id": {
+							Type:        "string",
+							Description: "ID of the run (from pipeline_runs_list)",
+						},
+					},
+					Required: []string{"run_id"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Pipeline Runs: Get",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: pipelineRunsGet,
+		},
+	}
+}
+
+// kfpBaseURL resolves the external URL of the Kubeflow Pipelines API served
+// by the DataSciencePipelinesApplication in the namespace. The DSPA operator
+// exposes the API via a Route named "ds-pipeline-<dspa-name>".
+func kfpBaseURL(params api.ToolHandlerParams, namespace string) (string, error) {
+	dspas, err := params.DynamicClient().Resource(dspaGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list DataSciencePipelinesApplications in namespace '%s': %w", namespace, err)
+	}
+	if len(dspas.Items) == 0 {
+		return "", fmt.Errorf("no DataSciencePipelinesApplication found in namespace '%s'", namespace)
+	}
+	dspaName := dspas.Items[0].GetName()
+	route, err := params.DynamicClient().Resource(routeGVR).Namespace(namespace).Get(params.Context, "ds-pipeline-"+dspaName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get pipelines API route for DSPA '%s/%s': %w", namespace, dspaName, err)
+	}
+	host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+	if host == "" {
+		return "", fmt.Errorf("pipelines API route for DSPA '%s/%s' has no host", namespace, dspaName)
+	}
+	return "https://" + host, nil
+}
+
+// restRequest performs an authenticated request against an OpenShift AI REST
+// service (Kubeflow Pipelines, Model Registry), forwarding the caller's
+// bearer token, and decodes the JSON response.
+func restRequest(params api.ToolHandlerParams, method, baseURL, endpoint string, body any) (map[string]interface{}, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(params.Context, method, baseURL+endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+	if token := params.RESTConfig().BearerToken; token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("pipelines API returned %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	result := map[string]interface{}{}
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode pipelines API response: %w", err)
+		}
+	}
+	return result, nil
+}
+
+func pipelinesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pipelines: %w", err)), nil
+	}
+
+	baseURL, err := kfpBaseURL(params, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pipelines: %w", err)), nil
+	}
+	response, err := restRequest(params, http.MethodGet, baseURL, "/apis/v2beta1/pipelines", nil)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pipelines: %w", err)), nil
+	}
+
+	pipelines, _ := response["pipelines"].([]interface{})
+	if len(pipelines) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No pipelines registered in namespace '%s' (API: %s)", namespace, baseURL), nil), nil
+	}
+	lines := []string{fmt.Sprintf("%d pipeline(s) in namespace '%s':", len(pipelines), namespace)}
+	for _, item := range pipelines {
+		pipeline, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := pipeline["pipeline_id"].(string)
+		displayName, _ := pipeline["display_name"].(string)
+		lines = append(lines, fmt.Sprintf("- %s (id: %s)", displayName, id))
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
+
+func pipelineRunsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pipeline runs: %w", err)), nil
+	}
+
+	baseURL, err := kfpBaseURL(params, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pipeline runs: %w", err)), nil
+	}
+	response, err := restRequest(params, http.MethodGet, baseURL, "/apis/v2beta1/runs", nil)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pipeline runs: %w", err)), nil
+	}
+
+	runs, _ := response["runs"].([]interface{})
+	if len(runs) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No pipeline runs found in namespace '%s'", namespace), nil), nil
+	}
+	lines := []string{fmt.Sprintf("%d pipeline run(s) in namespace '%s':", len(runs), namespace)}
+	for _, item := range runs {
+		run, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := run["run_id"].(string)
+		displayName, _ := run["display_name"].(string)
+		state, _ := run["state"].(string)
+		lines = append(lines, fmt.Sprintf("- %s (id: %s, state: %s)", displayName, id, state))
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
+
+func pipelineRunsStart(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	pipelineID := p.RequiredString("pipeline_id")
+	displayName := p.OptionalString("display_name", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to start pipeline run: %w", err)), nil
+	}
+	if displayName == "" {
+		displayName = pipelineID
+	}
+
+	baseURL, err := kfpBaseURL(params, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to start pipeline run: %w", err)), nil
+	}
+	body := map[string]interface{}{
+		"display_name":               displayName,
+		"pipeline_version_reference": map[string]interface{}{"pipeline_id": pipelineID},
+	}
+	if runtimeParams, ok := params.GetArguments()["params"].(map[string]interface{}); ok && len(runtimeParams) > 0 {
+		body["runtime_config"] = map[string]interface{}{"parameters": runtimeParams}
+	}
+	response, err := restRequest(params, http.MethodPost, baseURL, "/apis/v2beta1/runs", body)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to start pipeline run: %w", err)), nil
+	}
+	runID, _ := response["run_id"].(string)
+	return api.NewToolCallResult(fmt.Sprintf("Pipeline run '%s' started (run id: %s)", displayName, runID), nil), nil
+}
+
+func pipelineRunsGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	runID := p.RequiredString("run_id")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pipeline run: %w", err)), nil
+	}
+
+	baseURL, err := kfpBaseURL(params, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pipeline run: %w", err)), nil
+	}
+	response, err := restRequest(params, http.MethodGet, baseURL, "/apis/v2beta1/runs/"+runID, nil)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pipeline run '%s': %w", runID, err)), nil
+	}
+
+	displayName, _ := response["display_name"].(string)
+	state, _ := response["state"].(string)
+	lines := []string{fmt.Sprintf("Run '%s' (id: %s) state: %s", displayName, runID, state)}
+	if details, ok := response["run_details"].(map[string]interface{}); ok {
+		if tasks, ok := details["task_details"].([]interface{}); ok {
+			for _, item := range tasks {
+				task, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				taskName, _ := task["display_name"].(string)
+				taskState, _ := task["state"].(string)
+				line := fmt.Sprintf("- task %s: %s", taskName, taskState)
+				if taskErr, ok := task["error"].(map[string]interface{}); ok {
+					if message, _ := taskErr["message"].(string); message != "" {
+						line += " (" + message + ")"
+					}
+				}
+				lines = append(lines, line)
+			}
+		}
+	}
+	if stateError, ok := response["error"].(map[string]interface{}); ok {
+		if message, _ := stateError["message"].(string); message != "" {
+			lines = append(lines, "Error: "+message)
+		}
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
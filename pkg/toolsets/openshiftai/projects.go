@@ -0,0 +1,179 @@
+package openshiftai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// dashboardLabel marks a namespace as a data science project surfaced in the
+// OpenShift AI dashboard.
+const dashboardLabel = "opendatahub.io/dashboard"
+
+const (
+	displayNameAnnotation = "openshift.io/display-name"
+	descriptionAnnotation = "openshift.io/description"
+)
+
+func initProjects() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "datascience_projects_list",
+				Description: "List OpenShift AI data science projects (namespaces labeled opendatahub.io/dashboard=true) with their display names and descriptions",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Data Science Projects: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: dataScienceProjectsList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "datascience_projects_create",
+				Description: "Create an OpenShift AI data science project: a namespace labeled opendatahub.io/dashboard=true with display-name and description annotations",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the data science project (namespace name)",
+						},
+						"display_name": {
+							Type:        "string",
+							Description: "Human-readable display name shown in the OpenShift AI dashboard (Optional, defaults to the name)",
+						},
+						"description": {
+							Type:        "string",
+							Description: "Description of the project (Optional)",
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Data Science Projects: Create",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: dataScienceProjectsCreate,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "datascience_projects_delete",
+				Description: "Delete an OpenShift AI data science project (the underlying namespace and everything in it)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the data science project to delete",
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Data Science Projects: Delete",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(true),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: dataScienceProjectsDelete,
+		},
+	}
+}
+
+func dataScienceProjectsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespaces, err := params.CoreV1().Namespaces().List(params.Context, metav1.ListOptions{
+		LabelSelector: dashboardLabel + "=true",
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list data science projects: %w", err)), nil
+	}
+	if len(namespaces.Items) == 0 {
+		return api.NewToolCallResult("No data science projects found", nil), nil
+	}
+	lines := make([]string, 0, len(namespaces.Items)+1)
+	lines = append(lines, fmt.Sprintf("%d data science project(s):", len(namespaces.Items)))
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		displayName := namespace.Annotations[displayNameAnnotation]
+		if displayName == "" {
+			displayName = namespace.Name
+		}
+		line := fmt.Sprintf("- %s (display name: %s, phase: %s)", namespace.Name, displayName, namespace.Status.Phase)
+		if description := namespace.Annotations[descriptionAnnotation]; description != "" {
+			line += ": " + description
+		}
+		lines = append(lines, line)
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
+
+func dataScienceProjectsCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	displayName := p.OptionalString("display_name", "")
+	description := p.OptionalString("description", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create data science project: %w", err)), nil
+	}
+	if displayName == "" {
+		displayName = name
+	}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{dashboardLabel: "true"},
+			Annotations: map[string]string{
+				displayNameAnnotation: displayName,
+			},
+		},
+	}
+	if description != "" {
+		namespace.Annotations[descriptionAnnotation] = description
+	}
+	if _, err := params.CoreV1().Namespaces().Create(params.Context, namespace, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create data science project '%s': %w", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Data science project '%s' created (display name: %s)", name, displayName), nil), nil
+}
+
+func dataScienceProjectsDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to delete data science project: %w", err)), nil
+	}
+
+	// Only namespaces that actually carry the dashboard label are deleted, so
+	// the tool cannot be used to remove arbitrary namespaces.
+	namespace, err := params.CoreV1().Namespaces().Get(params.Context, name, metav1.GetOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get data science project '%s': %w", name, err)), nil
+	}
+	if namespace.Labels[dashboardLabel] != "true" {
+		return api.NewToolCallResult("", fmt.Errorf("namespace '%s' is not a data science project (missing %s=true label)", name, dashboardLabel)), nil
+	}
+	if err = params.CoreV1().Namespaces().Delete(params.Context, name, metav1.DeleteOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to delete data science project '%s': %w", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Data science project '%s' deleted", name), nil), nil
+}
@@ -0,0 +1,320 @@
+package openshiftai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+var (
+	inferenceServiceGVR = schema.GroupVersionResource{Group: "serving.kserve.io", Version: "v1beta1", Resource: "inferenceservices"}
+	servingRuntimeGVR   = schema.GroupVersionResource{Group: "serving.kserve.io", Version: "v1alpha1", Resource: "servingruntimes"}
+)
+
+// inferenceServiceLabel is the label KServe puts on predictor pods.
+const inferenceServiceLabel = "serving.kserve.io/inferenceservice"
+
+func initServing() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "inference_services_list",
+				Description: "List KServe InferenceServices with their ready state and endpoint URL, plus the ServingRuntimes available in the namespace",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to list model servers from (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Inference Services: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: inferenceServicesList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "inference_services_deploy",
+				Description: "Deploy a model as a KServe InferenceService from a storage URI (e.g. s3://bucket/model, pvc://claim/path, oci://image)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the InferenceService",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to deploy the model in (Optional, current namespace if not provided)",
+						},
+						"storage_uri": {
+							Type:        "string",
+							Description: "Storage URI of the model artifacts",
+						},
+						"model_format": {
+							Type:        "string",
+							Description: "Model format (e.g. sklearn, onnx, pytorch, tensorflow, vLLM)",
+						},
+						"runtime": {
+							Type:        "string",
+							Description: "Name of the ServingRuntime to serve the model with (Optional, KServe picks one matching the model format if not provided)",
+						},
+					},
+					Required: []string{"name", "storage_uri", "model_format"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Inference Services: Deploy",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: inferenceServicesDeploy,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "inference_services_scale",
+				Description: "Scale a KServe InferenceService predictor by setting its minimum (and optionally maximum) replica count",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the InferenceService",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the InferenceService (Optional, current namespace if not provided)",
+						},
+						"min_replicas": {
+							Type:        "integer",
+							Description: "Minimum number of predictor replicas (0 allows scale-to-zero)",
+							Minimum:     ptr.To(float64(0)),
+						},
+						"max_replicas": {
+							Type:        "integer",
+							Description: "Maximum number of predictor replicas (Optional, left unchanged if not provided)",
+							Minimum:     ptr.To(float64(1)),
+						},
+					},
+					Required: []string{"name", "min_replicas"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Inference Services: Scale",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: inferenceServicesScale,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "inference_services_logs",
+				Description: "Fetch the logs of the predictor pods backing a KServe InferenceService",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the InferenceService",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the InferenceService (Optional, current namespace if not provided)",
+						},
+						"tail": {
+							Type:        "integer",
+							Description: "Number of log lines to return per pod (Optional, defaults to 100)",
+							Minimum:     ptr.To(float64(1)),
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Inference Services: Logs",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: inferenceServicesLogs,
+		},
+	}
+}
+
+func inferenceServicesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list inference services: %w", err)), nil
+	}
+
+	services, err := params.DynamicClient().Resource(inferenceServiceGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list inference services: %w", err)), nil
+	}
+
+	var lines []string
+	if len(services.Items) == 0 {
+		lines = append(lines, "No InferenceServices found")
+	} else {
+		lines = append(lines, fmt.Sprintf("%d InferenceService(s):", len(services.Items)))
+		for i := range services.Items {
+			service := &services.Items[i]
+			ready := "not ready"
+			if isReady(service) {
+				ready = "ready"
+			}
+			line := fmt.Sprintf("- %s/%s (%s)", service.GetNamespace(), service.GetName(), ready)
+			if url, _, _ := unstructured.NestedString(service.Object, "status", "url"); url != "" {
+				line += " " + url
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	runtimes, err := params.DynamicClient().Resource(servingRuntimeGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err == nil && len(runtimes.Items) > 0 {
+		lines = append(lines, fmt.Sprintf("\n%d ServingRuntime(s):", len(runtimes.Items)))
+		for i := range runtimes.Items {
+			runtime := &runtimes.Items[i]
+			lines = append(lines, fmt.Sprintf("- %s/%s", runtime.GetNamespace(), runtime.GetName()))
+		}
+	}
+	return api.NewToolCallResult(strings.Join(lines, "\n"), nil), nil
+}
+
+func inferenceServicesDeploy(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	storageURI := p.RequiredString("storage_uri")
+	modelFormat := p.RequiredString("model_format")
+	runtime := p.OptionalString("runtime", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to deploy model: %w", err)), nil
+	}
+
+	model := map[string]interface{}{
+		"modelFormat": map[string]interface{}{"name": modelFormat},
+		"storageUri":  storageURI,
+	}
+	if runtime != "" {
+		model["runtime"] = runtime
+	}
+	service := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "serving.kserve.io/v1beta1",
+		"kind":       "InferenceService",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{
+			"predictor": map[string]interface{}{
+				"model": model,
+			},
+		},
+	}}
+	if _, err := params.DynamicClient().Resource(inferenceServiceGVR).Namespace(namespace).Create(params.Context, service, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to deploy InferenceService '%s/%s': %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("InferenceService '%s' deploying in namespace '%s' from %s (format: %s)", name, namespace, storageURI, modelFormat), nil), nil
+}
+
+func inferenceServicesScale(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	minReplicas := p.OptionalInt64("min_replicas", -1)
+	maxReplicas := p.OptionalInt64("max_replicas", -1)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to scale inference service: %w", err)), nil
+	}
+	if minReplicas < 0 {
+		return api.NewToolCallResult("", fmt.Errorf("failed to scale inference service: min_replicas parameter required")), nil
+	}
+
+	predictor := map[string]interface{}{"minReplicas": minReplicas}
+	if maxReplicas > 0 {
+		predictor["maxReplicas"] = maxReplicas
+	}
+	patch := api.ToRawMessage(map[string]interface{}{"spec": map[string]interface{}{"predictor": predictor}})
+	if _, err := params.DynamicClient().Resource(inferenceServiceGVR).Namespace(namespace).Patch(params.Context, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to scale InferenceService '%s/%s': %w", namespace, name, err)), nil
+	}
+	result := fmt.Sprintf("InferenceService '%s' in namespace '%s' scaled to minReplicas=%d", name, namespace, minReplicas)
+	if maxReplicas > 0 {
+		result += fmt.Sprintf(", maxReplicas=%d", maxReplicas)
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
+
+func inferenceServicesLogs(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	tail := p.OptionalInt64("tail", 100)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get predictor logs: %w", err)), nil
+	}
+
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{
+		LabelSelector: inferenceServiceLabel + "=" + name,
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list predictor pods for InferenceService '%s/%s': %w", namespace, name, err)), nil
+	}
+	if len(pods.Items) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No predictor pods found for InferenceService '%s' in namespace '%s'", name, namespace), nil), nil
+	}
+
+	var sections []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		logs, logErr := kubernetes.NewCore(params).PodsLog(params.Context, pod.Namespace, pod.Name, "", false, tail)
+		if logErr != nil {
+			sections = append(sections, fmt.Sprintf("Pod %s: failed to fetch logs: %v", pod.Name, logErr))
+			continue
+		}
+		sections = append(sections, fmt.Sprintf("Pod %s:\n%s", pod.Name, logs))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// isReady reports whether an unstructured resource has a Ready condition with
+// status True.
+func isReady(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if conditionType, _ := condition["type"].(string); conditionType != "Ready" {
+			continue
+		}
+		status, _ := condition["status"].(string)
+		return status == "True"
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package openshiftai
+
+import (
+	"slices"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+// Toolset provides OpenShift AI (RHOAI/ODH) tools: data science projects,
+// workbenches, model serving, and related accelerator resources.
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "openshift-ai"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "OpenShift AI tools for data science projects, workbenches, model serving, pipelines, and accelerators"
+}
+
+func (t *Toolset) GetTools(_ api.FilteringProvider) []api.ServerTool {
+	return slices.Concat(
+		initProjects(),
+		initNotebooks(),
+		initServing(),
+		initPipelines(),
+		initAccelerators(),
+		initTraining(),
+		initModelRegistry(),
+	)
+}
+
+func (t *Toolset) GetPrompts() []api.ServerPrompt {
+	return nil
+}
+
+func (t *Toolset) GetResources() []api.ServerResource {
+	return nil
+}
+
+func (t *Toolset) GetResourceTemplates() []api.ServerResourceTemplate {
+	return nil
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}
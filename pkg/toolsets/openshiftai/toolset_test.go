@@ -0,0 +1,112 @@
+package openshiftai_test
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/mcp/mcptest"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/openshiftai"
+)
+
+func TestMain(m *testing.M) {
+	mcptest.Main(m)
+}
+
+type ProjectsSuite struct {
+	mcptest.Suite
+}
+
+func (s *ProjectsSuite) SetupTest() {
+	s.Suite.SetupTest()
+	s.Cfg.Toolsets = append(s.Cfg.Toolsets, (&openshiftai.Toolset{}).GetName())
+	s.InitMcpClient()
+}
+
+func (s *ProjectsSuite) text(toolResult *mcp.CallToolResult) string {
+	s.T().Helper()
+	s.Require().NotEmpty(toolResult.Content, "expected tool result content")
+	return toolResult.Content[0].(*mcp.TextContent).Text
+}
+
+func (s *ProjectsSuite) TestDataScienceProjects() {
+	s.Run("datascience_projects_list (no projects)", func() {
+		toolResult, err := s.CallTool("datascience_projects_list", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("No data science projects found", s.text(toolResult))
+	})
+	s.Run("datascience_projects_create (missing name)", func() {
+		toolResult, err := s.CallTool("datascience_projects_create", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to create data science project: name parameter required", s.text(toolResult))
+	})
+	s.Run("datascience_projects_create (valid)", func() {
+		toolResult, err := s.CallTool("datascience_projects_create", map[string]interface{}{
+			"name":         "ds-project",
+			"display_name": "My Project",
+			"description":  "A data science project",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Run("reports the created project", func() {
+			s.Equal("Data science project 'ds-project' created (display name: My Project)", s.text(toolResult))
+		})
+		s.Run("creates a namespace carrying the dashboard label", func() {
+			namespace, getErr := kubernetes.NewForConfigOrDie(mcptest.RestConfig()).CoreV1().
+				Namespaces().Get(s.T().Context(), "ds-project", metav1.GetOptions{})
+			s.Require().NoError(getErr, "failed to get namespace")
+			s.Equal("true", namespace.Labels["opendatahub.io/dashboard"], "namespace should be labeled as a data science project")
+		})
+	})
+	s.Run("datascience_projects_create (already exists)", func() {
+		toolResult, err := s.CallTool("datascience_projects_create", map[string]interface{}{
+			"name": "ds-project",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Contains(s.text(toolResult), "failed to create data science project 'ds-project'")
+	})
+	s.Run("datascience_projects_list (with project)", func() {
+		toolResult, err := s.CallTool("datascience_projects_list", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := s.text(toolResult)
+		s.Run("counts the projects", func() {
+			s.Containsf(text, "1 data science project(s):", "unexpected result %v", text)
+		})
+		s.Run("lists display name, phase, and description", func() {
+			s.Containsf(text, "- ds-project (display name: My Project, phase: Active): A data science project", "unexpected result %v", text)
+		})
+	})
+	s.Run("datascience_projects_delete (missing name)", func() {
+		toolResult, err := s.CallTool("datascience_projects_delete", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to delete data science project: name parameter required", s.text(toolResult))
+	})
+	s.Run("datascience_projects_delete (unlabeled namespace)", func() {
+		toolResult, err := s.CallTool("datascience_projects_delete", map[string]interface{}{
+			"name": "default",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should refuse to delete a namespace that is not a data science project")
+		s.Equal("namespace 'default' is not a data science project (missing opendatahub.io/dashboard=true label)", s.text(toolResult))
+	})
+	s.Run("datascience_projects_delete (valid)", func() {
+		toolResult, err := s.CallTool("datascience_projects_delete", map[string]interface{}{
+			"name": "ds-project",
+		})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("Data science project 'ds-project' deleted", s.text(toolResult))
+	})
+}
+
+func TestProjects(t *testing.T) {
+	suite.Run(t, new(ProjectsSuite))
+}
@@ -0,0 +1,281 @@
+package openshiftai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+var (
+	rayClusterGVR = schema.GroupVersionResource{Group: "ray.io", Version: "v1", Resource: "rayclusters"}
+	rayJobGVR     = schema.GroupVersionResource{Group: "ray.io", Version: "v1", Resource: "rayjobs"}
+	pyTorchJobGVR = schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "pytorchjobs"}
+)
+
+func initTraining() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "distributed_workloads_list",
+				Description: "List distributed training workloads: RayClusters, RayJobs, and PyTorchJobs with their current state",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to list workloads from (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Distributed Workloads: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: distributedWorkloadsList,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "ray_jobs_create",
+				Description: "Create a RayJob from a simple spec: entrypoint command, container image, and worker count. The job provisions its own ephemeral RayCluster and shuts it down when finished",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the RayJob",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to create the RayJob in (Optional, current namespace if not provided)",
+						},
+						"entrypoint": {
+							Type:        "string",
+							Description: "Entrypoint command to run (e.g. 'python train.py')",
+						},
+						"image": {
+							Type:        "string",
+							Description: "Ray container image for the head and worker nodes",
+						},
+						"workers": {
+							Type:        "integer",
+							Description: "Number of worker replicas (Optional, defaults to 1)",
+							Minimum:     ptr.To(float64(0)),
+						},
+					},
+					Required: []string{"name", "entrypoint", "image"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Ray Jobs: Create",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: rayJobsCreate,
+		},
+		{
+			Tool: api.Tool{
+				Name:        "ray_head_logs",
+				Description: "Fetch the logs of the head pod of a RayCluster (or of the cluster provisioned by a RayJob)",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"name": {
+							Type:        "string",
+							Description: "Name of the RayCluster",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace of the RayCluster (Optional, current namespace if not provided)",
+						},
+						"tail": {
+							Type:        "integer",
+							Description: "Number of log lines to return (Optional, defaults to 100)",
+							Minimum:     ptr.To(float64(1)),
+						},
+					},
+					Required: []string{"name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Ray: Head Logs",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: rayHeadLogs,
+		},
+	}
+}
+
+func distributedWorkloadsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list distributed workloads: %w", err)), nil
+	}
+
+	var sections []string
+	if lines := listWithState(params, rayClusterGVR, namespace, "status", "state"); lines != nil {
+		sections = append(sections, "RayClusters:\n"+strings.Join(lines, "\n"))
+	}
+	if lines := listWithState(params, rayJobGVR, namespace, "status", "jobStatus"); lines != nil {
+		sections = append(sections, "RayJobs:\n"+strings.Join(lines, "\n"))
+	}
+	if lines := listPyTorchJobs(params, namespace); lines != nil {
+		sections = append(sections, "PyTorchJobs:\n"+strings.Join(lines, "\n"))
+	}
+	if len(sections) == 0 {
+		return api.NewToolCallResult("No distributed training workloads found (and/or the Ray and Training Operator CRDs are not installed)", nil), nil
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+// listWithState lists a custom resource and renders one line per item with
+// the status field at the given path. Returns nil when the CRD is missing or
+// no items exist.
+func listWithState(params api.ToolHandlerParams, gvr schema.GroupVersionResource, namespace string, statusPath ...string) []string {
+	list, err := params.DynamicClient().Resource(gvr).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil || len(list.Items) == 0 {
+		return nil
+	}
+	lines := make([]string, 0, len(list.Items))
+	for i := range list.Items {
+		item := &list.Items[i]
+		state, _, _ := unstructured.NestedString(item.Object, statusPath...)
+		if state == "" {
+			state = "unknown"
+		}
+		lines = append(lines, fmt.Sprintf("- %s/%s (%s)", item.GetNamespace(), item.GetName(), state))
+	}
+	return lines
+}
+
+// listPyTorchJobs renders PyTorchJobs with the latest True condition type
+// (Running, Succeeded, Failed, ...) as the state.
+func listPyTorchJobs(params api.ToolHandlerParams, namespace string) []string {
+	list, err := params.DynamicClient().Resource(pyTorchJobGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil || len(list.Items) == 0 {
+		return nil
+	}
+	lines := make([]string, 0, len(list.Items))
+	for i := range list.Items {
+		job := &list.Items[i]
+		state := "unknown"
+		conditions, _, _ := unstructured.NestedSlice(job.Object, "status", "conditions")
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if status, _ := condition["status"].(string); status == "True" {
+				if conditionType, _ := condition["type"].(string); conditionType != "" {
+					state = conditionType
+				}
+			}
+		}
+		lines = append(lines, fmt.Sprintf("- %s/%s (%s)", job.GetNamespace(), job.GetName(), state))
+	}
+	return lines
+}
+
+func rayJobsCreate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	entrypoint := p.RequiredString("entrypoint")
+	image := p.RequiredString("image")
+	workers := p.OptionalInt64("workers", 1)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create RayJob: %w", err)), nil
+	}
+
+	podTemplate := func(cpu, memory string) map[string]interface{} {
+		return map[string]interface{}{
+			"spec": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "ray",
+						"image": image,
+						"resources": map[string]interface{}{
+							"requests": map[string]interface{}{"cpu": cpu, "memory": memory},
+							"limits":   map[string]interface{}{"cpu": cpu, "memory": memory},
+						},
+					},
+				},
+			},
+		}
+	}
+	rayJob := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "ray.io/v1",
+		"kind":       "RayJob",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{
+			"entrypoint":               entrypoint,
+			"shutdownAfterJobFinishes": true,
+			"rayClusterSpec": map[string]interface{}{
+				"headGroupSpec": map[string]interface{}{
+					"rayStartParams": map[string]interface{}{"dashboard-host": "0.0.0.0"},
+					"template":       podTemplate("2", "8Gi"),
+				},
+				"workerGroupSpecs": []interface{}{
+					map[string]interface{}{
+						"groupName":      "workers",
+						"replicas":       workers,
+						"minReplicas":    workers,
+						"maxReplicas":    workers,
+						"rayStartParams": map[string]interface{}{},
+						"template":       podTemplate("2", "8Gi"),
+					},
+				},
+			},
+		},
+	}}
+	if _, err := params.DynamicClient().Resource(rayJobGVR).Namespace(namespace).Create(params.Context, rayJob, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create RayJob '%s/%s': %w", namespace, name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("RayJob '%s' created in namespace '%s' with %d worker(s); the cluster shuts down when the job finishes", name, namespace, workers), nil), nil
+}
+
+func rayHeadLogs(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	tail := p.OptionalInt64("tail", 100)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get Ray head logs: %w", err)), nil
+	}
+
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("ray.io/cluster=%s,ray.io/node-type=head", name),
+	})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list head pods for RayCluster '%s/%s': %w", namespace, name, err)), nil
+	}
+	if len(pods.Items) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No head pod found for RayCluster '%s' in namespace '%s'", name, namespace), nil), nil
+	}
+	headPod := &pods.Items[0]
+	logs, err := kubernetes.NewCore(params).PodsLog(params.Context, headPod.Namespace, headPod.Name, "", false, tail)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to fetch logs of head pod '%s/%s': %w", headPod.Namespace, headPod.Name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Head pod %s:\n%s", headPod.Name, logs), nil), nil
+}
@@ -0,0 +1,278 @@
+package diagnostics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+var (
+	podsGVR               = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
+	connectivityChecksGVR = schema.GroupVersionResource{Group: "controlplane.operator.openshift.io", Version: "v1alpha1", Resource: "podnetworkconnectivitychecks"}
+	egressFirewallsGVR    = schema.GroupVersionResource{Group: "k8s.ovn.org", Version: "v1", Resource: "egressfirewalls"}
+	adminNetPoliciesGVR   = schema.GroupVersionResource{Group: "policy.networking.k8s.io", Version: "v1alpha1", Resource: "adminnetworkpolicies"}
+	baselineAdminGVR      = schema.GroupVersionResource{Group: "policy.networking.k8s.io", Version: "v1alpha1", Resource: "baselineadminnetworkpolicies"}
+)
+
+const (
+	// ovnNamespace is where the OVN-Kubernetes control plane runs on OpenShift.
+	ovnNamespace = "openshift-ovn-kubernetes"
+	// diagnosticsNamespace hosts the network-check-source/target pods whose
+	// results land in PodNetworkConnectivityCheck objects.
+	diagnosticsNamespace = "openshift-network-diagnostics"
+)
+
+// InitDiagnostics returns the OVN-Kubernetes cluster-level network
+// diagnostics tools; they complement the ovn_*/ovs_* database tools with the
+// Kubernetes-side view of the CNI.
+func InitDiagnostics() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "ovn_control_plane_health",
+			Description: "Check the health of the OVN-Kubernetes control plane: the readiness, restarts, and phase of the ovnkube pods in " + ovnNamespace + ", " +
+				"flagging pods that are not ready or restart frequently",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "OVN: Control Plane Health",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: controlPlaneHealth},
+		{Tool: api.Tool{
+			Name: "ovn_nbdb_status",
+			Description: "Get the northbound database cluster status of OVN-Kubernetes by running 'ovn-appctl cluster/status OVN_Northbound' inside an ovnkube-node pod. " +
+				"When no pod name is provided, the first running ovnkube-node pod is used",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: `Name of the ovnkube-node pod to query (Optional, defaults to the first running ovnkube-node pod)`,
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "OVN: NBDB Status",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nbdbStatus},
+		{Tool: api.Tool{
+			Name: "ovn_connectivity_check",
+			Description: "Report the pod-to-pod and pod-to-apiserver connectivity measured by the OpenShift network diagnostics " +
+				"(network-check-source/target PodNetworkConnectivityCheck objects), listing the checks that are currently failing",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "OVN: Connectivity Check",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: connectivityCheck},
+		{Tool: api.Tool{
+			Name: "ovn_egress_policies_list",
+			Description: "List the EgressFirewall, AdminNetworkPolicy, and BaselineAdminNetworkPolicy objects of the cluster with their subjects, " +
+				"rule counts, and status, to reason about which policies shape east-west and egress traffic",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "OVN: Egress Policies List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: egressPoliciesList},
+	}
+}
+
+func controlPlaneHealth(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	pods, err := params.DynamicClient().Resource(podsGVR).Namespace(ovnNamespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the OVN-Kubernetes pods: %w", err)), nil
+	}
+	if len(pods.Items) == 0 {
+		return api.NewToolCallResult("No pods found in "+ovnNamespace+"; the cluster does not appear to run OVN-Kubernetes", nil), nil
+	}
+	var healthy int
+	var problems []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		phase, _, _ := unstructured.NestedString(pod.Object, "status", "phase")
+		ready := true
+		restarts := int64(0)
+		var notReadyContainers []string
+		statuses, _, _ := unstructured.NestedSlice(pod.Object, "status", "containerStatuses")
+		for _, raw := range statuses {
+			status, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			if containerReady, _ := status["ready"].(bool); !containerReady {
+				ready = false
+				containerName, _ := status["name"].(string)
+				notReadyContainers = append(notReadyContainers, containerName)
+			}
+			if restartCount, ok := status["restartCount"].(int64); ok {
+				restarts += restartCount
+			}
+		}
+		switch {
+		case phase != "Running":
+			problems = append(problems, fmt.Sprintf("- %s is %s", pod.GetName(), phase))
+		case !ready:
+			problems = append(problems, fmt.Sprintf("- %s has not-ready container(s): %s (%d restart(s) total)", pod.GetName(), strings.Join(notReadyContainers, ", "), restarts))
+		case restarts > 5:
+			problems = append(problems, fmt.Sprintf("- %s is ready but restarted %d time(s); check its previous logs", pod.GetName(), restarts))
+		default:
+			healthy++
+		}
+	}
+	if len(problems) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("The OVN-Kubernetes control plane is healthy: all %d pod(s) in %s are running and ready", healthy, ovnNamespace), nil), nil
+	}
+	sort.Strings(problems)
+	return api.NewToolCallResult(fmt.Sprintf("OVN-Kubernetes control plane problems (%d healthy pod(s), %d with problems):\n%s",
+		healthy, len(problems), strings.Join(problems, "\n")), nil), nil
+}
+
+func nbdbStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	name := p.OptionalString("name", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the northbound database status: %w", err)), nil
+	}
+	if name == "" {
+		pods, err := params.DynamicClient().Resource(podsGVR).Namespace(ovnNamespace).List(params.Context, metav1.ListOptions{
+			LabelSelector: "app=ovnkube-node",
+			FieldSelector: "status.phase=Running",
+		})
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to find an ovnkube-node pod: %w", err)), nil
+		}
+		if len(pods.Items) == 0 {
+			return api.NewToolCallResult("", fmt.Errorf("no running ovnkube-node pod found in %s", ovnNamespace)), nil
+		}
+		name = pods.Items[0].GetName()
+	}
+	stdout, stderr, err := kubernetes.NewCore(params).PodsExec(params.Context, ovnNamespace, name, "nbdb",
+		[]string{"ovn-appctl", "-t", "/var/run/ovn/ovnnb_db.ctl", "cluster/status", "OVN_Northbound"})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the northbound database status from pod %s: %w (%s)", name, err, strings.TrimSpace(stderr))), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Northbound database status from %s/%s:\n%s", ovnNamespace, name, stdout), nil), nil
+}
+
+func connectivityCheck(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	checks, err := params.DynamicClient().Resource(connectivityChecksGVR).Namespace(diagnosticsNamespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list the PodNetworkConnectivityChecks: %w", err)), nil
+	}
+	if len(checks.Items) == 0 {
+		return api.NewToolCallResult("No PodNetworkConnectivityCheck objects found; the network diagnostics may be disabled (spec.disableNetworkDiagnostics in network.config.openshift.io)", nil), nil
+	}
+	reachable := 0
+	var failing []string
+	for i := range checks.Items {
+		check := &checks.Items[i]
+		isReachable := false
+		var message string
+		conditions, _, _ := unstructured.NestedSlice(check.Object, "status", "conditions")
+		for _, raw := range conditions {
+			condition, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			if conditionType, _ := condition["type"].(string); conditionType == "Reachable" {
+				status, _ := condition["status"].(string)
+				isReachable = status == "True"
+				message, _ = condition["message"].(string)
+			}
+		}
+		if isReachable {
+			reachable++
+			continue
+		}
+		line := "- " + check.GetName()
+		if message != "" {
+			line += ": " + message
+		}
+		failing = append(failing, line)
+	}
+	if len(failing) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("All %d connectivity check(s) are reachable", reachable), nil), nil
+	}
+	sort.Strings(failing)
+	return api.NewToolCallResult(fmt.Sprintf("%d of %d connectivity check(s) are failing:\n%s", len(failing), reachable+len(failing), strings.Join(failing, "\n")), nil), nil
+}
+
+func egressPoliciesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	var sections []string
+
+	egressFirewalls, err := params.DynamicClient().Resource(egressFirewallsGVR).List(params.Context, metav1.ListOptions{})
+	if err == nil && len(egressFirewalls.Items) > 0 {
+		var lines []string
+		for i := range egressFirewalls.Items {
+			firewall := &egressFirewalls.Items[i]
+			rules, _, _ := unstructured.NestedSlice(firewall.Object, "spec", "egress")
+			status, _, _ := unstructured.NestedString(firewall.Object, "status", "status")
+			line := fmt.Sprintf("- %s/%s: %d rule(s)", firewall.GetNamespace(), firewall.GetName(), len(rules))
+			if status != "" {
+				line += ", status: " + status
+			}
+			lines = append(lines, line)
+		}
+		sort.Strings(lines)
+		sections = append(sections, fmt.Sprintf("EgressFirewalls (%d):\n%s", len(egressFirewalls.Items), strings.Join(lines, "\n")))
+	}
+
+	for _, policyKind := range []struct {
+		gvr   schema.GroupVersionResource
+		label string
+	}{
+		{adminNetPoliciesGVR, "AdminNetworkPolicies"},
+		{baselineAdminGVR, "BaselineAdminNetworkPolicies"},
+	} {
+		policies, err := params.DynamicClient().Resource(policyKind.gvr).List(params.Context, metav1.ListOptions{})
+		if err != nil || len(policies.Items) == 0 {
+			continue
+		}
+		var lines []string
+		for i := range policies.Items {
+			policy := &policies.Items[i]
+			ingress, _, _ := unstructured.NestedSlice(policy.Object, "spec", "ingress")
+			egress, _, _ := unstructured.NestedSlice(policy.Object, "spec", "egress")
+			line := fmt.Sprintf("- %s: %d ingress rule(s), %d egress rule(s)", policy.GetName(), len(ingress), len(egress))
+			if priority, found, _ := unstructured.NestedInt64(policy.Object, "spec", "priority"); found {
+				line = fmt.Sprintf("- %s (priority %d): %d ingress rule(s), %d egress rule(s)", policy.GetName(), priority, len(ingress), len(egress))
+			}
+			lines = append(lines, line)
+		}
+		sort.Strings(lines)
+		sections = append(sections, fmt.Sprintf("%s (%d):\n%s", policyKind.label, len(policies.Items), strings.Join(lines, "\n")))
+	}
+
+	if len(sections) == 0 {
+		return api.NewToolCallResult("No EgressFirewall, AdminNetworkPolicy, or BaselineAdminNetworkPolicy objects found; no OVN-level egress or admin policies shape traffic", nil), nil
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/ovnkubernetes/diagnostics"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/ovnkubernetes/ovn"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/ovnkubernetes/ovs"
 )
@@ -22,7 +23,7 @@ func (t *Toolset) GetDescription() string {
 }
 
 func (t *Toolset) GetTools(_ api.FilteringProvider) []api.ServerTool {
-	return slices.Concat(ovn.InitOVNTools(), ovs.Tools())
+	return slices.Concat(diagnostics.InitDiagnostics(), ovn.InitOVNTools(), ovs.Tools())
 }
 
 func (t *Toolset) GetPrompts() []api.ServerPrompt {
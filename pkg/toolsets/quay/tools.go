@@ -0,0 +1,265 @@
+package quay
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	quayclient "github.com/containers/kubernetes-mcp-server/pkg/quay"
+)
+
+func initTagsList() []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "quay_tags_list",
+			Description: "List the active tags of a repository in the configured Quay organization, with their manifest digest, size, and last modification time. " +
+				"Complements the imagestream tools with the registry-side view of the image lifecycle",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"repository": {
+						Type:        "string",
+						Description: "Repository name inside the configured organization (without the organization prefix)",
+					},
+				},
+				Required: []string{"repository"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Quay: Tags List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler: tagsList,
+	}}
+}
+
+func tagsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	repository := p.RequiredString("repository")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list Quay tags: %w", err)), nil
+	}
+	quay := quayclient.NewQuay(params)
+	tags, err := quay.ListTags(params.Context, repository, "")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list Quay tags: %w", err)), nil
+	}
+	if len(tags) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No active tags found in %s/%s", quay.Organization(), repository), nil), nil
+	}
+	lines := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		line := fmt.Sprintf("- %s\n  digest: %s, size: %d bytes, last modified: %s", tag.Name, tag.ManifestDigest, tag.Size, tag.LastModified)
+		if tag.Expiration != "" {
+			line += ", expires: " + tag.Expiration
+		}
+		lines = append(lines, line)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Active tags in %s/%s (%d):\n%s", quay.Organization(), repository, len(tags), strings.Join(lines, "\n")), nil), nil
+}
+
+func initTagVulnerabilities() []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "quay_tag_vulnerabilities",
+			Description: "Summarize the security scan of a repository tag in the configured Quay organization: vulnerability counts by severity " +
+				"and the affected packages with a fixed version available",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"repository": {
+						Type:        "string",
+						Description: "Repository name inside the configured organization (without the organization prefix)",
+					},
+					"tag": {
+						Type:        "string",
+						Description: "Tag whose security scan to summarize",
+					},
+				},
+				Required: []string{"repository", "tag"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Quay: Tag Vulnerabilities",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler: tagVulnerabilities,
+	}}
+}
+
+// severityOrder ranks Clair severities for the summary, most urgent first.
+var severityOrder = []string{"Critical", "High", "Medium", "Low", "Negligible", "Unknown"}
+
+func tagVulnerabilities(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	repository := p.RequiredString("repository")
+	tagName := p.RequiredString("tag")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get Quay tag vulnerabilities: %w", err)), nil
+	}
+	quay := quayclient.NewQuay(params)
+	tags, err := quay.ListTags(params.Context, repository, tagName)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get Quay tag vulnerabilities: %w", err)), nil
+	}
+	if len(tags) == 0 {
+		return api.NewToolCallResult("", fmt.Errorf("tag %s not found in %s/%s", tagName, quay.Organization(), repository)), nil
+	}
+	body, err := quay.ManifestSecurity(params.Context, repository, tags[0].ManifestDigest)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get Quay tag vulnerabilities: %w", err)), nil
+	}
+
+	var report struct {
+		Status string `json:"status"`
+		Data   struct {
+			Layer struct {
+				Features []struct {
+					Name            string `json:"Name"`
+					Version         string `json:"Version"`
+					Vulnerabilities []struct {
+						Name     string `json:"Name"`
+						Severity string `json:"Severity"`
+						FixedBy  string `json:"FixedBy"`
+					} `json:"Vulnerabilities"`
+				} `json:"Features"`
+			} `json:"Layer"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(body), &report); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to parse Quay security report: %w", err)), nil
+	}
+	if report.Status != "scanned" {
+		return api.NewToolCallResult(fmt.Sprintf("Security scan of %s/%s:%s is not available (status: %s)", quay.Organization(), repository, tagName, report.Status), nil), nil
+	}
+
+	bySeverity := map[string]int{}
+	var fixable []string
+	total := 0
+	for _, feature := range report.Data.Layer.Features {
+		for _, vulnerability := range feature.Vulnerabilities {
+			total++
+			severity := vulnerability.Severity
+			if severity == "" {
+				severity = "Unknown"
+			}
+			bySeverity[severity]++
+			if vulnerability.FixedBy != "" {
+				fixable = append(fixable, fmt.Sprintf("- %s in %s %s, fixed by %s", vulnerability.Name, feature.Name, feature.Version, vulnerability.FixedBy))
+			}
+		}
+	}
+	if total == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No vulnerabilities found in %s/%s:%s", quay.Organization(), repository, tagName), nil), nil
+	}
+	var counts []string
+	for _, severity := range severityOrder {
+		if bySeverity[severity] > 0 {
+			counts = append(counts, fmt.Sprintf("%s: %d", severity, bySeverity[severity]))
+		}
+	}
+	summary := fmt.Sprintf("Security scan of %s/%s:%s — %d vulnerability(ies) (%s)", quay.Organization(), repository, tagName, total, strings.Join(counts, ", "))
+	if len(fixable) > 0 {
+		sort.Strings(fixable)
+		summary += fmt.Sprintf("\n\nFixable (%d):\n%s", len(fixable), strings.Join(fixable, "\n"))
+	}
+	return api.NewToolCallResult(summary, nil), nil
+}
+
+func initTagsPrune() []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name: "quay_tags_prune",
+			Description: "Delete the tags of a repository in the configured Quay organization that were last pushed more than olderThanDays days ago. " +
+				"Runs as a dry run by default, only reporting what would be deleted; set dryRun to false to actually delete the tags",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"repository": {
+						Type:        "string",
+						Description: "Repository name inside the configured organization (without the organization prefix)",
+					},
+					"olderThanDays": {
+						Type:        "integer",
+						Description: "Delete tags last pushed more than this many days ago",
+						Minimum:     ptr.To(float64(1)),
+					},
+					"dryRun": {
+						Type:        "boolean",
+						Description: "Only report the tags that would be deleted without deleting them (Optional, default: true)",
+					},
+				},
+				Required: []string{"repository", "olderThanDays"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Quay: Tags Prune",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		},
+		Handler: tagsPrune,
+	}}
+}
+
+func tagsPrune(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	repository := p.RequiredString("repository")
+	olderThanDays := p.OptionalInt64("olderThanDays", 0)
+	dryRun := p.OptionalBool("dryRun", true)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to prune Quay tags: %w", err)), nil
+	}
+	if olderThanDays < 1 {
+		return api.NewToolCallResult("", fmt.Errorf("failed to prune Quay tags: olderThanDays must be at least 1")), nil
+	}
+
+	quay := quayclient.NewQuay(params)
+	tags, err := quay.ListTags(params.Context, repository, "")
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to prune Quay tags: %w", err)), nil
+	}
+	cutoff := time.Now().AddDate(0, 0, -int(olderThanDays))
+	var stale []quayclient.Tag
+	for _, tag := range tags {
+		if time.Unix(tag.StartTs, 0).Before(cutoff) {
+			stale = append(stale, tag)
+		}
+	}
+	if len(stale) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No tags in %s/%s are older than %d day(s)", quay.Organization(), repository, olderThanDays), nil), nil
+	}
+
+	var lines []string
+	deleted := 0
+	for _, tag := range stale {
+		if dryRun {
+			lines = append(lines, fmt.Sprintf("- %s (last pushed %s) would be deleted", tag.Name, tag.LastModified))
+			continue
+		}
+		if err := quay.DeleteTag(params.Context, repository, tag.Name); err != nil {
+			lines = append(lines, fmt.Sprintf("- %s could not be deleted: %v", tag.Name, err))
+			continue
+		}
+		deleted++
+		lines = append(lines, fmt.Sprintf("- %s (last pushed %s) deleted", tag.Name, tag.LastModified))
+	}
+	header := fmt.Sprintf("Deleted %d of %d tag(s) older than %d day(s) in %s/%s:", deleted, len(stale), olderThanDays, quay.Organization(), repository)
+	if dryRun {
+		header = fmt.Sprintf("Dry run: %d tag(s) older than %d day(s) in %s/%s would be deleted (set dryRun to false to delete them):", len(stale), olderThanDays, quay.Organization(), repository)
+	}
+	return api.NewToolCallResult(header+"\n"+strings.Join(lines, "\n"), nil), nil
+}
@@ -0,0 +1,52 @@
+package quay
+
+import (
+	"slices"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "quay"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "Quay registry tools for repository tags, vulnerability summaries, and tag pruning in a configured organization"
+}
+
+func (t *Toolset) GetTools(_ api.FilteringProvider) []api.ServerTool {
+	tools := slices.Concat(
+		initTagsList(),
+		initTagVulnerabilities(),
+		initTagsPrune(),
+	)
+	// Quay calls a single configured registry endpoint; the provider-level
+	// context parameter injected for core Kubernetes tools does not apply.
+	for i := range tools {
+		tools[i].ClusterAware = ptr.To(false)
+	}
+	return tools
+}
+
+func (t *Toolset) GetPrompts() []api.ServerPrompt {
+	return nil
+}
+
+func (t *Toolset) GetResources() []api.ServerResource {
+	return nil
+}
+
+func (t *Toolset) GetResourceTemplates() []api.ServerResourceTemplate {
+	return nil
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}
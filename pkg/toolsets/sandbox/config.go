@@ -0,0 +1,60 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// Config designates the sandbox namespaces the cleanup tools operate on and
+// the default TTL applied to resources that don't carry their own.
+type Config struct {
+	// Namespaces lists the sandbox namespaces subject to TTL enforcement.
+	// The tools refuse to run without at least one designated namespace, so
+	// a production namespace can never be swept by accident.
+	Namespaces []string `toml:"namespaces,omitempty"`
+	// DefaultTTL applies to resources without a TTL annotation (Go duration,
+	// e.g. "72h"). Empty means resources without an annotation are skipped.
+	DefaultTTL string `toml:"default_ttl,omitempty"`
+}
+
+var _ api.ExtendedConfig = (*Config)(nil)
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return errors.New("sandbox config is nil")
+	}
+	if c.DefaultTTL != "" {
+		if _, err := time.ParseDuration(c.DefaultTTL); err != nil {
+			return fmt.Errorf("sandbox default_ttl is not a valid duration: %w", err)
+		}
+	}
+	return nil
+}
+
+// defaultTTL returns the parsed DefaultTTL, or zero when unset. Validate
+// guarantees the value parses.
+func (c *Config) defaultTTL() time.Duration {
+	if c.DefaultTTL == "" {
+		return 0
+	}
+	ttl, _ := time.ParseDuration(c.DefaultTTL)
+	return ttl
+}
+
+func sandboxToolsetParser(_ context.Context, primitive toml.Primitive, md toml.MetaData) (api.ExtendedConfig, error) {
+	var cfg Config
+	if err := md.PrimitiveDecode(primitive, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func init() {
+	config.RegisterToolsetConfig("sandbox", sandboxToolsetParser)
+}
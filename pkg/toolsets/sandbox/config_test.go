@@ -0,0 +1,47 @@
+package sandbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigSuite struct {
+	suite.Suite
+}
+
+func (s *ConfigSuite) TestValidate() {
+	s.Run("valid configurations", func() {
+		s.Run("empty config is valid", func() {
+			s.NoError((&Config{}).Validate())
+		})
+		s.Run("namespaces with default_ttl is valid", func() {
+			cfg := &Config{Namespaces: []string{"sandbox-alice"}, DefaultTTL: "72h"}
+			s.NoError(cfg.Validate())
+		})
+	})
+	s.Run("edge cases", func() {
+		s.Run("returns error for nil config", func() {
+			var cfg *Config
+			s.Error(cfg.Validate())
+		})
+		s.Run("returns error for invalid default_ttl", func() {
+			cfg := &Config{DefaultTTL: "three days"}
+			s.ErrorContains(cfg.Validate(), "default_ttl")
+		})
+	})
+}
+
+func (s *ConfigSuite) TestDefaultTTL() {
+	s.Run("returns zero when unset", func() {
+		s.Equal(time.Duration(0), (&Config{}).defaultTTL())
+	})
+	s.Run("returns the parsed duration", func() {
+		s.Equal(72*time.Hour, (&Config{DefaultTTL: "72h"}).defaultTTL())
+	})
+}
+
+func TestConfig(t *testing.T) {
+	suite.Run(t, new(ConfigSuite))
+}
@@ -0,0 +1,269 @@
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/version"
+)
+
+// TTLAnnotation holds a per-resource TTL (Go duration, e.g. "72h"). It can
+// also be set on the sandbox namespace to apply to every resource in it;
+// the resource-level annotation wins.
+var TTLAnnotation = version.BinaryName + "/ttl"
+
+// sandboxScanTargets are the resource types subject to TTL enforcement.
+// Resources with owner references are skipped — deleting the owner cascades.
+var sandboxScanTargets = []struct {
+	gvr  schema.GroupVersionResource
+	kind string
+}{
+	{schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, "Deployment"},
+	{schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}, "StatefulSet"},
+	{schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}, "CronJob"},
+	{schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}, "Job"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}, "Service"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "configmaps"}, "ConfigMap"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}, "Secret"},
+	{schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}, "Pod"},
+}
+
+// clusterManagedNames are well-known per-namespace objects injected by the
+// platform; they carry no owner reference but must never be TTL-swept.
+var clusterManagedNames = map[string]bool{
+	"kube-root-ca.crt":         true,
+	"openshift-service-ca.crt": true,
+}
+
+func initSandboxReport() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name: "sandbox_report",
+			Description: "Report resources in the designated sandbox namespaces that have outlived their TTL (from the " + TTLAnnotation + " annotation on the resource or its namespace, or the configured default). " +
+				"Read-only, so it can also run as a scheduled report; use sandbox_cleanup to delete the expired resources",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Restrict the report to a single designated sandbox namespace (Optional, defaults to all of them)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Sandbox: Report",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: sandboxReport},
+	}
+}
+
+func initSandboxCleanup() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "sandbox_cleanup",
+			Description: "Delete resources in the designated sandbox namespaces that have outlived their TTL. Run sandbox_report first to see what would be deleted",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Restrict the cleanup to a single designated sandbox namespace (Optional, defaults to all of them)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Sandbox: Cleanup",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: sandboxCleanup},
+	}
+}
+
+// sandboxNamespaces resolves which designated namespaces a call operates on.
+// An explicit namespace argument must itself be designated: the tools never
+// touch a namespace the configuration does not list.
+func sandboxNamespaces(params api.ToolHandlerParams, namespace string) (*Config, []string, error) {
+	extended, ok := params.GetToolsetConfig("sandbox")
+	cfg, valid := extended.(*Config)
+	if !ok || !valid || len(cfg.Namespaces) == 0 {
+		return nil, nil, errors.New("no sandbox namespaces are designated: list them under namespaces in [toolset_configs.sandbox]")
+	}
+	if namespace == "" {
+		return cfg, cfg.Namespaces, nil
+	}
+	if !slices.Contains(cfg.Namespaces, namespace) {
+		return nil, nil, fmt.Errorf("namespace %s is not a designated sandbox namespace", namespace)
+	}
+	return cfg, []string{namespace}, nil
+}
+
+// expiredResource is a resource that outlived its TTL.
+type expiredResource struct {
+	gvr       schema.GroupVersionResource
+	kind      string
+	namespace string
+	name      string
+	age       time.Duration
+	ttl       time.Duration
+}
+
+func (e expiredResource) String() string {
+	return fmt.Sprintf("%s/%s in namespace %s (age %s, ttl %s)",
+		strings.ToLower(e.kind), e.name, e.namespace,
+		e.age.Round(time.Minute), e.ttl)
+}
+
+// scanExpired walks the designated namespaces and returns every scanned
+// resource that has outlived its TTL. The TTL comes from the resource's
+// annotation, then the namespace's annotation, then the configured default;
+// resources with no TTL from any source are left alone.
+func scanExpired(params api.ToolHandlerParams, cfg *Config, namespaces []string) ([]expiredResource, int, error) {
+	now := time.Now()
+	scanned := 0
+	var expired []expiredResource
+	for _, namespace := range namespaces {
+		namespaceTTL := cfg.defaultTTL()
+		if ns, err := params.CoreV1().Namespaces().Get(params.Context, namespace, metav1.GetOptions{}); err == nil {
+			if ttl := parseTTLAnnotation(ns.Annotations); ttl > 0 {
+				namespaceTTL = ttl
+			}
+		}
+		for _, target := range sandboxScanTargets {
+			list, err := params.DynamicClient().Resource(target.gvr).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+			if err != nil {
+				return nil, 0, fmt.Errorf("failed to list %s in namespace %s: %w", target.gvr.Resource, namespace, err)
+			}
+			for _, item := range list.Items {
+				if len(item.GetOwnerReferences()) > 0 || clusterManagedNames[item.GetName()] {
+					continue
+				}
+				if target.kind == "Secret" && isServiceAccountSecret(&item) {
+					continue
+				}
+				scanned++
+				ttl := parseTTLAnnotation(item.GetAnnotations())
+				if ttl <= 0 {
+					ttl = namespaceTTL
+				}
+				if ttl <= 0 {
+					continue
+				}
+				age := now.Sub(item.GetCreationTimestamp().Time)
+				if age > ttl {
+					expired = append(expired, expiredResource{
+						gvr: target.gvr, kind: target.kind,
+						namespace: namespace, name: item.GetName(),
+						age: age, ttl: ttl,
+					})
+				}
+			}
+		}
+	}
+	return expired, scanned, nil
+}
+
+func parseTTLAnnotation(annotations map[string]string) time.Duration {
+	value, ok := annotations[TTLAnnotation]
+	if !ok {
+		return 0
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0
+	}
+	return ttl
+}
+
+func isServiceAccountSecret(secret *unstructured.Unstructured) bool {
+	secretType, _, _ := unstructured.NestedString(secret.Object, "type")
+	return strings.HasPrefix(secretType, "kubernetes.io/service-account")
+}
+
+func sandboxReport(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to report sandbox resources: %w", err)), nil
+	}
+	cfg, namespaces, err := sandboxNamespaces(params, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to report sandbox resources: %w", err)), nil
+	}
+	expired, scanned, err := scanExpired(params, cfg, namespaces)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to report sandbox resources: %w", err)), nil
+	}
+	if len(expired) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf(
+			"No expired resources in sandbox namespace(s) %s (%d resource(s) scanned)",
+			strings.Join(namespaces, ", "), scanned), nil), nil
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d of %d scanned resource(s) have outlived their TTL:\n", len(expired), scanned))
+	for _, resource := range expired {
+		sb.WriteString("  - " + resource.String() + "\n")
+	}
+	sb.WriteString("Delete them with sandbox_cleanup")
+	return api.NewToolCallResult(sb.String(), nil), nil
+}
+
+func sandboxCleanup(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to clean up sandbox resources: %w", err)), nil
+	}
+	cfg, namespaces, err := sandboxNamespaces(params, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to clean up sandbox resources: %w", err)), nil
+	}
+	expired, scanned, err := scanExpired(params, cfg, namespaces)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to clean up sandbox resources: %w", err)), nil
+	}
+	if len(expired) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf(
+			"No expired resources in sandbox namespace(s) %s (%d resource(s) scanned)",
+			strings.Join(namespaces, ", "), scanned), nil), nil
+	}
+	propagation := metav1.DeletePropagationBackground
+	var deleted, failed []string
+	for _, resource := range expired {
+		err := params.DynamicClient().Resource(resource.gvr).Namespace(resource.namespace).
+			Delete(params.Context, resource.name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+		if err != nil {
+			failed = append(failed, resource.String()+": "+err.Error())
+		} else {
+			deleted = append(deleted, resource.String())
+		}
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Deleted %d expired resource(s):\n", len(deleted)))
+	for _, line := range deleted {
+		sb.WriteString("  - " + line + "\n")
+	}
+	if len(failed) > 0 {
+		sb.WriteString(fmt.Sprintf("Failed to delete %d resource(s):\n", len(failed)))
+		for _, line := range failed {
+			sb.WriteString("  - " + line + "\n")
+		}
+	}
+	return api.NewToolCallResult(strings.TrimSuffix(sb.String(), "\n"), nil), nil
+}
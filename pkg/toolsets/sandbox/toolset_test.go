@@ -0,0 +1,147 @@
+package sandbox_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/mcp/mcptest"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/sandbox"
+)
+
+func TestMain(m *testing.M) {
+	mcptest.Main(m)
+}
+
+type SandboxSuite struct {
+	mcptest.Suite
+	toolsetName string
+}
+
+func (s *SandboxSuite) SetupTest() {
+	s.Suite.SetupTest()
+	s.toolsetName = (&sandbox.Toolset{}).GetName()
+}
+
+// configureSandbox replaces s.Cfg with one parsed from the given
+// [toolset_configs.sandbox] section. toolset_configs requires the two-phase
+// parsing performed by config.ReadToml, so the runtime fields the suite
+// already set are restored afterwards.
+func (s *SandboxSuite) configureSandbox(toolsetConfig string) {
+	kubeConfig := s.Cfg.KubeConfig
+	listOutput := s.Cfg.ListOutput
+	readOnly := s.Cfg.ReadOnly
+	cfg, err := config.ReadToml([]byte(fmt.Sprintf(`
+		toolsets = ["%s"]
+		[toolset_configs.sandbox]
+		%s
+	`, s.toolsetName, toolsetConfig)))
+	s.Require().NoError(err, "failed to parse sandbox toolset config")
+	s.Cfg = cfg
+	s.Cfg.KubeConfig = kubeConfig
+	s.Cfg.ListOutput = listOutput
+	s.Cfg.ReadOnly = readOnly
+}
+
+func (s *SandboxSuite) text(toolResult *mcp.CallToolResult) string {
+	s.T().Helper()
+	s.Require().NotEmpty(toolResult.Content, "expected tool result content")
+	return toolResult.Content[0].(*mcp.TextContent).Text
+}
+
+func (s *SandboxSuite) TestNoDesignatedNamespaces() {
+	s.Cfg.Toolsets = append(s.Cfg.Toolsets, s.toolsetName)
+	s.InitMcpClient()
+	s.Run("sandbox_report refuses without designated namespaces", func() {
+		toolResult, err := s.CallTool("sandbox_report", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to report sandbox resources: no sandbox namespaces are designated: list them under namespaces in [toolset_configs.sandbox]", s.text(toolResult))
+	})
+	s.Run("sandbox_cleanup refuses without designated namespaces", func() {
+		toolResult, err := s.CallTool("sandbox_cleanup", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to clean up sandbox resources: no sandbox namespaces are designated: list them under namespaces in [toolset_configs.sandbox]", s.text(toolResult))
+	})
+}
+
+func (s *SandboxSuite) TestReportAndCleanup() {
+	s.configureSandbox(`namespaces = ["sandbox-ns"]`)
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(mcptest.RestConfig())
+	_, _ = client.CoreV1().Namespaces().Create(s.T().Context(),
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "sandbox-ns"}}, metav1.CreateOptions{})
+	// An already-expired resource (its 1ms TTL is over by the time the tools
+	// scan) next to one without any TTL, which must be left alone.
+	_, err := client.CoreV1().ConfigMaps("sandbox-ns").Create(s.T().Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "expired-cm",
+			Annotations: map[string]string{sandbox.TTLAnnotation: "1ms"},
+		},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create expired configmap")
+	_, err = client.CoreV1().ConfigMaps("sandbox-ns").Create(s.T().Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "fresh-cm"},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create fresh configmap")
+
+	s.Run("sandbox_report (non-designated namespace)", func() {
+		toolResult, err := s.CallTool("sandbox_report", map[string]interface{}{"namespace": "default"})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to report sandbox resources: namespace default is not a designated sandbox namespace", s.text(toolResult))
+	})
+	s.Run("sandbox_report (expired resource)", func() {
+		toolResult, err := s.CallTool("sandbox_report", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := s.text(toolResult)
+		s.Run("reports only the expired configmap", func() {
+			s.Containsf(text, "1 of 2 scanned resource(s) have outlived their TTL:", "unexpected result %v", text)
+			s.Containsf(text, "configmap/expired-cm in namespace sandbox-ns", "unexpected result %v", text)
+		})
+		s.Run("points at sandbox_cleanup", func() {
+			s.Containsf(text, "Delete them with sandbox_cleanup", "unexpected result %v", text)
+		})
+		s.Run("does not delete anything", func() {
+			_, getErr := client.CoreV1().ConfigMaps("sandbox-ns").Get(s.T().Context(), "expired-cm", metav1.GetOptions{})
+			s.NoError(getErr, "sandbox_report must leave the expired configmap in place")
+		})
+	})
+	s.Run("sandbox_cleanup (expired resource)", func() {
+		toolResult, err := s.CallTool("sandbox_cleanup", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := s.text(toolResult)
+		s.Run("reports the deleted configmap", func() {
+			s.Containsf(text, "Deleted 1 expired resource(s):", "unexpected result %v", text)
+			s.Containsf(text, "configmap/expired-cm in namespace sandbox-ns", "unexpected result %v", text)
+		})
+		s.Run("deletes the expired configmap", func() {
+			_, getErr := client.CoreV1().ConfigMaps("sandbox-ns").Get(s.T().Context(), "expired-cm", metav1.GetOptions{})
+			s.Truef(apierrors.IsNotFound(getErr), "expected the expired configmap to be deleted, got %v", getErr)
+		})
+		s.Run("keeps the configmap without a TTL", func() {
+			_, getErr := client.CoreV1().ConfigMaps("sandbox-ns").Get(s.T().Context(), "fresh-cm", metav1.GetOptions{})
+			s.NoError(getErr, "a resource with no TTL from any source must not be swept")
+		})
+	})
+	s.Run("sandbox_cleanup (nothing left to delete)", func() {
+		toolResult, err := s.CallTool("sandbox_cleanup", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(s.text(toolResult), "No expired resources in sandbox namespace(s) sandbox-ns")
+	})
+}
+
+func TestSandbox(t *testing.T) {
+	suite.Run(t, new(SandboxSuite))
+}
@@ -0,0 +1,184 @@
+package secretsmanagement
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+const (
+	mechanismExternalSecrets = "external-secrets"
+	mechanismCSI             = "csi"
+)
+
+var (
+	externalSecretGVK    = schema.GroupVersionKind{Group: "external-secrets.io", Version: "v1beta1", Kind: "ExternalSecret"}
+	secretStoreGVR       = schema.GroupVersionResource{Group: "external-secrets.io", Version: "v1beta1", Resource: "secretstores"}
+	secretProviderGVK    = schema.GroupVersionKind{Group: "secrets-store.csi.x-k8s.io", Version: "v1", Kind: "SecretProviderClass"}
+	secretProviderGVR    = schema.GroupVersionResource{Group: "secrets-store.csi.x-k8s.io", Version: "v1", Resource: "secretproviderclasses"}
+	externalSecretsGVRv1 = schema.GroupVersionResource{Group: "external-secrets.io", Version: "v1beta1", Resource: "externalsecrets"}
+)
+
+// auditCheck is a single result line in a secrets management audit report.
+type auditCheck struct {
+	status string // "PASS", "FAIL", or "WARN"
+	detail string
+}
+
+func pass(format string, args ...any) auditCheck {
+	return auditCheck{status: "PASS", detail: fmt.Sprintf(format, args...)}
+}
+
+func fail(format string, args ...any) auditCheck {
+	return auditCheck{status: "FAIL", detail: fmt.Sprintf(format, args...)}
+}
+
+func warn(format string, args ...any) auditCheck {
+	return auditCheck{status: "WARN", detail: fmt.Sprintf(format, args...)}
+}
+
+func initDebug() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "secrets_management_debug",
+				Description: "Audit the secret delivery machinery in the cluster: External Secrets Operator installation and store/secret sync health, and Secrets Store CSI driver installation and SecretProviderClass state. Returns a PASS/FAIL/WARN report",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"mechanism": {
+							Type:        "string",
+							Description: "Restrict the audit to one mechanism: 'external-secrets' or 'csi' (Optional, both are audited if not provided)",
+							Enum:        []any{mechanismExternalSecrets, mechanismCSI},
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to audit stores and secrets in (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Secrets Management: Debug",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: secretsManagementDebug,
+		},
+	}
+}
+
+func secretsManagementDebug(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	mechanism := p.OptionalString("mechanism", "")
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to audit secrets management: %w", err)), nil
+	}
+
+	var sections []string
+	if mechanism == "" || mechanism == mechanismExternalSecrets {
+		sections = append(sections, renderAudit("External Secrets Operator", runESOAudit(params, namespace)))
+	}
+	if mechanism == "" || mechanism == mechanismCSI {
+		sections = append(sections, renderAudit("Secrets Store CSI driver", runSSCSIAudit(params, namespace)))
+	}
+	return api.NewToolCallResult(strings.Join(sections, "\n\n"), nil), nil
+}
+
+func renderAudit(title string, checks []auditCheck) string {
+	lines := make([]string, 0, len(checks)+1)
+	failures := 0
+	for _, check := range checks {
+		if check.status == "FAIL" {
+			failures++
+		}
+		lines = append(lines, fmt.Sprintf("[%s] %s", check.status, check.detail))
+	}
+	header := fmt.Sprintf("%s audit (%d check(s), %d failed):", title, len(checks), failures)
+	return header + "\n" + strings.Join(lines, "\n")
+}
+
+// runESOAudit audits the External Secrets Operator installation and the sync
+// state of SecretStores and ExternalSecrets in the given namespace (all
+// namespaces when empty).
+func runESOAudit(params api.ToolHandlerParams, namespace string) []auditCheck {
+	var checks []auditCheck
+
+	installed, err := api.HasGVKs(params.DiscoveryClient(), []schema.GroupVersionKind{externalSecretGVK})
+	if err != nil {
+		return append(checks, fail("unable to discover External Secrets CRDs: %v", err))
+	}
+	if !installed {
+		return append(checks, fail("External Secrets Operator is not installed (ExternalSecret CRD not found)"))
+	}
+	checks = append(checks, pass("ExternalSecret CRD is present"))
+	checks = append(checks, esoControllerHealth(params))
+
+	stores, err := params.DynamicClient().Resource(secretStoreGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		checks = append(checks, warn("unable to list SecretStores: %v", err))
+	} else {
+		notReady := 0
+		for i := range stores.Items {
+			if status, _, _ := storeReadyCondition(&stores.Items[i]); status != "" && status != "True" {
+				notReady++
+			}
+		}
+		if notReady > 0 {
+			checks = append(checks, fail("%d of %d SecretStore(s) are not Ready", notReady, len(stores.Items)))
+		} else {
+			checks = append(checks, pass("%d SecretStore(s) found, all Ready", len(stores.Items)))
+		}
+	}
+
+	externalSecrets, err := params.DynamicClient().Resource(externalSecretsGVRv1).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		checks = append(checks, warn("unable to list ExternalSecrets: %v", err))
+	} else {
+		notReady := 0
+		for i := range externalSecrets.Items {
+			if status, _, _ := storeReadyCondition(&externalSecrets.Items[i]); status != "" && status != "True" {
+				notReady++
+			}
+		}
+		if notReady > 0 {
+			checks = append(checks, fail("%d of %d ExternalSecret(s) are not syncing (not Ready)", notReady, len(externalSecrets.Items)))
+		} else {
+			checks = append(checks, pass("%d ExternalSecret(s) found, all syncing", len(externalSecrets.Items)))
+		}
+	}
+	return checks
+}
+
+// runSSCSIAudit audits the Secrets Store CSI driver installation and the
+// SecretProviderClasses in the given namespace (all namespaces when empty).
+func runSSCSIAudit(params api.ToolHandlerParams, namespace string) []auditCheck {
+	var checks []auditCheck
+
+	installed, err := api.HasGVKs(params.DiscoveryClient(), []schema.GroupVersionKind{secretProviderGVK})
+	if err != nil {
+		return append(checks, fail("unable to discover Secrets Store CSI CRDs: %v", err))
+	}
+	if !installed {
+		return append(checks, fail("Secrets Store CSI driver is not installed (SecretProviderClass CRD not found)"))
+	}
+	checks = append(checks, pass("SecretProviderClass CRD is present"))
+	checks = append(checks, csiDriverHealth(params))
+
+	classes, err := params.DynamicClient().Resource(secretProviderGVR).Namespace(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		checks = append(checks, warn("unable to list SecretProviderClasses: %v", err))
+	} else {
+		checks = append(checks, pass("%d SecretProviderClass(es) found", len(classes.Items)))
+	}
+	return checks
+}
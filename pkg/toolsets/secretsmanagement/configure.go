@@ -0,0 +1,287 @@
+package secretsmanagement
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func initConfigure() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "secrets_management_configure",
+				Description: "Generate a configuration plan for delivering external secrets to workloads (a SecretStore for the External Secrets Operator or a SecretProviderClass for the Secrets Store CSI driver). With execute=true the plan is applied after user confirmation: the operator installation is verified, the resource is created, and the relevant audit is re-run to confirm the result",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"provider": {
+							Type:        "string",
+							Description: "Secret provider backend: 'aws', 'gcp', 'azure', or 'vault'",
+							Enum:        []any{"aws", "gcp", "azure", "vault"},
+						},
+						"mechanism": {
+							Type:        "string",
+							Description: "Delivery mechanism: 'external-secrets' (SecretStore) or 'csi' (SecretProviderClass) (Optional, defaults to external-secrets)",
+							Enum:        []any{mechanismExternalSecrets, mechanismCSI},
+						},
+						"name": {
+							Type:        "string",
+							Description: "Name for the SecretStore or SecretProviderClass",
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to create the resource in (Optional, current namespace if not provided)",
+						},
+						"service_account": {
+							Type:        "string",
+							Description: "ServiceAccount the provider authenticates as (Optional, defaults to 'default')",
+						},
+						"address": {
+							Type:        "string",
+							Description: "Provider endpoint: Vault server address or Azure Key Vault URL (required for vault and azure)",
+						},
+						"region": {
+							Type:        "string",
+							Description: "Cloud region for the aws provider (Optional)",
+						},
+						"execute": {
+							Type:        "boolean",
+							Description: "Apply the plan instead of only printing it; the user is asked for confirmation first (Optional, defaults to false)",
+						},
+					},
+					Required: []string{"provider", "name"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Secrets Management: Configure",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: secretsManagementConfigure,
+		},
+	}
+}
+
+func secretsManagementConfigure(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	provider := p.RequiredString("provider")
+	mechanism := p.OptionalString("mechanism", mechanismExternalSecrets)
+	name := p.RequiredString("name")
+	namespace := params.NamespaceOrDefault(p.OptionalString("namespace", ""))
+	serviceAccount := p.OptionalString("service_account", "default")
+	address := p.OptionalString("address", "")
+	region := p.OptionalString("region", "")
+	execute := p.OptionalBool("execute", false)
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to configure secrets management: %w", err)), nil
+	}
+	if (provider == "vault" || provider == "azure") && address == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to configure secrets management: the %s provider requires the address parameter", provider)), nil
+	}
+
+	plan, err := buildConfigurationPlan(provider, mechanism, name, namespace, serviceAccount, address, region)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to configure secrets management: %w", err)), nil
+	}
+
+	if !execute {
+		return api.NewToolCallResult(plan.render()+"\n\nRe-run with execute=true to apply this plan", nil), nil
+	}
+
+	confirmed, err := confirmPlan(params, plan)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to confirm configuration plan: %w", err)), nil
+	}
+	if !confirmed {
+		return api.NewToolCallResult("Configuration plan was not confirmed; nothing was applied", nil), nil
+	}
+
+	// Verify the operator backing the chosen mechanism is installed before
+	// creating its custom resource.
+	requiredGVK := externalSecretGVK
+	if mechanism == mechanismCSI {
+		requiredGVK = secretProviderGVK
+	}
+	installed, err := api.HasGVKs(params.DiscoveryClient(), []schema.GroupVersionKind{requiredGVK})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to verify operator installation: %w", err)), nil
+	}
+	if !installed {
+		return api.NewToolCallResult("", fmt.Errorf("cannot apply plan: the %s CRD is not installed; install the operator first", requiredGVK.Kind)), nil
+	}
+
+	gvr := secretStoreGVR
+	if mechanism == mechanismCSI {
+		gvr = secretProviderGVR
+	}
+	if _, err = params.DynamicClient().Resource(gvr).Namespace(namespace).Create(params.Context, &unstructured.Unstructured{Object: plan.resource}, metav1.CreateOptions{}); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to create %s '%s/%s': %w", plan.kind, namespace, name, err)), nil
+	}
+
+	// Re-run the mechanism's audit so the caller gets immediate confirmation
+	// of the resulting state.
+	var audit string
+	if mechanism == mechanismCSI {
+		audit = renderAudit("Secrets Store CSI driver", runSSCSIAudit(params, namespace))
+	} else {
+		audit = renderAudit("External Secrets Operator", runESOAudit(params, namespace))
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Created %s '%s' in namespace '%s'\n\n%s", plan.kind, name, namespace, audit), nil), nil
+}
+
+// configurationPlan is the output of secrets_management_configure: the
+// resource to create plus the prerequisites the cluster must satisfy.
+type configurationPlan struct {
+	kind          string
+	resource      map[string]interface{}
+	prerequisites []string
+}
+
+func (p *configurationPlan) render() string {
+	manifest, err := yaml.Marshal(p.resource)
+	if err != nil {
+		manifest = []byte(fmt.Sprintf("# failed to render manifest: %v", err))
+	}
+	return fmt.Sprintf("Configuration plan (%s):\n\nPrerequisites:\n- %s\n\nResource to create:\n%s",
+		p.kind, strings.Join(p.prerequisites, "\n- "), string(manifest))
+}
+
+func buildConfigurationPlan(provider, mechanism, name, namespace, serviceAccount, address, region string) (*configurationPlan, error) {
+	switch mechanism {
+	case mechanismExternalSecrets:
+		return buildSecretStorePlan(provider, name, namespace, serviceAccount, address, region)
+	case mechanismCSI:
+		return buildSecretProviderClassPlan(provider, name, namespace, address, region)
+	default:
+		return nil, fmt.Errorf("unknown mechanism '%s'", mechanism)
+	}
+}
+
+func buildSecretStorePlan(provider, name, namespace, serviceAccount, address, region string) (*configurationPlan, error) {
+	plan := &configurationPlan{
+		kind: "SecretStore",
+		prerequisites: []string{
+			"External Secrets Operator installed (ExternalSecret CRD established)",
+			fmt.Sprintf("ServiceAccount '%s/%s' exists", namespace, serviceAccount),
+		},
+	}
+	saRef := map[string]interface{}{"name": serviceAccount}
+	var providerSpec map[string]interface{}
+	switch provider {
+	case "aws":
+		providerSpec = map[string]interface{}{
+			"aws": map[string]interface{}{
+				"service": "SecretsManager",
+				"region":  region,
+				"auth":    map[string]interface{}{"jwt": map[string]interface{}{"serviceAccountRef": saRef}},
+			},
+		}
+		plan.prerequisites = append(plan.prerequisites, fmt.Sprintf("ServiceAccount '%s' annotated with eks.amazonaws.com/role-arn (IRSA)", serviceAccount))
+	case "gcp":
+		providerSpec = map[string]interface{}{
+			"gcpsm": map[string]interface{}{
+				"auth": map[string]interface{}{"workloadIdentity": map[string]interface{}{"serviceAccountRef": saRef}},
+			},
+		}
+		plan.prerequisites = append(plan.prerequisites, fmt.Sprintf("ServiceAccount '%s' annotated with iam.gke.io/gcp-service-account (Workload Identity)", serviceAccount))
+	case "azure":
+		providerSpec = map[string]interface{}{
+			"azurekv": map[string]interface{}{
+				"authType":          "WorkloadIdentity",
+				"vaultUrl":          address,
+				"serviceAccountRef": saRef,
+			},
+		}
+	case "vault":
+		providerSpec = map[string]interface{}{
+			"vault": map[string]interface{}{
+				"server":  address,
+				"path":    "secret",
+				"version": "v2",
+				"auth": map[string]interface{}{
+					"kubernetes": map[string]interface{}{
+						"mountPath":         "kubernetes",
+						"role":              name,
+						"serviceAccountRef": saRef,
+					},
+				},
+			},
+		}
+		plan.prerequisites = append(plan.prerequisites, fmt.Sprintf("Vault Kubernetes auth role '%s' bound to ServiceAccount '%s/%s'", name, namespace, serviceAccount))
+	default:
+		return nil, fmt.Errorf("unknown provider '%s'", provider)
+	}
+	plan.resource = map[string]interface{}{
+		"apiVersion": "external-secrets.io/v1beta1",
+		"kind":       "SecretStore",
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+		"spec":       map[string]interface{}{"provider": providerSpec},
+	}
+	return plan, nil
+}
+
+func buildSecretProviderClassPlan(provider, name, namespace, address, region string) (*configurationPlan, error) {
+	parameters := map[string]interface{}{}
+	switch provider {
+	case "aws":
+		parameters["region"] = region
+		parameters["objects"] = "# TODO: list the secrets to mount, e.g.\n- objectName: my-secret\n  objectType: secretsmanager\n"
+	case "gcp":
+		parameters["secrets"] = "# TODO: list the secrets to mount, e.g.\n- resourceName: projects/PROJECT/secrets/my-secret/versions/latest\n  path: my-secret\n"
+	case "azure":
+		parameters["keyvaultName"] = address
+		parameters["objects"] = "# TODO: list the objects to mount\n"
+	case "vault":
+		parameters["vaultAddress"] = address
+		parameters["objects"] = "# TODO: list the secrets to mount, e.g.\n- objectName: my-secret\n  secretPath: secret/data/my-secret\n  secretKey: value\n"
+	default:
+		return nil, fmt.Errorf("unknown provider '%s'", provider)
+	}
+	return &configurationPlan{
+		kind: "SecretProviderClass",
+		prerequisites: []string{
+			"Secrets Store CSI driver installed (SecretProviderClass CRD established)",
+			fmt.Sprintf("Secrets Store CSI provider plugin for '%s' running on the target nodes", provider),
+		},
+		resource: map[string]interface{}{
+			"apiVersion": "secrets-store.csi.x-k8s.io/v1",
+			"kind":       "SecretProviderClass",
+			"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+			"spec": map[string]interface{}{
+				"provider":   provider,
+				"parameters": parameters,
+			},
+		},
+	}, nil
+}
+
+// confirmPlan asks the user to confirm applying the plan via elicitation.
+// Execution proceeds only on an explicit accept; clients without elicitation
+// support cannot confirm, so execution is refused.
+func confirmPlan(params api.ToolHandlerParams, plan *configurationPlan) (bool, error) {
+	if params.Elicitor == nil {
+		return false, api.ErrElicitationNotSupported
+	}
+	result, err := params.Elicitor.Elicit(params.Context, &api.ElicitParams{
+		Message: fmt.Sprintf("About to apply the following secrets management plan. Do you want to proceed?\n\n%s", plan.render()),
+	})
+	if err != nil {
+		if errors.Is(err, api.ErrElicitationNotSupported) {
+			return false, fmt.Errorf("cannot execute plan: %w; re-run without execute and apply the manifest manually", err)
+		}
+		return false, err
+	}
+	return result.Action == api.ElicitActionAccept, nil
+}
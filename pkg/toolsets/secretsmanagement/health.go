@@ -0,0 +1,74 @@
+package secretsmanagement
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// Label selectors used to locate the controller/driver workloads regardless
+// of the namespace or operator flavor (Helm chart, OLM operator, community
+// manifests) they were installed from.
+const (
+	esoControllerSelector = "app.kubernetes.io/name=external-secrets"
+	csiDriverSelector     = "app.kubernetes.io/name=secrets-store-csi-driver"
+	csiDriverLegacyLabel  = "app=secrets-store-csi-driver"
+)
+
+// esoControllerHealth checks the External Secrets Operator controller
+// Deployment across all namespaces and reports whether it has ready replicas.
+func esoControllerHealth(params api.ToolHandlerParams) auditCheck {
+	deployments, err := params.AppsV1().Deployments("").List(params.Context, metav1.ListOptions{LabelSelector: esoControllerSelector})
+	if err != nil {
+		return warn("unable to list External Secrets controller deployments: %v", err)
+	}
+	if len(deployments.Items) == 0 {
+		return fail("no controller Deployment found (label %s); the CRDs are installed but nothing reconciles them", esoControllerSelector)
+	}
+	var unhealthy []string
+	ready := 0
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if deployment.Status.ReadyReplicas > 0 {
+			ready++
+			continue
+		}
+		unhealthy = append(unhealthy, deployment.Namespace+"/"+deployment.Name)
+	}
+	if len(unhealthy) > 0 {
+		return fail("controller Deployment(s) with no ready replicas: %s", strings.Join(unhealthy, ", "))
+	}
+	return pass("controller healthy: %d Deployment(s) with ready replicas", ready)
+}
+
+// csiDriverHealth checks the Secrets Store CSI driver node DaemonSet across
+// all namespaces and reports whether every scheduled pod is ready.
+func csiDriverHealth(params api.ToolHandlerParams) auditCheck {
+	daemonSets, err := params.AppsV1().DaemonSets("").List(params.Context, metav1.ListOptions{LabelSelector: csiDriverSelector})
+	if err != nil {
+		return warn("unable to list Secrets Store CSI driver daemonsets: %v", err)
+	}
+	if len(daemonSets.Items) == 0 {
+		// Older chart releases label the DaemonSet with the legacy app label only.
+		daemonSets, err = params.AppsV1().DaemonSets("").List(params.Context, metav1.ListOptions{LabelSelector: csiDriverLegacyLabel})
+		if err != nil {
+			return warn("unable to list Secrets Store CSI driver daemonsets: %v", err)
+		}
+	}
+	if len(daemonSets.Items) == 0 {
+		return fail("no driver DaemonSet found (label %s); the CRDs are installed but no node plugin is running", csiDriverSelector)
+	}
+	var unhealthy []string
+	for i := range daemonSets.Items {
+		daemonSet := &daemonSets.Items[i]
+		if daemonSet.Status.NumberReady < daemonSet.Status.DesiredNumberScheduled {
+			unhealthy = append(unhealthy, daemonSet.Namespace+"/"+daemonSet.Name)
+		}
+	}
+	if len(unhealthy) > 0 {
+		return fail("driver DaemonSet(s) with unready pods: %s", strings.Join(unhealthy, ", "))
+	}
+	return pass("driver healthy: all DaemonSet pods ready on scheduled nodes")
+}
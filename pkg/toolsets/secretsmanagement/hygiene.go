@@ -0,0 +1,250 @@
+package secretsmanagement
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+var apiServerGVR = schema.GroupVersionResource{Group: "apiserver.config.openshift.io", Version: "v1", Resource: "apiservers"}
+
+// Secret types that are owned by the platform and not expected to be mounted
+// by workloads; they are excluded from the unused-secret check.
+var platformSecretTypes = map[corev1.SecretType]bool{
+	corev1.SecretTypeServiceAccountToken:  true,
+	"helm.sh/release.v1":                  true,
+	corev1.SecretTypeDockercfg:            true,
+	corev1.SecretTypeTLS:                  false, // TLS secrets are workload-mounted, keep them in scope
+	"kubernetes.io/dockerconfigjson":      false,
+	corev1.SecretTypeBootstrapToken:       true,
+	"openshift.io/service-account-secret": true,
+}
+
+func initHygiene() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "secrets_management_audit",
+				Description: "Audit secret hygiene: etcd encryption on the OpenShift APIServer resource, Secrets exposed as environment variables instead of volume mounts, Secrets unused by any pod, and overly broad RBAC on secrets. Produces a scored report with remediations",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to audit Secrets and pods in (Optional, all namespaces if not provided)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Secrets Management: Hygiene Audit",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			Handler: secretsManagementAudit,
+		},
+	}
+}
+
+func secretsManagementAudit(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	p := api.WrapParams(params)
+	namespace := p.OptionalString("namespace", "")
+	if err := p.Err(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to audit secret hygiene: %w", err)), nil
+	}
+
+	var checks []auditCheck
+	var remediations []string
+	score := 100
+
+	encryption := etcdEncryptionCheck(params)
+	checks = append(checks, encryption)
+	if encryption.status == "FAIL" {
+		score -= 40
+		remediations = append(remediations, "Enable etcd encryption: set spec.encryption.type to aescbc or aesgcm on the APIServer 'cluster' resource")
+	}
+
+	pods, err := params.CoreV1().Pods(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods for secret hygiene audit: %w", err)), nil
+	}
+	secrets, err := params.CoreV1().Secrets(namespace).List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list secrets for secret hygiene audit: %w", err)), nil
+	}
+
+	envConsumers, referenced := secretConsumers(pods.Items)
+	if len(envConsumers) > 0 {
+		sort.Strings(envConsumers)
+		score -= min(20, 2*len(envConsumers))
+		checks = append(checks, warn("%d pod(s) expose Secrets as environment variables: %s", len(envConsumers), summarize(envConsumers, 10)))
+		remediations = append(remediations, "Mount Secrets as volumes instead of environment variables; env vars leak via /proc, crash dumps, and child processes")
+	} else {
+		checks = append(checks, pass("no pods expose Secrets as environment variables"))
+	}
+
+	var unused []string
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if platformSecretTypes[secret.Type] {
+			continue
+		}
+		if !referenced[secret.Namespace+"/"+secret.Name] {
+			unused = append(unused, secret.Namespace+"/"+secret.Name)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		score -= min(15, len(unused))
+		checks = append(checks, warn("%d Secret(s) are not referenced by any pod: %s", len(unused), summarize(unused, 10)))
+		remediations = append(remediations, "Review and delete unused Secrets; stale credentials widen the blast radius of a namespace compromise")
+	} else {
+		checks = append(checks, pass("all audited Secrets are referenced by at least one pod"))
+	}
+
+	broad := broadSecretRBAC(params)
+	if len(broad) > 0 {
+		sort.Strings(broad)
+		score -= min(25, 5*len(broad))
+		checks = append(checks, warn("%d ClusterRole(s) grant broad access to secrets: %s", len(broad), summarize(broad, 10)))
+		remediations = append(remediations, "Scope secret access with resourceNames or namespaced Roles instead of wildcard ClusterRole rules")
+	} else {
+		checks = append(checks, pass("no ClusterRoles with overly broad secret access found"))
+	}
+
+	if score < 0 {
+		score = 0
+	}
+	report := fmt.Sprintf("Secret hygiene score: %d/100\n\n%s", score, renderAudit("Secret hygiene", checks))
+	if len(remediations) > 0 {
+		report += "\n\nRemediations:\n- " + strings.Join(remediations, "\n- ")
+	}
+	return api.NewToolCallResult(report, nil), nil
+}
+
+// etcdEncryptionCheck inspects the OpenShift APIServer 'cluster' resource for
+// encryption at rest. On clusters without the OpenShift config API the check
+// is reported as a WARN rather than a failure.
+func etcdEncryptionCheck(params api.ToolHandlerParams) auditCheck {
+	apiServer, err := params.DynamicClient().Resource(apiServerGVR).Get(params.Context, "cluster", metav1.GetOptions{})
+	if err != nil {
+		return warn("unable to read APIServer 'cluster' resource (not an OpenShift cluster?): %v", err)
+	}
+	encryptionType, _, _ := unstructured.NestedString(apiServer.Object, "spec", "encryption", "type")
+	switch encryptionType {
+	case "aescbc", "aesgcm", "KMS", "kms":
+		return pass("etcd encryption at rest is enabled (type: %s)", encryptionType)
+	case "", "identity":
+		return fail("etcd encryption at rest is not enabled on the APIServer resource")
+	default:
+		return warn("unrecognized etcd encryption type '%s'", encryptionType)
+	}
+}
+
+// secretConsumers scans pods and returns the pods that consume Secrets via
+// environment variables, plus the set of "namespace/name" Secret references
+// consumed in any way (env, envFrom, volumes, image pull secrets).
+func secretConsumers(pods []corev1.Pod) (envConsumers []string, referenced map[string]bool) {
+	referenced = map[string]bool{}
+	for i := range pods {
+		pod := &pods[i]
+		usesEnv := false
+		containers := make([]corev1.Container, 0, len(pod.Spec.Containers)+len(pod.Spec.InitContainers))
+		containers = append(containers, pod.Spec.Containers...)
+		containers = append(containers, pod.Spec.InitContainers...)
+		for _, container := range containers {
+			for _, env := range container.Env {
+				if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+					usesEnv = true
+					referenced[pod.Namespace+"/"+env.ValueFrom.SecretKeyRef.Name] = true
+				}
+			}
+			for _, envFrom := range container.EnvFrom {
+				if envFrom.SecretRef != nil {
+					usesEnv = true
+					referenced[pod.Namespace+"/"+envFrom.SecretRef.Name] = true
+				}
+			}
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.Secret != nil {
+				referenced[pod.Namespace+"/"+volume.Secret.SecretName] = true
+			}
+			if volume.Projected != nil {
+				for _, source := range volume.Projected.Sources {
+					if source.Secret != nil {
+						referenced[pod.Namespace+"/"+source.Secret.Name] = true
+					}
+				}
+			}
+		}
+		for _, pullSecret := range pod.Spec.ImagePullSecrets {
+			referenced[pod.Namespace+"/"+pullSecret.Name] = true
+		}
+		if usesEnv {
+			envConsumers = append(envConsumers, pod.Namespace+"/"+pod.Name)
+		}
+	}
+	return envConsumers, referenced
+}
+
+// broadSecretRBAC returns the names of non-system ClusterRoles whose rules
+// grant unscoped read (or wildcard) access to secrets.
+func broadSecretRBAC(params api.ToolHandlerParams) []string {
+	clusterRoles, err := params.RbacV1().ClusterRoles().List(params.Context, metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+	var broad []string
+	for i := range clusterRoles.Items {
+		role := &clusterRoles.Items[i]
+		if strings.HasPrefix(role.Name, "system:") {
+			continue
+		}
+		for _, rule := range role.Rules {
+			if ruleGrantsBroadSecretAccess(rule) {
+				broad = append(broad, role.Name)
+				break
+			}
+		}
+	}
+	return broad
+}
+
+func ruleGrantsBroadSecretAccess(rule rbacv1.PolicyRule) bool {
+	coversSecrets := false
+	for _, resource := range rule.Resources {
+		if resource == "secrets" || resource == "*" {
+			coversSecrets = true
+			break
+		}
+	}
+	if !coversSecrets || len(rule.ResourceNames) > 0 {
+		return false
+	}
+	for _, verb := range rule.Verbs {
+		if verb == "*" || verb == "get" || verb == "list" {
+			return true
+		}
+	}
+	return false
+}
+
+func summarize(items []string, limit int) string {
+	if len(items) <= limit {
+		return strings.Join(items, ", ")
+	}
+	return strings.Join(items[:limit], ", ") + fmt.Sprintf(", and %d more", len(items)-limit)
+}
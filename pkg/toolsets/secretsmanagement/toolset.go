@@ -0,0 +1,47 @@
+package secretsmanagement
+
+import (
+	"slices"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/toolsets"
+)
+
+// Toolset provides guided configuration and auditing of secure secret
+// delivery to workloads, covering the External Secrets Operator (ESO) and the
+// Secrets Store CSI driver.
+type Toolset struct{}
+
+var _ api.Toolset = (*Toolset)(nil)
+
+func (t *Toolset) GetName() string {
+	return "secrets-management"
+}
+
+func (t *Toolset) GetDescription() string {
+	return "Tools for configuring and auditing secure secret delivery to workloads (External Secrets Operator and Secrets Store CSI driver)"
+}
+
+func (t *Toolset) GetTools(_ api.FilteringProvider) []api.ServerTool {
+	return slices.Concat(
+		initConfigure(),
+		initDebug(),
+		initHygiene(),
+	)
+}
+
+func (t *Toolset) GetPrompts() []api.ServerPrompt {
+	return nil
+}
+
+func (t *Toolset) GetResources() []api.ServerResource {
+	return nil
+}
+
+func (t *Toolset) GetResourceTemplates() []api.ServerResourceTemplate {
+	return nil
+}
+
+func init() {
+	toolsets.Register(&Toolset{})
+}